@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	stdlog "log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/backup"
+	"reviewer-service/internal/config"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/logger"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/sqlite"
+	"reviewer-service/internal/teamimport"
+)
+
+const usage = `usage: backup -config_path=<file> [-storage=postgres|sqlite] <command> [flags]
+
+commands:
+  export [-o file]                                write all teams, users and pull requests as JSON
+  import [-mode=merge|replace] [-dry-run] <file>  load a document written by export, - reads stdin
+  import-teams [-format=csv|yaml] [-dry-run] <file>
+                                                  create or update teams and users from a CSV or YAML file`
+
+type store interface {
+	repository.Dumper
+	ImportTeams(ctx context.Context, teams []domain.Team) error
+	Close()
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var configPath, storage string
+
+	flag.StringVar(&configPath, "config_path", "", "Path to the config file")
+	flag.StringVar(&storage, "storage", "", "Storage backend: postgres or sqlite, overrides STORAGE")
+	flag.Usage = func() { fmt.Fprintln(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.New(configPath)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+
+	log, err := logger.New(&cfg.Logger)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+	defer log.Sync()
+
+	if storage != "" {
+		cfg.Storage = storage
+	}
+
+	repo, err := newStore(ctx, cfg, log)
+	if err != nil {
+		log.Fatal("cannot initialize storage", zap.String("storage", cfg.Storage), zap.Error(err))
+	}
+	defer repo.Close()
+
+	command, args := flag.Arg(0), flag.Args()[1:]
+
+	switch command {
+	case "export":
+		err = runExport(ctx, repo, args)
+	case "import":
+		err = runImport(ctx, repo, args)
+	case "import-teams":
+		err = runImportTeams(ctx, repo, args)
+	default:
+		err = fmt.Errorf("unknown command: %s\n%s", command, usage)
+	}
+
+	if err != nil {
+		log.Fatal("backup failed", zap.String("command", command), zap.Error(err))
+	}
+}
+
+func runExport(ctx context.Context, repo store, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	output := fs.String("o", "-", "Output file, - writes to stdout")
+	fs.Parse(args)
+
+	doc, err := backup.Export(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	return backup.Encode(w, doc)
+}
+
+func runImport(ctx context.Context, repo store, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	mode := fs.String("mode", backup.ModeMerge, "merge keeps existing rows, replace deletes them first")
+	dryRun := fs.Bool("dry-run", false, "Report what would change without writing")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import takes exactly one file\n%s", usage)
+	}
+
+	r := io.Reader(os.Stdin)
+	if fs.Arg(0) != "-" {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return fmt.Errorf("failed to open input file: %w", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	doc, err := backup.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	report, importErr := backup.Import(ctx, repo, doc, backup.Options{Mode: *mode, DryRun: *dryRun})
+	if report != nil {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		err = encoder.Encode(report)
+		if err != nil {
+			return errors.Join(importErr, err)
+		}
+	}
+
+	return importErr
+}
+
+func runImportTeams(ctx context.Context, repo store, args []string) error {
+	fs := flag.NewFlagSet("import-teams", flag.ExitOnError)
+	format := fs.String("format", "", "csv or yaml, taken from the file extension by default")
+	dryRun := fs.Bool("dry-run", false, "Validate the file without writing")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import-teams takes exactly one file\n%s", usage)
+	}
+
+	if *format == "" {
+		*format = teamimport.FormatFromPath(fs.Arg(0))
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("failed to open input file: %w", err)
+	}
+	defer f.Close()
+
+	teams, err := teamimport.Parse(f, *format)
+	if err != nil {
+		var rowErrs teamimport.Errors
+		if errors.As(err, &rowErrs) {
+			for _, e := range rowErrs {
+				fmt.Fprintf(os.Stderr, "line %d: %s %s\n", e.Line, e.Field, e.Message)
+			}
+		}
+
+		return err
+	}
+
+	users := 0
+	for _, team := range teams {
+		users += len(team.Members)
+	}
+
+	if !*dryRun {
+		err = repo.ImportTeams(ctx, teams)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("teams: %d, users: %d, dry run: %t\n", len(teams), users, *dryRun)
+	return nil
+}
+
+func newStore(ctx context.Context, cfg *config.Config, log *zap.Logger) (store, error) {
+	switch cfg.Storage {
+	case "postgres":
+		return postgres.New(ctx, &cfg.Postgres, log)
+
+	case "sqlite":
+		return sqlite.New(ctx, &cfg.SQLite, log)
+
+	default:
+		return nil, fmt.Errorf("unsupported storage: %s", cfg.Storage)
+	}
+}
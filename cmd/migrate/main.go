@@ -5,23 +5,41 @@ import (
 	"flag"
 	"fmt"
 	stdlog "log"
+	"strconv"
 
-	"github.com/golang-migrate/migrate"
-	_ "github.com/golang-migrate/migrate/database/postgres"
-	_ "github.com/golang-migrate/migrate/source/file"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"go.uber.org/zap"
 
+	"reviewer-service/database"
 	"reviewer-service/internal/config"
 	"reviewer-service/internal/logger"
 )
 
+const usage = `usage: migrate -config_path=<file> [-migration_path=<dir>] <command> [arg]
+
+commands:
+  up [N]     apply all pending migrations, or the next N
+  down N     roll back the last N migrations
+  goto V     migrate up or down to version V
+  version    print the current version and dirty flag
+  force V    set the version without running migrations, to recover a dirty database`
+
 func main() {
 	var configPath, migrationPath string
 
 	flag.StringVar(&configPath, "config_path", "", "Path to the config file")
-	flag.StringVar(&migrationPath, "migration_path", "", "Path to the migration file")
+	flag.StringVar(&migrationPath, "migration_path", "", "Directory to read migrations from instead of the embedded ones")
+	flag.Usage = func() { fmt.Fprintln(flag.CommandLine.Output(), usage) }
 	flag.Parse()
 
+	args := flag.Args()
+	if len(args) == 0 {
+		args = []string{"up"}
+	}
+
 	cfg, err := config.New(configPath)
 	if err != nil {
 		stdlog.Fatal(err)
@@ -32,7 +50,7 @@ func main() {
 		stdlog.Fatal(err)
 	}
 
-	url := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
+	url := fmt.Sprintf("pgx5://%s:%s@%s:%s/%s?sslmode=disable",
 		cfg.Postgres.User,
 		cfg.Postgres.Password,
 		cfg.Postgres.Host,
@@ -40,15 +58,126 @@ func main() {
 		cfg.Postgres.Database,
 	)
 
-	migration, err := migrate.New("file://"+migrationPath, url)
+	migration, err := newMigrate(migrationPath, url)
 	if err != nil {
 		log.Fatal("failed to create migration", zap.Error(err))
 	}
+	defer migration.Close()
+
+	err = run(migration, args, log)
+	if errors.Is(err, migrate.ErrNoChange) {
+		log.Info("no change")
+		return
+	}
+
+	if err != nil {
+		log.Fatal("failed to run migration", zap.String("command", args[0]), zap.Error(err))
+	}
+}
+
+func newMigrate(migrationPath, url string) (*migrate.Migrate, error) {
+	if migrationPath != "" {
+		return migrate.New("file://"+migrationPath, url)
+	}
+
+	src, err := iofs.New(database.Migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+
+	return migrate.NewWithSourceInstance("iofs", src, url)
+}
+
+func run(m *migrate.Migrate, args []string, log *zap.Logger) error {
+	command, args := args[0], args[1:]
+
+	switch command {
+	case "up":
+		if len(args) == 0 {
+			err := m.Up()
+			if err != nil {
+				return err
+			}
+
+			break
+		}
+
+		n, err := parseArg(command, args)
+		if err != nil {
+			return err
+		}
+
+		err = m.Steps(int(n))
+		if err != nil {
+			return err
+		}
+
+	case "down":
+		// A bare down would roll back every migration, so the step count is required.
+		n, err := parseArg(command, args)
+		if err != nil {
+			return err
+		}
+
+		err = m.Steps(-int(n))
+		if err != nil {
+			return err
+		}
+
+	case "goto":
+		v, err := parseArg(command, args)
+		if err != nil {
+			return err
+		}
+
+		err = m.Migrate(v)
+		if err != nil {
+			return err
+		}
+
+	case "force":
+		v, err := parseArg(command, args)
+		if err != nil {
+			return err
+		}
+
+		err = m.Force(int(v))
+		if err != nil {
+			return err
+		}
+
+	case "version":
+		if len(args) != 0 {
+			return fmt.Errorf("version takes no arguments")
+		}
+
+	default:
+		return fmt.Errorf("unknown command: %s\n%s", command, usage)
+	}
+
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		log.Info("no migrations applied")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get version: %w", err)
+	}
+
+	log.Info("current version", zap.Uint("version", version), zap.Bool("dirty", dirty))
+	return nil
+}
+
+func parseArg(command string, args []string) (uint, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("%s takes exactly one argument\n%s", command, usage)
+	}
 
-	err = migration.Up()
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		log.Fatal("failed to run migration", zap.Error(err))
+	n, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil || (n == 0 && command != "force") {
+		return 0, fmt.Errorf("%s: invalid argument %q", command, args[0])
 	}
 
-	log.Info("successfully migrated")
+	return uint(n), nil
 }
@@ -6,16 +6,37 @@ import (
 	"flag"
 	"fmt"
 	stdlog "log"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"go.uber.org/zap"
 
+	"reviewer-service/internal/api/handler"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/authz"
+	"reviewer-service/internal/buildinfo"
 	"reviewer-service/internal/config"
+	"reviewer-service/internal/events"
+	"reviewer-service/internal/grpcapi"
+	"reviewer-service/internal/health"
+	"reviewer-service/internal/idempotency"
 	"reviewer-service/internal/logger"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/notifier/email"
+	"reviewer-service/internal/notifier/slack"
+	"reviewer-service/internal/ratelimit"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/memory"
 	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/sqlite"
+	"reviewer-service/internal/retention"
 	"reviewer-service/internal/server"
+	"reviewer-service/internal/service"
+	"reviewer-service/internal/validation"
 )
 
 func main() {
@@ -26,7 +47,7 @@ func main() {
 	)
 	defer cancel()
 
-	configPath := fetchConfigPath()
+	configPath, storage := fetchFlags()
 	if configPath == "" {
 		stdlog.Fatal("config path must specify")
 	}
@@ -42,46 +63,165 @@ func main() {
 	}
 	defer log.Sync()
 
-	pgClient, err := postgres.New(ctx, &cfg.Postgres, log)
+	if storage != "" {
+		cfg.Storage = storage
+	}
+
+	repo, err := newRepository(ctx, cfg, log)
+	if err != nil {
+		log.Fatal("cannot initialize storage", zap.String("storage", cfg.Storage), zap.Error(err))
+	}
+
+	broker := events.NewBroker(&cfg.Events, repo, log)
+
+	// Slack and email are delivered in the background, the broker only writes to storage and
+	// stays in the request so that the event is saved before the response.
+	var external notifier.Multi
+	if len(cfg.Slack.Webhooks) > 0 {
+		external = append(external, slack.New(&cfg.Slack, repo, log))
+	}
+
+	if cfg.Email.Host != "" {
+		emailClient, err := email.New(&cfg.Email, repo, log)
+		if err != nil {
+			log.Fatal("cannot initialize email notifier", zap.Error(err))
+		}
+
+		external = append(external, emailClient)
+	}
+
+	queue := notifier.NewQueue(&cfg.Notify, external, log)
+	notifiers := notifier.Multi{broker, queue}
+
+	go broker.Run(ctx)
+	go notifier.RunReminder(ctx, &cfg.Reminder, repo, notifiers, log)
+	go retention.Run(ctx, &cfg.Retention, repo, log)
+
+	authenticator, err := auth.New(&cfg.Auth, repo, log)
+	if err != nil {
+		log.Fatal("cannot initialize authentication", zap.Error(err))
+	}
+
+	authorizer := authz.New(repo, log)
+
+	// Only postgres can share the buckets between instances, other storages leave store nil.
+	store, _ := repo.(ratelimit.Store)
+
+	throttler, err := ratelimit.New(&cfg.RateLimit, store, log)
+	if err != nil {
+		log.Fatal("cannot initialize rate limiting", zap.Error(err))
+	}
+
+	keeper := idempotency.New(&cfg.Idempotency, repo, log)
+
+	validator, err := validation.New(&cfg.Validation, log)
+	if err != nil {
+		log.Fatal("cannot initialize request validation", zap.Error(err))
+	}
+
+	schemas, err := validation.NewSchemas()
+	if err != nil {
+		log.Fatal("cannot initialize grpc request validation", zap.Error(err))
+	}
+
+	deprecation, err := handler.Deprecation(cfg.HTTP.V1DeprecatedAt, cfg.HTTP.V1Sunset)
 	if err != nil {
-		log.Fatal("cannot initialize postgres", zap.Error(err))
+		log.Fatal("cannot initialize v1 deprecation headers", zap.Error(err))
 	}
 
-	router := server.NewRouter(pgClient, log, &cfg.Logger, cfg.HTTP.Timeout)
+	svc := service.New(repo, notifiers, rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())), log)
+
+	// The memory storage has nothing to check and leaves checker nil.
+	checker, _ := repo.(health.Checker)
+	prober := health.New(&cfg.Health, checker, log)
+
+	router := server.NewRouter(repo, svc, broker, log, &cfg.Logger, authenticator, authorizer, throttler, validator, keeper, cfg.HTTP.Timeout, deprecation, prober)
 	addr := fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port)
 
 	srv := http.Server{
 		Addr:    addr,
 		Handler: router,
 	}
+	srv.RegisterOnShutdown(broker.Close)
 
 	go func() {
-		log.Info("starting http server", zap.String("addr", srv.Addr))
+		log.Info("starting http server", zap.String("addr", srv.Addr), zap.String("version", buildinfo.Version))
 		if err = srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Error("failed to start server", zap.Error(err))
 		}
 	}()
 
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
+	grpcServer := grpcapi.NewGRPCServer(
+		grpcapi.New(svc, cfg.HTTP.Timeout, log),
+		grpcapi.Interceptors(authenticator, throttler, schemas, authorizer, log),
+	)
+
+	grpcListener, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatal("cannot listen grpc address", zap.String("addr", grpcAddr), zap.Error(err))
+	}
+
+	go func() {
+		log.Info("starting grpc server", zap.String("addr", grpcAddr))
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Error("failed to start grpc server", zap.Error(err))
+		}
+	}()
+
 	<-ctx.Done()
+	log.Info("received shutdown signal")
+
+	// /readyz fails first and the server keeps serving, so that load balancers drain this instance
+	// before it stops accepting connections.
+	prober.Drain()
+	time.Sleep(cfg.Health.DrainDelay)
+
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
 	defer shutdownCancel()
 
-	log.Info("received shutdown signal")
+	err = grpcapi.Shutdown(shutdownCtx, grpcServer)
+	if err != nil {
+		log.Error("grpc server did not stop in time, cancelled the remaining calls", zap.Error(err))
+	}
 
-	pgClient.Close()
 	err = srv.Shutdown(shutdownCtx)
 	if err != nil {
 		log.Error("failed to shutdown server", zap.Error(err))
 	}
 
+	// Queued notifications still read users, so they are delivered before the storage closes.
+	queue.Close()
+
+	// The storage outlives the requests that srv.Shutdown waited for.
+	repo.Close()
+
 	log.Info("application shutdown completed successfully")
 }
 
-func fetchConfigPath() string {
-	var path string
+func fetchFlags() (string, string) {
+	var path, storage string
 
 	flag.StringVar(&path, "config_path", "", "Path to the config file")
+	flag.StringVar(&storage, "storage", "", "Storage backend: postgres, sqlite or memory, overrides STORAGE")
 	flag.Parse()
 
-	return path
+	return path, storage
+}
+
+func newRepository(ctx context.Context, cfg *config.Config, log *zap.Logger) (repository.Repository, error) {
+	switch cfg.Storage {
+	case "postgres":
+		return postgres.New(ctx, &cfg.Postgres, log)
+
+	case "sqlite":
+		return sqlite.New(ctx, &cfg.SQLite, log)
+
+	case "memory":
+		log.Warn("using in-memory storage, data is lost on shutdown")
+		return memory.New(log), nil
+
+	default:
+		return nil, fmt.Errorf("unknown storage: %s", cfg.Storage)
+	}
 }
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"reviewer-service/internal/api"
+)
+
+// stringList collects a repeated flag.
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+func teamAdd(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	team, err := parseTeam("team add", args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AddTeamWithResponse(ctx, *team)
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return teamResult(&resp.JSON201.Team), nil
+}
+
+func teamGet(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("team get takes exactly one team name")
+	}
+
+	return getTeam(ctx, client, args[0])
+}
+
+// teamUpdate goes through the bulk import endpoint, which creates or updates members in one transaction.
+func teamUpdate(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	team, err := parseTeam("team update", args)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"team_name", "user_id", "username", "email", "is_active"})
+	for _, m := range team.Members {
+		w.Write([]string{team.TeamName, m.UserId, m.Username, m.Email, strconv.FormatBool(m.IsActive)})
+	}
+	w.Flush()
+
+	if len(team.Members) == 0 {
+		return nil, fmt.Errorf("team update needs at least one -m member")
+	}
+
+	resp, err := client.ImportTeamsWithBodyWithResponse(ctx, &api.ImportTeamsParams{}, "text/csv", &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return getTeam(ctx, client, team.TeamName)
+}
+
+func userSetActive(ctx context.Context, client *api.ClientWithResponses, args []string, isActive bool) (*result, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("expected exactly one user id")
+	}
+
+	resp, err := client.SetIsActiveWithResponse(ctx, api.SetIsActiveJSONRequestBody{UserId: args[0], IsActive: isActive})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	user := resp.JSON200.User
+	return &result{
+		value:   resp.JSON200,
+		headers: []string{"USER_ID", "USERNAME", "EMAIL", "TEAM", "ACTIVE"},
+		rows:    [][]string{{user.UserId, user.Username, user.Email, user.TeamName, strconv.FormatBool(user.IsActive)}},
+	}, nil
+}
+
+func prCreate(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	author := fs.String("author", "", "Author user id")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if *author == "" || fs.NArg() != 2 {
+		return nil, fmt.Errorf("usage: pr create -author <user_id> <pr_id> <name>")
+	}
+
+	resp, err := client.CreatePullRequestWithResponse(ctx, api.CreatePullRequestJSONRequestBody{
+		PullRequestId:   fs.Arg(0),
+		PullRequestName: fs.Arg(1),
+		AuthorId:        *author,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return prResult(resp.JSON201, &resp.JSON201.PullRequest), nil
+}
+
+func prMerge(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: pr merge <pr_id>")
+	}
+
+	resp, err := client.MergePullRequestWithResponse(ctx, api.MergePullRequestJSONRequestBody{PullRequestId: args[0]})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return prResult(resp.JSON200, &resp.JSON200.PullRequest), nil
+}
+
+func prReassign(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	fs := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+	old := fs.String("old", "", "Reviewer to replace")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if *old == "" || fs.NArg() != 1 {
+		return nil, fmt.Errorf("usage: pr reassign -old <user_id> <pr_id>")
+	}
+
+	resp, err := client.ReassignPullRequestWithResponse(ctx, api.ReassignPullRequestJSONRequestBody{
+		PullRequestId: fs.Arg(0),
+		OldUserId:     *old,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := prResult(resp.JSON200, &resp.JSON200.PullRequest)
+	res.summary = fmt.Sprintf("%s replaced by %s", *old, resp.JSON200.ReplacedBy)
+
+	return res, nil
+}
+
+func prList(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	fs := flag.NewFlagSet("pr list", flag.ContinueOnError)
+	reviewer := fs.String("reviewer", "", "List pull requests assigned to this user")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if *reviewer == "" || fs.NArg() != 0 {
+		return nil, fmt.Errorf("usage: pr list -reviewer <user_id>")
+	}
+
+	resp, err := client.GetReviewWithResponse(ctx, &api.GetReviewParams{UserId: reviewer})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &result{value: resp.JSON200, headers: []string{"PR_ID", "NAME", "AUTHOR", "STATUS"}}
+	for _, pr := range resp.JSON200.PullRequests {
+		res.rows = append(res.rows, []string{pr.PullRequestId, pr.PullRequestName, pr.AuthorId, string(pr.Status)})
+	}
+
+	return res, nil
+}
+
+func stats(ctx context.Context, client *api.ClientWithResponses) (*result, error) {
+	resp, err := client.GetStatsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	s := resp.JSON200
+	res := &result{
+		value:   s,
+		summary: fmt.Sprintf("pull requests: %d open, %d merged", s.PullRequests.Open, s.PullRequests.Merged),
+		headers: []string{"REVIEWER", "ASSIGNED", "OPEN"},
+	}
+	for _, r := range s.Reviewers {
+		res.rows = append(res.rows, []string{r.UserId, strconv.Itoa(r.Assigned), strconv.Itoa(r.Open)})
+	}
+
+	return res, nil
+}
+
+func getTeam(ctx context.Context, client *api.ClientWithResponses, name string) (*result, error) {
+	resp, err := client.GetTeamWithResponse(ctx, &api.GetTeamParams{TeamName: name})
+	if err != nil {
+		return nil, err
+	}
+
+	err = check(resp.HTTPResponse, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return teamResult(resp.JSON200), nil
+}
+
+// parseTeam reads "[-m id:username[:email]]... [-inactive id]... <team>".
+func parseTeam(name string, args []string) (*api.Team, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	var members, inactive stringList
+	fs.Var(&members, "m", "Member as id:username[:email], repeatable")
+	fs.Var(&inactive, "inactive", "Id of a member to mark inactive, repeatable")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if fs.NArg() != 1 {
+		return nil, fmt.Errorf("%s takes exactly one team name after the flags", name)
+	}
+
+	team := &api.Team{TeamName: fs.Arg(0), Members: make([]api.TeamMember, 0, len(members))}
+	for _, spec := range members {
+		parts := strings.SplitN(spec, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid member %q, want id:username[:email]", spec)
+		}
+
+		member := api.TeamMember{UserId: parts[0], Username: parts[1], IsActive: !slices.Contains(inactive, parts[0])}
+		if len(parts) == 3 {
+			member.Email = parts[2]
+		}
+
+		team.Members = append(team.Members, member)
+	}
+
+	return team, nil
+}
+
+func teamResult(team *api.Team) *result {
+	res := &result{
+		value:   team,
+		summary: "team " + team.TeamName,
+		headers: []string{"USER_ID", "USERNAME", "EMAIL", "ACTIVE"},
+	}
+	for _, m := range team.Members {
+		res.rows = append(res.rows, []string{m.UserId, m.Username, m.Email, strconv.FormatBool(m.IsActive)})
+	}
+
+	return res
+}
+
+func prResult(value any, pr *api.PullRequestV1) *result {
+	return &result{
+		value:   value,
+		headers: []string{"PR_ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "CREATED", "MERGED"},
+		rows: [][]string{{
+			pr.PullRequestId,
+			pr.PullRequestName,
+			pr.AuthorId,
+			string(pr.Status),
+			strings.Join(pr.AssignedReviewers, ","),
+			formatTime(pr.CreatedAt),
+			formatTime(pr.MergedAt),
+		}},
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Local().Format(time.DateTime)
+}
+
+// check turns a non-2xx response into an error with the detail, code and field errors sent by the API.
+func check(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var problem api.Problem
+
+	err := json.Unmarshal(body, &problem)
+	if err != nil || problem.Detail == "" {
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	msg := fmt.Sprintf("%s: %s (%s)", resp.Status, problem.Detail, problem.Code)
+	for _, e := range problem.Errors {
+		if e.Line > 0 {
+			msg += fmt.Sprintf("\n  line %d: %s %s", e.Line, e.Field, e.Message)
+			continue
+		}
+
+		msg += fmt.Sprintf("\n  %s: %s", e.Field, e.Message)
+	}
+
+	return fmt.Errorf("%s", msg)
+}
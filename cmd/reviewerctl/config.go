@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultBaseURL = "http://localhost:8080"
+	defaultOutput  = outputTable
+)
+
+// config is read from a YAML file, flags given on the command line take precedence:
+//
+//	base_url: https://reviewer.example.com
+//	api_key: secret
+//	output: table
+type config struct {
+	BaseURL string `yaml:"base_url"`
+	APIKey  string `yaml:"api_key"`
+	Output  string `yaml:"output"`
+}
+
+// loadConfig reads path, or REVIEWERCTL_CONFIG, or reviewerctl/config.yaml in the user config dir.
+// Only an explicitly given file has to exist.
+func loadConfig(path string) (*config, error) {
+	cfg := &config{BaseURL: defaultBaseURL, Output: defaultOutput}
+
+	explicit := path != ""
+	if !explicit {
+		path = os.Getenv("REVIEWERCTL_CONFIG")
+		explicit = path != ""
+	}
+
+	if !explicit {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return cfg, nil
+		}
+
+		path = filepath.Join(dir, "reviewerctl", "config.yaml")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return cfg, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	err = yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
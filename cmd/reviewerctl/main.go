@@ -0,0 +1,142 @@
+// Command reviewerctl operates reviewer-service through its HTTP API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"reviewer-service/internal/api"
+)
+
+const usage = `usage: reviewerctl [-config file] [-url url] [-api-key key] [-o table|json|yaml] <command> [flags] [args]
+
+commands:
+  team add [-m id:username[:email]]... [-inactive id]... <team>
+  team get <team>
+  team update [-m id:username[:email]]... [-inactive id]... <team>   create or update members, moving them if needed
+  user activate <user_id>
+  user deactivate <user_id>
+  pr create -author <user_id> <pr_id> <name>
+  pr merge <pr_id>
+  pr reassign -old <user_id> <pr_id>
+  pr list -reviewer <user_id>
+  stats
+
+Flags of a command go before its arguments. The config file is YAML with base_url, api_key and output,
+it is read from -config, REVIEWERCTL_CONFIG or reviewerctl/config.yaml in the user config directory.`
+
+const requestTimeout = 30 * time.Second
+
+// apiKeyHeader carries the key from the config file, the server checks it when API keys are enabled.
+const apiKeyHeader = "X-API-Key"
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	err := run(ctx, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "reviewerctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reviewerctl", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprintln(fs.Output(), usage) }
+
+	configPath := fs.String("config", "", "Path to the config file")
+	baseURL := fs.String("url", "", "Base URL of the service, overrides base_url")
+	apiKey := fs.String("api-key", "", "API key, overrides api_key")
+	output := fs.String("o", "", "Output format: table, json or yaml, overrides output")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if *baseURL != "" {
+		cfg.BaseURL = *baseURL
+	}
+
+	if *apiKey != "" {
+		cfg.APIKey = *apiKey
+	}
+
+	if *output != "" {
+		cfg.Output = *output
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("command is required")
+	}
+
+	client, err := api.NewClientWithResponses(cfg.BaseURL,
+		api.WithHTTPClient(&http.Client{Timeout: requestTimeout}),
+		api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if cfg.APIKey != "" {
+				req.Header.Set(apiKeyHeader, cfg.APIKey)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	res, err := dispatch(ctx, client, fs.Args())
+	if err != nil {
+		return err
+	}
+
+	return printResult(os.Stdout, cfg.Output, res)
+}
+
+func dispatch(ctx context.Context, client *api.ClientWithResponses, args []string) (*result, error) {
+	group, args := args[0], args[1:]
+
+	if group == "stats" {
+		return stats(ctx, client)
+	}
+
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%s: subcommand is required\n%s", group, usage)
+	}
+
+	command, args := args[0], args[1:]
+
+	switch group + " " + command {
+	case "team add":
+		return teamAdd(ctx, client, args)
+	case "team get":
+		return teamGet(ctx, client, args)
+	case "team update":
+		return teamUpdate(ctx, client, args)
+	case "user activate":
+		return userSetActive(ctx, client, args, true)
+	case "user deactivate":
+		return userSetActive(ctx, client, args, false)
+	case "pr create":
+		return prCreate(ctx, client, args)
+	case "pr merge":
+		return prMerge(ctx, client, args)
+	case "pr reassign":
+		return prReassign(ctx, client, args)
+	case "pr list":
+		return prList(ctx, client, args)
+	default:
+		return nil, fmt.Errorf("unknown command: %s %s\n%s", group, command, usage)
+	}
+}
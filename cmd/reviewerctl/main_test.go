@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/api/handler"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository/memory"
+	"reviewer-service/internal/service"
+)
+
+// newClient talks to the API handlers over a memory store, without the server middleware.
+func newClient(t *testing.T) *api.ClientWithResponses {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	svc := service.New(repo, notifier.Multi{}, rand.New(rand.NewPCG(1, 2)), zap.NewNop())
+	h := handler.New(repo, svc, nil, time.Second, zap.NewNop())
+
+	router := chi.NewRouter()
+	api.HandlerWithOptions(api.NewStrictHandlerWithOptions(h, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handler.RequestErrorHandler(zap.NewNop()),
+		ResponseErrorHandlerFunc: handler.ResponseErrorHandler(zap.NewNop()),
+	}), api.ChiServerOptions{BaseRouter: router})
+
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClientWithResponses(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClientWithResponses: %v", err)
+	}
+
+	return client
+}
+
+func TestCommands(t *testing.T) {
+	client := newClient(t)
+
+	// Steps run in order against one store. A step either prints rows or fails with an error
+	// containing every string of wantErr.
+	steps := []struct {
+		args    string
+		rows    [][]string
+		summary string
+		wantErr []string
+	}{
+		{
+			args: "team add -m u1:Alice:alice@example.com -m u2:Bob -m u3:Carol -inactive u3 backend",
+			rows: [][]string{{"u1", "Alice", "alice@example.com", "true"}, {"u2", "Bob", "", "true"}, {"u3", "Carol", "", "false"}},
+		},
+		{
+			// One bad member fails the whole update, the third member is on line 4 after the header.
+			args:    "team update -m u4:Dave -m u2:Robert -m u@5:Eve backend",
+			wantErr: []string{"422", api.CodeInvalidRows, "line 4: user_id"},
+		},
+		{
+			args: "team get backend",
+			rows: [][]string{{"u1", "Alice", "alice@example.com", "true"}, {"u2", "Bob", "", "true"}, {"u3", "Carol", "", "false"}},
+		},
+		{
+			args: "team update -m u4:Dave -m u5:Eve -inactive u5 frontend",
+			rows: [][]string{{"u4", "Dave", "", "true"}, {"u5", "Eve", "", "false"}},
+		},
+		{
+			args: "pr create -author u1 pr-1 Add-search",
+			rows: [][]string{{"pr-1", "Add-search", "u1", "OPEN", "u2", "", "-"}},
+		},
+		{
+			args: "pr list -reviewer u2",
+			rows: [][]string{{"pr-1", "Add-search", "u1", "OPEN"}},
+		},
+		{
+			args:    "pr reassign -old u2 pr-1",
+			wantErr: []string{"409", api.CodeNoCandidate},
+		},
+		{
+			args:    "pr create -author u4 pr-2 Lonely",
+			wantErr: []string{"409", api.CodeNoReviewers},
+		},
+		{
+			args: "pr merge pr-1",
+			rows: [][]string{{"pr-1", "Add-search", "u1", "MERGED", "u2", "", ""}},
+		},
+		{
+			args: "user deactivate u2",
+			rows: [][]string{{"u2", "Bob", "", "backend", "false"}},
+		},
+		{
+			args:    "stats",
+			summary: "pull requests: 0 open, 1 merged",
+		},
+		{
+			args:    "team get missing",
+			wantErr: []string{"404", api.CodeNotFound},
+		},
+		{
+			args:    "pr create pr-3 name",
+			wantErr: []string{"usage: pr create"},
+		},
+		{
+			args:    "team add -m u6 qa",
+			wantErr: []string{`invalid member "u6"`},
+		},
+		{
+			args:    "team drop backend",
+			wantErr: []string{"unknown command: team drop"},
+		},
+	}
+
+	for _, s := range steps {
+		res, err := dispatch(context.Background(), client, strings.Fields(s.args))
+
+		if s.wantErr != nil {
+			if err == nil {
+				t.Errorf("%s: succeeded, want an error", s.args)
+				continue
+			}
+
+			for _, want := range s.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("%s: error %q does not contain %q", s.args, err, want)
+				}
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: %v", s.args, err)
+			continue
+		}
+
+		// Times depend on the clock, only their presence is checked.
+		for _, row := range res.rows {
+			if len(row) == 7 && row[5] != "-" {
+				row[5] = ""
+			}
+			if len(row) == 7 && row[6] != "-" {
+				row[6] = ""
+			}
+		}
+
+		if s.rows != nil && !reflect.DeepEqual(res.rows, s.rows) {
+			t.Errorf("%s: rows = %q, want %q", s.args, res.rows, s.rows)
+		}
+
+		if s.summary != "" && res.summary != s.summary {
+			t.Errorf("%s: summary = %q, want %q", s.args, res.summary, s.summary)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	body, err := json.Marshal(api.Problem{
+		Status: http.StatusUnprocessableEntity,
+		Code:   api.CodeInvalidRows,
+		Detail: "2 validation errors",
+		Errors: []api.ProblemFieldError{
+			{Line: 3, Field: "user_id", Message: "is required"},
+			{Field: "team_name", Message: "is too long"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := &http.Response{StatusCode: http.StatusUnprocessableEntity, Status: "422 Unprocessable Entity"}
+
+	want := "422 Unprocessable Entity: 2 validation errors (INVALID_ROWS)\n  line 3: user_id is required\n  team_name: is too long"
+	if err := check(resp, body); err == nil || err.Error() != want {
+		t.Errorf("check = %v, want %q", err, want)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}
+	if err := check(resp, []byte("upstream down\n")); err == nil || err.Error() != "502 Bad Gateway: upstream down" {
+		t.Errorf("check(non-problem) = %v", err)
+	}
+
+	if err := check(&http.Response{StatusCode: http.StatusNoContent}, nil); err != nil {
+		t.Errorf("check(204) = %v, want nil", err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("REVIEWERCTL_CONFIG", "")
+
+	// Without any file the defaults apply.
+	cfg, err := loadConfig("")
+	if err != nil || *cfg != (config{BaseURL: defaultBaseURL, Output: defaultOutput}) {
+		t.Errorf("loadConfig = %+v, %v, want the defaults", cfg, err)
+	}
+
+	path := filepath.Join(dir, "ctl.yaml")
+
+	err = os.WriteFile(path, []byte("base_url: https://reviewer.example.com\napi_key: secret\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("REVIEWERCTL_CONFIG", path)
+
+	cfg, err = loadConfig("")
+	want := config{BaseURL: "https://reviewer.example.com", APIKey: "secret", Output: defaultOutput}
+	if err != nil || *cfg != want {
+		t.Errorf("loadConfig(env) = %+v, %v, want %+v", cfg, err, want)
+	}
+
+	// Only an explicitly named file has to exist.
+	_, err = loadConfig(filepath.Join(dir, "missing.yaml"))
+	if err == nil {
+		t.Error("loadConfig(missing file): want an error")
+	}
+}
+
+func TestPrintResult(t *testing.T) {
+	res := &result{
+		value:   map[string]any{"team_name": "backend"},
+		summary: "team backend",
+		headers: []string{"USER_ID", "ACTIVE"},
+		rows:    [][]string{{"u1", "true"}},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{outputTable, "team backend\nUSER_ID  ACTIVE\nu1       true\n"},
+		{outputJSON, "{\n  \"team_name\": \"backend\"\n}\n"},
+		{outputYAML, "team_name: backend\n"},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+
+		err := printResult(&buf, tt.format, res)
+		if err != nil || buf.String() != tt.want {
+			t.Errorf("%s: printResult = %q, %v, want %q", tt.format, buf.String(), err, tt.want)
+		}
+	}
+
+	if err := printResult(&bytes.Buffer{}, "xml", res); err == nil {
+		t.Error("printResult(xml): want an error")
+	}
+}
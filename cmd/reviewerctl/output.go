@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// result is what a command prints: value as is for json and yaml, headers and rows for a table.
+type result struct {
+	value   any
+	summary string
+	headers []string
+	rows    [][]string
+}
+
+func printResult(w io.Writer, format string, res *result) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(res.value)
+
+	case outputYAML:
+		// Going through JSON keeps the field names of the API instead of the Go ones.
+		data, err := json.Marshal(res.value)
+		if err != nil {
+			return err
+		}
+
+		var value any
+
+		err = json.Unmarshal(data, &value)
+		if err != nil {
+			return err
+		}
+
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		defer encoder.Close()
+
+		return encoder.Encode(value)
+
+	case outputTable:
+		if res.summary != "" {
+			fmt.Fprintln(w, res.summary)
+		}
+
+		if len(res.headers) == 0 {
+			return nil
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(res.headers, "\t"))
+		for _, row := range res.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+
+		return tw.Flush()
+
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+}
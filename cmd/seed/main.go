@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	stdlog "log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/config"
+	"reviewer-service/internal/logger"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/sqlite"
+	"reviewer-service/internal/seed"
+	"reviewer-service/internal/service"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	opts := seed.DefaultOptions()
+
+	var configPath, storage, end string
+
+	flag.StringVar(&configPath, "config_path", "", "Path to the config file")
+	flag.StringVar(&storage, "storage", "", "Storage backend: postgres or sqlite, overrides STORAGE")
+	flag.Uint64Var(&opts.Seed, "seed", opts.Seed, "Random seed, the same seed and flags give the same data")
+	flag.StringVar(&opts.Prefix, "prefix", opts.Prefix, "Prefix of generated team, user and pull request ids")
+	flag.IntVar(&opts.Teams, "teams", opts.Teams, "Number of teams")
+	flag.IntVar(&opts.UsersPerTeam, "users", opts.UsersPerTeam, "Users per team")
+	flag.IntVar(&opts.PullRequests, "prs", opts.PullRequests, "Number of pull requests")
+	flag.StringVar(&end, "end", opts.End.Format(time.DateOnly), "Date the generated history ends, YYYY-MM-DD in UTC")
+	flag.DurationVar(&opts.Span, "span", opts.Span, "Length of the generated history")
+	flag.Float64Var(&opts.MergeRatio, "merge_ratio", opts.MergeRatio, "Share of pull requests that get merged")
+	flag.DurationVar(&opts.MergeMedian, "merge_median", opts.MergeMedian, "Median time from creation to merge")
+	flag.Float64Var(&opts.InactiveRatio, "inactive_ratio", opts.InactiveRatio, "Share of inactive users")
+	flag.Parse()
+
+	cfg, err := config.New(configPath)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+
+	log, err := logger.New(&cfg.Logger)
+	if err != nil {
+		stdlog.Fatal(err)
+	}
+	defer log.Sync()
+
+	if storage != "" {
+		cfg.Storage = storage
+	}
+
+	opts.End, err = time.Parse(time.DateOnly, end)
+	if err != nil {
+		log.Fatal("invalid end date", zap.String("end", end), zap.Error(err))
+	}
+
+	repo, err := newRepository(ctx, cfg, log)
+	if err != nil {
+		log.Fatal("cannot initialize storage", zap.String("storage", cfg.Storage), zap.Error(err))
+	}
+	defer repo.Close()
+
+	// Seeded pull requests notify nobody, reviewers come from the seed like the rest of the data.
+	svc := service.New(repo, notifier.Multi{}, seed.NewRand(opts.Seed), log)
+
+	report, err := seed.Generate(ctx, repo, svc, opts)
+	if err != nil {
+		log.Fatal("seed failed", zap.Error(err))
+	}
+
+	fmt.Printf("teams: %d, users: %d (%d inactive), pull requests: %d created, %d merged, %d skipped\n",
+		report.Teams, report.Users, report.Inactive, report.Created, report.Merged, report.Skipped)
+}
+
+func newRepository(ctx context.Context, cfg *config.Config, log *zap.Logger) (repository.Repository, error) {
+	switch cfg.Storage {
+	case "postgres":
+		return postgres.New(ctx, &cfg.Postgres, log)
+
+	case "sqlite":
+		return sqlite.New(ctx, &cfg.SQLite, log)
+
+	default:
+		return nil, fmt.Errorf("unsupported storage: %s", cfg.Storage)
+	}
+}
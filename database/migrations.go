@@ -0,0 +1,53 @@
+package database
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+// Migrations holds the postgres schema migrations in golang-migrate file naming.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// LatestVersion is the version of the newest embedded migration, a database below it has pending migrations.
+func LatestVersion() (uint, error) {
+	entries, err := fs.ReadDir(Migrations, "migrations")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	var latest uint
+	for _, e := range entries {
+		var version uint
+
+		_, err = fmt.Sscanf(e.Name(), "%d_", &version)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse migration version: %s: %w", e.Name(), err)
+		}
+
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
+
+// CheckVersion tells whether a schema at version is usable by this build: it must not be dirty and must have
+// every embedded migration applied. A newer schema is fine, it belongs to a rollout in progress.
+func CheckVersion(version uint, dirty bool) error {
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", version)
+	}
+
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if version < latest {
+		return fmt.Errorf("pending migrations: schema version %d, latest %d", version, latest)
+	}
+
+	return nil
+}
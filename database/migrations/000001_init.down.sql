@@ -1,3 +1 @@
 drop schema if exists reviewer_service cascade;
-
-
@@ -0,0 +1 @@
+alter table reviewer_service.users drop column if exists email;
@@ -0,0 +1 @@
+alter table reviewer_service.users add column if not exists email text;
@@ -0,0 +1,16 @@
+create table if not exists reviewer_service.events(
+    event_id bigserial primary key,
+    event_type text not null,
+    team_name text not null,
+    pull_request_id text not null,
+    pull_request_name text not null,
+    author_id text not null,
+    status text not null,
+    assigned_reviewers text[] not null,
+    replaced_user_id text,
+    user_ids text[] not null,
+    created_at timestamptz not null default now()
+);
+
+create index if not exists events_team_name_idx on reviewer_service.events(team_name, event_id);
+create index if not exists events_user_ids_idx on reviewer_service.events using gin(user_ids);
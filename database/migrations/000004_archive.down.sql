@@ -0,0 +1,5 @@
+drop index if exists reviewer_service.pull_requests_merged_at_idx;
+
+alter table reviewer_service.pull_requests drop column if exists archived_at;
+alter table reviewer_service.users drop column if exists archived_at;
+alter table reviewer_service.teams drop column if exists archived_at;
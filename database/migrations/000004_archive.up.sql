@@ -0,0 +1,5 @@
+alter table reviewer_service.teams add column if not exists archived_at timestamptz;
+alter table reviewer_service.users add column if not exists archived_at timestamptz;
+alter table reviewer_service.pull_requests add column if not exists archived_at timestamptz;
+
+create index if not exists pull_requests_merged_at_idx on reviewer_service.pull_requests(merged_at) where status = 'MERGED';
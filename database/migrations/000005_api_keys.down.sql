@@ -0,0 +1,3 @@
+alter table reviewer_service.events drop column if exists actor;
+
+drop table if exists reviewer_service.api_keys;
@@ -0,0 +1,10 @@
+create table if not exists reviewer_service.api_keys(
+    key_id text primary key,
+    name text not null,
+    key_hash bytea not null unique,
+    scopes text[] not null,
+    created_at timestamptz not null,
+    revoked_at timestamptz
+);
+
+alter table reviewer_service.events add column if not exists actor text;
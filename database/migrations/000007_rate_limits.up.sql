@@ -0,0 +1,5 @@
+create table if not exists reviewer_service.rate_limits(
+    key text primary key,
+    tokens double precision not null,
+    updated_at timestamptz not null
+);
@@ -0,0 +1 @@
+drop table if exists reviewer_service.idempotency_keys;
@@ -0,0 +1,13 @@
+create table if not exists reviewer_service.idempotency_keys(
+    scope text not null,
+    key text not null,
+    request_hash bytea not null,
+    status_code integer not null default 0,
+    content_type text not null default '',
+    body bytea,
+    created_at timestamptz not null,
+    expires_at timestamptz not null,
+    primary key (scope, key)
+);
+
+create index if not exists idempotency_keys_expires_at_idx on reviewer_service.idempotency_keys (expires_at);
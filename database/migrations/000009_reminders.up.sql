@@ -0,0 +1,6 @@
+create table if not exists reviewer_service.reminders(
+    pull_request_id text not null references reviewer_service.pull_requests on delete cascade,
+    user_id text not null,
+    reminded_at timestamptz not null,
+    primary key (pull_request_id, user_id)
+);
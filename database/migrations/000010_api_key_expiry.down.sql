@@ -0,0 +1 @@
+alter table reviewer_service.api_keys drop column if exists expires_at;
@@ -0,0 +1 @@
+alter table reviewer_service.api_keys add column if not exists expires_at timestamptz;
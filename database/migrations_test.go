@@ -0,0 +1,44 @@
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"reviewer-service/database"
+)
+
+func TestCheckVersion(t *testing.T) {
+	latest, err := database.LatestVersion()
+	if err != nil || latest == 0 {
+		t.Fatalf("LatestVersion = %d, %v, want the newest embedded migration", latest, err)
+	}
+
+	tests := []struct {
+		name    string
+		version uint
+		dirty   bool
+		wantErr string
+	}{
+		{"Current", latest, false, ""},
+		{"Newer", latest + 1, false, ""},
+		{"Pending", latest - 1, false, "pending migrations"},
+		{"NeverMigrated", 0, false, "pending migrations"},
+		{"Dirty", latest, true, "dirty"},
+		{"DirtyNewer", latest + 1, true, "dirty"},
+	}
+
+	for _, tt := range tests {
+		err := database.CheckVersion(tt.version, tt.dirty)
+
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: CheckVersion = %v, want nil", tt.name, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: CheckVersion = %v, want an error containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -1,47 +1,99 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
 const (
-	CodeTeamExists  = "TEAM_EXISTS"
-	CodePRExists    = "PR_EXISTS"
-	CodePRMerged    = "PR_MERGED"
-	CodeNotAssigned = "NOT_ASSIGNED"
-	CodeNoCandidate = "NO_CANDIDATE"
-	CodeNotFound    = "NOT_FOUND"
+	CodeTeamExists   = "TEAM_EXISTS"
+	CodePRExists     = "PR_EXISTS"
+	CodePRMerged     = "PR_MERGED"
+	CodeNotAssigned  = "NOT_ASSIGNED"
+	CodeNoCandidate  = "NO_CANDIDATE"
+	CodePRChanged    = "PR_CHANGED"
+	CodeNoReviewers  = "NO_REVIEWERS"
+	CodeNotFound     = "NOT_FOUND"
+	CodeBadRequest   = "BAD_REQUEST"
+	CodeInvalid      = "VALIDATION_FAILED"
+	CodeTooLarge     = "REQUEST_TOO_LARGE"
+	CodeInvalidRows  = "INVALID_ROWS"
+	CodeTeamArchived = "TEAM_ARCHIVED"
+	CodeUnauthorized = "UNAUTHORIZED"
+	CodeForbidden    = "FORBIDDEN"
+	CodeRateLimited  = "RATE_LIMITED"
+	CodeNotReady     = "NOT_READY"
+	CodeInternal     = "INTERNAL"
+
+	CodeIdempotencyKeyReused  = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyInProgress = "IDEMPOTENCY_IN_PROGRESS"
 )
 
 const (
-	ErrTeamExists  = "already exists"
-	ErrPRExists    = "PR id already exists"
-	ErrPRMerged    = "cannot reassign on merged PR"
-	ErrNotAssigned = "reviewer is not assigned to this PR"
-	ErrNoCandidate = "no active replacement candidate in team"
-	ErrNotFound    = "not found"
+	ErrTeamExists   = "already exists"
+	ErrPRExists     = "PR id already exists"
+	ErrPRMerged     = "cannot reassign on merged PR"
+	ErrNotAssigned  = "reviewer is not assigned to this PR"
+	ErrNoCandidate  = "no active replacement candidate in team"
+	ErrPRChanged    = "pull request changed concurrently, retry"
+	ErrNoReviewers  = "no active reviewer candidate in author's team"
+	ErrNotFound     = "not found"
+	ErrTeamArchived = "team is archived"
+	ErrUnauthorized = "invalid or missing credentials"
+	ErrForbidden    = "caller lacks the required scope"
+	ErrNotAllowed   = "caller's role does not allow this action"
+	ErrRateLimited  = "rate limit exceeded"
+	ErrNotReady     = "storage is not ready"
+	ErrShuttingDown = "service is shutting down"
+	ErrInternal     = "internal error"
+
+	ErrReadBody              = "failed to read body"
+	ErrInvalidRequest        = "request does not match the API schema"
+	ErrMethodNotAllowed      = "method not allowed"
+	ErrIdempotencyKey        = "Idempotency-Key must be at most 255 characters"
+	ErrIdempotencyKeyReused  = "Idempotency-Key was used with a different request"
+	ErrIdempotencyInProgress = "request with this Idempotency-Key is in progress"
 )
 
-type apiError struct {
-	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
-	} `json:"error"`
+// ContentTypeProblem is the media type of every error response, RFC 7807.
+const ContentTypeProblem = "application/problem+json"
+
+// NewProblem builds an error response, ctx supplies the request id and the route.
+func NewProblem(ctx context.Context, status int, code string, detail string, fieldErrors ...ProblemFieldError) Problem {
+	p := Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Code:      ProblemCode(code),
+		Detail:    detail,
+		RequestId: middleware.GetReqID(ctx),
+		Errors:    fieldErrors,
+	}
+
+	if rctx := chi.RouteContext(ctx); rctx != nil {
+		p.Instance = rctx.RoutePattern()
+	}
+
+	return p
 }
 
-func WriteApiError(w http.ResponseWriter, logger *zap.Logger, message string, code string, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+// WriteProblem answers outside of the generated handlers: in middlewares and for routing errors.
+func WriteProblem(w http.ResponseWriter, r *http.Request, logger *zap.Logger, status int, code string, detail string, fieldErrors ...ProblemFieldError) {
+	p := NewProblem(r.Context(), status, code, detail, fieldErrors...)
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
 
-	e := apiError{}
-	e.Error.Code = code
-	e.Error.Message = message
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.WriteHeader(status)
 
-	err := json.NewEncoder(w).Encode(e)
+	err := json.NewEncoder(w).Encode(p)
 	if err != nil {
-		logger.Error("WriteError: failed to encoding response", zap.Error(err))
+		logger.Error("WriteProblem: failed to encode response", zap.Error(err))
 	}
 }
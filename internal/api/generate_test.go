@@ -0,0 +1,53 @@
+package api_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedCodeUpToDate fails when openapi.gen.go was not regenerated after a change of openapi.yml.
+func TestGeneratedCodeUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the code generator")
+	}
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "openapi.gen.go")
+
+	// The output path in the config wins over -o, so the generator gets a copy that writes to dir.
+	config, err := os.ReadFile("oapi-codegen.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config = bytes.Replace(config, []byte("output: openapi.gen.go"), []byte("output: "+out), 1)
+
+	configPath := filepath.Join(dir, "oapi-codegen.yaml")
+	err = os.WriteFile(configPath, config, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "tool", "oapi-codegen", "--config="+configPath, "../../openapi.yml")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("oapi-codegen: %v\n%s", err, output)
+	}
+
+	want, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile("openapi.gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Fatal("openapi.gen.go is out of date with openapi.yml, run go generate ./internal/api")
+	}
+}
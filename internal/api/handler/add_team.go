@@ -2,74 +2,41 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
 	"reviewer-service/internal/api"
-	"reviewer-service/internal/domain"
 	"reviewer-service/internal/repository"
 )
 
-func AddTeam(repo repository.Repository, requestTimeout time.Duration, logger *zap.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
-		defer cancel()
+func (h *Handler) AddTeam(ctx context.Context, request api.AddTeamRequestObject) (api.AddTeamResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
 
-		var team api.Team
-		err := json.NewDecoder(r.Body).Decode(&team)
-		if err != nil {
-			logger.Warn("AddTeam: failed to decode body", zap.Error(err))
-			writeError(w, logger, "failed to decode body", http.StatusBadRequest)
-			return
-		}
+	team := request.Body
 
-		members := make([]domain.TeamMember, len(team.Members))
-		for i, m := range team.Members {
-			members[i] = domain.TeamMember{
-				UserID:   m.UserID,
-				UserName: m.UserName,
-				IsActive: m.IsActive,
-			}
-		}
+	err := h.svc.CreateTeam(ctx, toDomainTeam(*team))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTeamAlreadyExists):
+			h.logger.Warn("AddTeam: team already exists", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", team.TeamName, api.ErrTeamExists)
+			return api.AddTeam400ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeTeamExists, msg)), nil
 
-		dTeam := &domain.Team{
-			TeamName: team.TeamName,
-			Members:  members,
+		case errors.Is(err, repository.ErrDuplicateKey):
+			h.logger.Warn("AddTeam: duplicate key", zap.Error(err))
+			return api.AddTeam400ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, "duplicate key")), nil
 		}
 
-		err = repo.SaveTeam(ctx, dTeam)
-		if err != nil {
-			switch {
-			case errors.Is(err, repository.ErrTeamAlreadyExists):
-				logger.Warn("AddTeam: team already exists", zap.Error(err))
-				msg := fmt.Sprintf("%s %s", team.TeamName, api.ErrTeamExists)
-				api.WriteApiError(w, logger, msg, api.CodeTeamExists, http.StatusBadRequest)
-				return
-
-			case errors.Is(err, repository.ErrDuplicateKey):
-				logger.Warn("AddTeam: duplicate key", zap.Error(err))
-				writeError(w, logger, "duplicate key", http.StatusBadRequest)
-				return
-			}
-
-			logger.Error("AddTeam: failed to save team", zap.Error(err))
-			writeError(w, logger, "failed to save team", http.StatusInternalServerError)
-			return
-		}
-
-		resp := map[string]api.Team{"team": team}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		err = json.NewEncoder(w).Encode(resp)
-		if err != nil {
-			logger.Error("AddTeam: failed to encode response", zap.Error(err))
-		}
-
-		logger.Info("AddTeam: successfully saved team", zap.String("team_name", team.TeamName))
+		h.logger.Error("AddTeam: failed to save team", zap.Error(err))
+		return api.AddTeam500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to save team")),
+		}, nil
 	}
+
+	h.logger.Info("AddTeam: successfully saved team", zap.String("team_name", team.TeamName))
+	return api.AddTeam201JSONResponse{Team: *team}, nil
 }
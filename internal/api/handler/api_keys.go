@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/repository"
+)
+
+func (h *Handler) IssueApiKey(ctx context.Context, request api.IssueApiKeyRequestObject) (api.IssueApiKeyResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	secret, key, err := auth.NewKey(req.Name, scopes, time.Now(), req.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnknownScope) || errors.Is(err, auth.ErrNoScopes) || errors.Is(err, auth.ErrInvalidExpiry) {
+			h.logger.Warn("IssueApiKey: invalid key", zap.Error(err))
+			return api.IssueApiKey400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, err.Error())),
+			}, nil
+		}
+
+		h.logger.Error("IssueApiKey: failed to generate key", zap.Error(err))
+		return api.IssueApiKey500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to generate key")),
+		}, nil
+	}
+
+	err = h.repo.SaveAPIKey(ctx, key)
+	if err != nil {
+		h.logger.Error("IssueApiKey: failed to save key", zap.Error(err))
+		return api.IssueApiKey500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to save key")),
+		}, nil
+	}
+
+	h.logger.Info("IssueApiKey: successfully issued key", zap.String("key_id", key.KeyID), zap.Strings("scopes", key.Scopes))
+	return api.IssueApiKey201JSONResponse{Key: toAPIKey(key), Secret: secret}, nil
+}
+
+func (h *Handler) ListApiKeys(ctx context.Context, _ api.ListApiKeysRequestObject) (api.ListApiKeysResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	keys, err := h.repo.ListAPIKeys(ctx)
+	if err != nil {
+		h.logger.Error("ListApiKeys: failed to list keys", zap.Error(err))
+		return api.ListApiKeys500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to list keys")),
+		}, nil
+	}
+
+	resp := api.ListApiKeys200JSONResponse{Keys: make([]api.APIKey, len(keys))}
+	for i, key := range keys {
+		resp.Keys[i] = toAPIKey(key)
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) RevokeApiKey(ctx context.Context, request api.RevokeApiKeyRequestObject) (api.RevokeApiKeyResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	key, err := h.repo.RevokeAPIKey(ctx, req.KeyId, time.Now())
+	if err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			h.logger.Warn("RevokeApiKey: key not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.KeyId, api.ErrNotFound)
+			return api.RevokeApiKey404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("RevokeApiKey: failed to revoke key", zap.String("key_id", req.KeyId), zap.Error(err))
+		return api.RevokeApiKey500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to revoke key")),
+		}, nil
+	}
+
+	h.logger.Info("RevokeApiKey: successfully revoked key", zap.String("key_id", req.KeyId))
+	return api.RevokeApiKey200JSONResponse{Key: toAPIKey(*key)}, nil
+}
@@ -0,0 +1,119 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/api/handler"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/repository/memory"
+)
+
+func TestAPIKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	h := handler.New(repo, nil, nil, time.Second, zap.NewNop())
+
+	authenticator, err := auth.New(&auth.Config{Enabled: true, AdminKey: "bootstrap-secret"}, repo, zap.NewNop())
+	if err != nil {
+		t.Fatalf("auth.New: %v", err)
+	}
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	resp, err := h.IssueApiKey(ctx, api.IssueApiKeyRequestObject{Body: &api.IssueApiKeyJSONRequestBody{
+		Name:      "ci",
+		Scopes:    []api.APIKeyScope{api.APIKeyScopeWrite},
+		ExpiresAt: &expiresAt,
+	}})
+	if err != nil {
+		t.Fatalf("IssueApiKey: %v", err)
+	}
+
+	issued, ok := resp.(api.IssueApiKey201JSONResponse)
+	if !ok {
+		t.Fatalf("IssueApiKey = %T, want 201", resp)
+	}
+
+	if issued.Secret == "" || issued.Key.ExpiresAt == nil || !issued.Key.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("issued = %+v, want a secret and the expiry", issued)
+	}
+
+	identity, err := authenticator.Authenticate(ctx, issued.Secret, "")
+	if err != nil || identity.KeyID != issued.Key.KeyId || !identity.Allows(auth.ScopeWrite) || identity.Allows(auth.ScopeAdmin) {
+		t.Errorf("Authenticate(issued) = %+v, %v, want the issued write key", identity, err)
+	}
+
+	listed, err := h.ListApiKeys(ctx, api.ListApiKeysRequestObject{})
+	if err != nil {
+		t.Fatalf("ListApiKeys: %v", err)
+	}
+
+	keys, ok := listed.(api.ListApiKeys200JSONResponse)
+	if !ok || len(keys.Keys) != 1 || keys.Keys[0].KeyId != issued.Key.KeyId || keys.Keys[0].RevokedAt != nil {
+		t.Errorf("ListApiKeys = %+v, want the issued key", listed)
+	}
+
+	revoked, err := h.RevokeApiKey(ctx, api.RevokeApiKeyRequestObject{Body: &api.RevokeApiKeyJSONRequestBody{KeyId: issued.Key.KeyId}})
+	if err != nil {
+		t.Fatalf("RevokeApiKey: %v", err)
+	}
+
+	if r, ok := revoked.(api.RevokeApiKey200JSONResponse); !ok || r.Key.RevokedAt == nil {
+		t.Errorf("RevokeApiKey = %+v, want the revoked key", revoked)
+	}
+
+	_, err = authenticator.Authenticate(ctx, issued.Secret, "")
+	if !auth.IsUnauthorized(err) {
+		t.Errorf("Authenticate(revoked) error = %v, want unauthorized", err)
+	}
+
+	revoked, err = h.RevokeApiKey(ctx, api.RevokeApiKeyRequestObject{Body: &api.RevokeApiKeyJSONRequestBody{KeyId: "unknown"}})
+	if err != nil {
+		t.Fatalf("RevokeApiKey(unknown): %v", err)
+	}
+
+	if _, ok := revoked.(api.RevokeApiKey404ApplicationProblemPlusJSONResponse); !ok {
+		t.Errorf("RevokeApiKey(unknown) = %T, want 404", revoked)
+	}
+}
+
+func TestIssueApiKeyRejectsInvalidKeys(t *testing.T) {
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	h := handler.New(repo, nil, nil, time.Second, zap.NewNop())
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		body api.IssueApiKeyJSONRequestBody
+	}{
+		{"NoScopes", api.IssueApiKeyJSONRequestBody{Name: "ci"}},
+		{"UnknownScope", api.IssueApiKeyJSONRequestBody{Name: "ci", Scopes: []api.APIKeyScope{"root"}}},
+		{"ExpiresInPast", api.IssueApiKeyJSONRequestBody{Name: "ci", Scopes: []api.APIKeyScope{api.APIKeyScopeRead}, ExpiresAt: &past}},
+	}
+
+	for _, tt := range tests {
+		resp, err := h.IssueApiKey(context.Background(), api.IssueApiKeyRequestObject{Body: &tt.body})
+		if err != nil {
+			t.Fatalf("%s: IssueApiKey: %v", tt.name, err)
+		}
+
+		if _, ok := resp.(api.IssueApiKey400ApplicationProblemPlusJSONResponse); !ok {
+			t.Errorf("%s: IssueApiKey = %T, want 400", tt.name, resp)
+		}
+	}
+
+	keys, _ := repo.ListAPIKeys(context.Background())
+	if len(keys) != 0 {
+		t.Errorf("stored %d keys, want none", len(keys))
+	}
+}
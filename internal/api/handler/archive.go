@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/repository"
+)
+
+func (h *Handler) ArchiveTeam(ctx context.Context, request api.ArchiveTeamRequestObject) (api.ArchiveTeamResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	archivedAt, err := h.svc.ArchiveTeam(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("ArchiveTeam: team not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.TeamName, api.ErrNotFound)
+			return api.ArchiveTeam404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("ArchiveTeam: failed to archive team", zap.String("team_name", req.TeamName), zap.Error(err))
+		return api.ArchiveTeam500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive team")),
+		}, nil
+	}
+
+	h.logger.Info("ArchiveTeam: successfully archived team", zap.String("team_name", req.TeamName))
+	return api.ArchiveTeam200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestoreTeam(ctx context.Context, request api.RestoreTeamRequestObject) (api.RestoreTeamResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	err := h.svc.RestoreTeam(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("RestoreTeam: team not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.TeamName, api.ErrNotFound)
+			return api.RestoreTeam404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("RestoreTeam: failed to restore team", zap.String("team_name", req.TeamName), zap.Error(err))
+		return api.RestoreTeam500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore team")),
+		}, nil
+	}
+
+	h.logger.Info("RestoreTeam: successfully restored team", zap.String("team_name", req.TeamName))
+	return api.RestoreTeam204Response{}, nil
+}
+
+func (h *Handler) ArchiveUser(ctx context.Context, request api.ArchiveUserRequestObject) (api.ArchiveUserResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	archivedAt, err := h.svc.ArchiveUser(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.logger.Warn("ArchiveUser: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.UserId, api.ErrNotFound)
+			return api.ArchiveUser404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("ArchiveUser: failed to archive user", zap.String("user_id", req.UserId), zap.Error(err))
+		return api.ArchiveUser500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive user")),
+		}, nil
+	}
+
+	h.logger.Info("ArchiveUser: successfully archived user", zap.String("user_id", req.UserId))
+	return api.ArchiveUser200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestoreUser(ctx context.Context, request api.RestoreUserRequestObject) (api.RestoreUserResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	err := h.svc.RestoreUser(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.logger.Warn("RestoreUser: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.UserId, api.ErrNotFound)
+			return api.RestoreUser404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		if errors.Is(err, repository.ErrTeamArchived) {
+			h.logger.Warn("RestoreUser: team is archived", zap.Error(err))
+			return api.RestoreUser409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeTeamArchived, api.ErrTeamArchived)), nil
+		}
+
+		h.logger.Error("RestoreUser: failed to restore user", zap.String("user_id", req.UserId), zap.Error(err))
+		return api.RestoreUser500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore user")),
+		}, nil
+	}
+
+	h.logger.Info("RestoreUser: successfully restored user", zap.String("user_id", req.UserId))
+	return api.RestoreUser204Response{}, nil
+}
+
+func (h *Handler) ArchivePullRequest(ctx context.Context, request api.ArchivePullRequestRequestObject) (api.ArchivePullRequestResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	archivedAt, err := h.svc.ArchivePR(ctx, req.PullRequestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.logger.Warn("ArchivePullRequest: pull request not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.PullRequestId, api.ErrNotFound)
+			return api.ArchivePullRequest404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("ArchivePullRequest: failed to archive pull request", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
+		return api.ArchivePullRequest500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive pull request")),
+		}, nil
+	}
+
+	h.logger.Info("ArchivePullRequest: successfully archived pull request", zap.String("pull_request_id", req.PullRequestId))
+	return api.ArchivePullRequest200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestorePullRequest(ctx context.Context, request api.RestorePullRequestRequestObject) (api.RestorePullRequestResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	err := h.svc.RestorePR(ctx, req.PullRequestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.logger.Warn("RestorePullRequest: pull request not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.PullRequestId, api.ErrNotFound)
+			return api.RestorePullRequest404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("RestorePullRequest: failed to restore pull request", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
+		return api.RestorePullRequest500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore pull request")),
+		}, nil
+	}
+
+	h.logger.Info("RestorePullRequest: successfully restored pull request", zap.String("pull_request_id", req.PullRequestId))
+	return api.RestorePullRequest204Response{}, nil
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/teamimport"
+)
+
+func toAPITeam(team *domain.Team) api.Team {
+	members := make([]api.TeamMember, len(team.Members))
+	for i, m := range team.Members {
+		members[i] = api.TeamMember{
+			UserId:   m.UserID,
+			Username: m.UserName,
+			Email:    m.Email,
+			IsActive: m.IsActive,
+		}
+	}
+
+	return api.Team{
+		TeamName: team.TeamName,
+		Members:  members,
+	}
+}
+
+func toDomainTeam(team api.Team) *domain.Team {
+	members := make([]domain.TeamMember, len(team.Members))
+	for i, m := range team.Members {
+		members[i] = domain.TeamMember{
+			UserID:   m.UserId,
+			UserName: m.Username,
+			Email:    m.Email,
+			IsActive: m.IsActive,
+		}
+	}
+
+	return &domain.Team{
+		TeamName: team.TeamName,
+		Members:  members,
+	}
+}
+
+func toAPIUser(user *domain.User) api.User {
+	resp := api.User{
+		UserId:   user.UserID,
+		Username: user.UserName,
+		Email:    user.Email,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+
+	if user.Role != "" {
+		role := api.Role(user.Role)
+		resp.Role = &role
+	}
+
+	return resp
+}
+
+func toAPIPullRequest(pr *domain.PullRequest) api.PullRequest {
+	return api.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+	}
+}
+
+// toAPIPullRequestV1 keeps the v1 wire shape, with snake_case timestamps.
+func toAPIPullRequestV1(pr *domain.PullRequest) api.PullRequestV1 {
+	return api.PullRequestV1{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+	}
+}
+
+func toAPIPullRequestShort(pr domain.PullRequestShort) api.PullRequestShort {
+	return api.PullRequestShort{
+		PullRequestId:   pr.PullRequestId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorId,
+		Status:          api.PullRequestStatus(pr.Status),
+	}
+}
+
+func toAPIEvent(event domain.Event) api.Event {
+	return api.Event{
+		EventId:        event.EventID,
+		Type:           api.EventType(event.Type),
+		TeamName:       event.TeamName,
+		PullRequest:    toAPIPullRequest(&event.PullRequest),
+		ReplacedUserId: event.ReplacedUserID,
+		Actor:          event.Actor,
+		CreatedAt:      event.CreatedAt,
+	}
+}
+
+func toAPIImportErrors(errs teamimport.Errors) []api.ProblemFieldError {
+	fieldErrors := make([]api.ProblemFieldError, len(errs))
+	for i, e := range errs {
+		fieldErrors[i] = api.ProblemFieldError{Line: e.Line, Field: e.Field, Message: e.Message}
+	}
+
+	return fieldErrors
+}
+
+func toAPIStats(stats *domain.Stats) api.Stats {
+	var resp api.Stats
+	resp.PullRequests.Open = stats.OpenPRs
+	resp.PullRequests.Merged = stats.MergedPRs
+
+	resp.Reviewers = make([]api.ReviewerStats, len(stats.Reviewers))
+	for i, r := range stats.Reviewers {
+		resp.Reviewers[i] = api.ReviewerStats{UserId: r.UserID, Assigned: r.Assigned, Open: r.Open}
+	}
+
+	return resp
+}
+
+func toAPIKey(key domain.APIKey) api.APIKey {
+	scopes := make([]api.APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = api.APIKeyScope(scope)
+	}
+
+	return api.APIKey{
+		KeyId:     key.KeyID,
+		Name:      key.Name,
+		Scopes:    scopes,
+		CreatedAt: key.CreatedAt,
+		RevokedAt: key.RevokedAt,
+		ExpiresAt: key.ExpiresAt,
+	}
+}
@@ -2,87 +2,44 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
 	"reviewer-service/internal/api"
-	"reviewer-service/internal/domain"
 	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
 )
 
-type createPRRequest struct {
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorId        string `json:"author_id"`
-}
-
-func CreatePR(repo repository.Repository, requestTimeout time.Duration, logger *zap.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
-		defer cancel()
-
-		_ = ctx
+func (h *Handler) CreatePullRequest(ctx context.Context, request api.CreatePullRequestRequestObject) (api.CreatePullRequestResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
 
-		var req createPRRequest
-		err := json.NewDecoder(r.Body).Decode(&req)
-		if err != nil {
-			logger.Warn("CreatePR: failed to decode body", zap.Error(err))
-			writeError(w, logger, "failed to decode body", http.StatusBadRequest)
-			return
-		}
-
-		tn := time.Now()
-		pr := domain.PullRequest{
-			PullRequestId:     req.PullRequestId,
-			PullRequestName:   req.PullRequestName,
-			AuthorId:          req.AuthorId,
-			Status:            api.PRStatusOpen,
-			AssignedReviewers: nil,
-			CreatedAt:         &tn,
-			MergedAt:          nil,
-		}
+	req := request.Body
 
-		newPR, err := repo.SavePR(ctx, pr)
-		if err != nil {
-			switch {
-			case errors.Is(err, repository.ErrPRAlreadyExists):
-				logger.Warn("CreatePR: pull request already exists", zap.Error(err))
-				api.WriteApiError(w, logger, api.ErrPRExists, api.CodePRExists, http.StatusConflict)
-				return
+	newPR, err := h.svc.CreatePR(ctx, req.PullRequestId, req.PullRequestName, req.AuthorId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPRAlreadyExists):
+			h.logger.Warn("CreatePR: pull request already exists", zap.Error(err))
+			return api.CreatePullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodePRExists, api.ErrPRExists)), nil
 
-			case errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrTeamNotFound):
-				logger.Warn("CreatePR: not found", zap.Error(err))
-				api.WriteApiError(w, logger, api.ErrNotFound, api.CodeNotFound, http.StatusNotFound)
-				return
-			}
+		case errors.Is(err, service.ErrReviewersNotFound):
+			h.logger.Warn("CreatePR: no reviewer candidate", zap.String("author_id", req.AuthorId), zap.Error(err))
+			return api.CreatePullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeNoReviewers, api.ErrNoReviewers)), nil
 
-			logger.Error("CreatePR: failed to save pull request", zap.Error(err))
-			writeError(w, logger, "failed to save pull request", http.StatusInternalServerError)
-			return
+		case errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrTeamNotFound):
+			h.logger.Warn("CreatePR: not found", zap.Error(err))
+			return api.CreatePullRequest404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, api.ErrNotFound)), nil
 		}
 
-		apiPR := api.PullRequest{
-			PullRequestId:     newPR.PullRequestId,
-			PullRequestName:   newPR.PullRequestName,
-			AuthorId:          newPR.AuthorId,
-			Status:            newPR.Status,
-			AssignedReviewers: newPR.AssignedReviewers,
-			CreatedAt:         newPR.CreatedAt,
-			MergedAt:          newPR.MergedAt,
-		}
-
-		resp := map[string]api.PullRequest{"pull_request": apiPR}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		err = json.NewEncoder(w).Encode(resp)
-		if err != nil {
-			logger.Error("CreatePR: failed to encode response", zap.Error(err))
-		}
-
-		logger.Info("CreatePR: successfully created pull request", zap.String("pull_request_id", apiPR.PullRequestId))
+		h.logger.Error("CreatePR: failed to save pull request", zap.Error(err))
+		return api.CreatePullRequest500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to save pull request")),
+		}, nil
 	}
+
+	h.logger.Info("CreatePR: successfully created pull request", zap.String("pull_request_id", newPR.PullRequestId))
+	return api.CreatePullRequest201JSONResponse{PullRequest: toAPIPullRequestV1(newPR)}, nil
 }
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"reviewer-service/internal/api"
+)
+
+// Deprecation adds the Deprecation header (RFC 9745) with deprecatedAt to every response of the
+// operations marked deprecated in openapi.yml, errors included, and the Sunset header (RFC 8594)
+// when sunset is set. Both dates are YYYY-MM-DD.
+func Deprecation(deprecatedAt string, sunset string) (func(http.Handler) http.Handler, error) {
+	doc, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+
+	deprecatedDay, err := time.Parse(time.DateOnly, deprecatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deprecation date: %w", err)
+	}
+
+	var sunsetAt string
+	if sunset != "" {
+		at, err := time.Parse(time.DateOnly, sunset)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sunset date: %w", err)
+		}
+
+		if at.Before(deprecatedDay) {
+			return nil, fmt.Errorf("sunset date %s is before deprecation date %s", sunset, deprecatedAt)
+		}
+
+		sunsetAt = at.Format(http.TimeFormat)
+	}
+
+	// v1 paths have no parameters, so method and path identify the operation.
+	deprecated := make(map[string]bool)
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.Deprecated {
+				deprecated[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+
+	value := "@" + strconv.FormatInt(deprecatedDay.Unix(), 10)
+
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if deprecated[r.Method+" "+r.URL.Path] {
+				w.Header().Set("Deprecation", value)
+				if sunsetAt != "" {
+					w.Header().Set("Sunset", sunsetAt)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}, nil
+}
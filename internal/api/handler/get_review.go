@@ -2,63 +2,47 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
 	"reviewer-service/internal/api"
-	"reviewer-service/internal/repository"
+	"reviewer-service/internal/auth"
 )
 
-type getReviewResponse struct {
-	UserID       string                 `json:"user_id"`
-	PullRequests []api.PullRequestShort `json:"pull_requests"`
-}
-
-func GetReview(repo repository.Repository, requestTimeout time.Duration, logger *zap.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
-		defer cancel()
-
-		userID := r.URL.Query().Get("user_id")
-		if userID == "" {
-			logger.Warn("GetReview: user_id is required")
-			writeError(w, logger, "user_id is required", http.StatusBadRequest)
-			return
-		}
-
-		reviewers, err := repo.GetReviewers(ctx, userID)
-		if err != nil {
-			logger.Error("failed to get PRs by reviewer", zap.Error(err))
-			writeError(w, logger, "failed to get reviewers", http.StatusInternalServerError)
-			return
-		}
-
-		apiReviewers := make([]api.PullRequestShort, 0, len(reviewers))
-		for _, rr := range reviewers {
-			apiReviewer := api.PullRequestShort{
-				PullRequestId:   rr.PullRequestId,
-				PullRequestName: rr.PullRequestName,
-				AuthorId:        rr.AuthorId,
-				Status:          rr.Status,
-			}
+func (h *Handler) GetReview(ctx context.Context, request api.GetReviewRequestObject) (api.GetReviewResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
 
-			apiReviewers = append(apiReviewers, apiReviewer)
-		}
+	// Callers authenticated with a bearer token see their own reviews by default.
+	userID := auth.UserID(ctx)
+	if request.Params.UserId != nil && *request.Params.UserId != "" {
+		userID = *request.Params.UserId
+	}
 
-		resp := getReviewResponse{
-			UserID:       userID,
-			PullRequests: apiReviewers,
-		}
+	if userID == "" {
+		h.logger.Warn("GetReview: user_id is required")
+		return api.GetReview400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, "user_id is required")),
+		}, nil
+	}
 
-		w.Header().Set("Content-Type", "application/json")
-		err = json.NewEncoder(w).Encode(resp)
-		if err != nil {
-			logger.Error("failed to encode response", zap.Error(err))
-		}
+	reviewers, err := h.svc.GetReviews(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get PRs by reviewer", zap.Error(err))
+		return api.GetReview500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to get reviewers")),
+		}, nil
+	}
 
-		logger.Info("GetReview: success give review")
+	apiReviewers := make([]api.PullRequestShort, 0, len(reviewers))
+	for _, rr := range reviewers {
+		apiReviewers = append(apiReviewers, toAPIPullRequestShort(rr))
 	}
+
+	h.logger.Info("GetReview: success give review")
+	return api.GetReview200JSONResponse{
+		UserId:       userID,
+		PullRequests: apiReviewers,
+	}, nil
 }
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+)
+
+func (h *Handler) GetStats(ctx context.Context, _ api.GetStatsRequestObject) (api.GetStatsResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	stats, err := h.repo.GetStats(ctx)
+	if err != nil {
+		h.logger.Error("GetStats: failed to get stats", zap.Error(err))
+		return api.GetStats500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to get stats")),
+		}, nil
+	}
+
+	h.logger.Info("GetStats: successfully got stats")
+	return api.GetStats200JSONResponse(toAPIStats(stats)), nil
+}
@@ -2,11 +2,9 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -14,51 +12,32 @@ import (
 	"reviewer-service/internal/repository"
 )
 
-func GetTeam(repo repository.Repository, requestTimeout time.Duration, logger *zap.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
-		defer cancel()
+func (h *Handler) GetTeam(ctx context.Context, request api.GetTeamRequestObject) (api.GetTeamResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
 
-		teamName := r.URL.Query().Get("team_name")
-		if teamName == "" {
-			logger.Warn("GetTeam: team_name is required")
-			writeError(w, logger, "team_name is required", http.StatusBadRequest)
-			return
-		}
-
-		team, err := repo.GetTeam(ctx, teamName)
-		if err != nil {
-			if errors.Is(err, repository.ErrTeamNotFound) {
-				logger.Warn("GetTeam: team not found", zap.String("team_name", teamName), zap.Error(err))
-				msg := fmt.Sprintf("%s %s", teamName, api.ErrNotFound)
-				api.WriteApiError(w, logger, msg, api.CodeNotFound, http.StatusNotFound)
-				return
-			}
-			logger.Error("GetTeam: get team failed", zap.Error(err))
-			writeError(w, logger, "get team failed", http.StatusInternalServerError)
-			return
-		}
-
-		members := make([]api.TeamMember, len(team.Members))
-		for i, m := range team.Members {
-			members[i] = api.TeamMember{
-				UserID:   m.UserID,
-				UserName: m.UserName,
-				IsActive: m.IsActive,
-			}
-		}
-
-		apiTeam := api.Team{
-			TeamName: team.TeamName,
-			Members:  members,
-		}
+	teamName := request.Params.TeamName
+	if teamName == "" {
+		h.logger.Warn("GetTeam: team_name is required")
+		return api.GetTeam400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, "team_name is required")),
+		}, nil
+	}
 
-		w.WriteHeader(http.StatusOK)
-		err = json.NewEncoder(w).Encode(apiTeam)
-		if err != nil {
-			logger.Error("GetTeam: failed to encode response", zap.Error(err))
+	team, err := h.svc.GetTeam(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("GetTeam: team not found", zap.String("team_name", teamName), zap.Error(err))
+			msg := fmt.Sprintf("%s %s", teamName, api.ErrNotFound)
+			return api.GetTeam404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
 		}
 
-		logger.Info("GetTeam: successfully give team", zap.String("team_name", teamName))
+		h.logger.Error("GetTeam: get team failed", zap.Error(err))
+		return api.GetTeam500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "get team failed")),
+		}, nil
 	}
+
+	h.logger.Info("GetTeam: successfully give team", zap.String("team_name", teamName))
+	return api.GetTeam200JSONResponse(toAPITeam(team)), nil
 }
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/events"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+// Handler implements api.StrictServerInterface generated from openapi.yml.
+type Handler struct {
+	repo           repository.Repository
+	svc            *service.Service
+	broker         *events.Broker
+	requestTimeout time.Duration
+	logger         *zap.Logger
+}
+
+var _ api.StrictServerInterface = (*Handler)(nil)
+
+func New(repo repository.Repository, svc *service.Service, broker *events.Broker, requestTimeout time.Duration, logger *zap.Logger) *Handler {
+	return &Handler{
+		repo:           repo,
+		svc:            svc,
+		broker:         broker,
+		requestTimeout: requestTimeout,
+		logger:         logger,
+	}
+}
+
+// RequestErrorHandler answers requests whose body or parameters do not match the spec.
+func RequestErrorHandler(logger *zap.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		logger.Warn("invalid request", zap.String("path", r.URL.Path), zap.Error(err))
+
+		var fieldErrors []api.ProblemFieldError
+		if name := paramName(err); name != "" {
+			fieldErrors = append(fieldErrors, api.ProblemFieldError{Field: name, Message: err.Error()})
+		}
+
+		api.WriteProblem(w, r, logger, http.StatusBadRequest, api.CodeBadRequest, err.Error(), fieldErrors...)
+	}
+}
+
+// paramName returns the parameter a binding error is about, or an empty string for body errors.
+func paramName(err error) string {
+	var (
+		required    *api.RequiredParamError
+		header      *api.RequiredHeaderError
+		format      *api.InvalidParamFormatError
+		unmarshal   *api.UnmarshalingParamError
+		tooManyVals *api.TooManyValuesForParamError
+	)
+
+	switch {
+	case errors.As(err, &required):
+		return required.ParamName
+	case errors.As(err, &header):
+		return header.ParamName
+	case errors.As(err, &format):
+		return format.ParamName
+	case errors.As(err, &unmarshal):
+		return unmarshal.ParamName
+	case errors.As(err, &tooManyVals):
+		return tooManyVals.ParamName
+	default:
+		return ""
+	}
+}
+
+// NotFound and MethodNotAllowed answer requests that match no route in the same format as the handlers.
+func NotFound(logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.WriteProblem(w, r, logger, http.StatusNotFound, api.CodeNotFound, api.ErrNotFound)
+	}
+}
+
+func MethodNotAllowed(logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.WriteProblem(w, r, logger, http.StatusMethodNotAllowed, api.CodeBadRequest, api.ErrMethodNotAllowed)
+	}
+}
+
+// Recoverer turns a panic into a 500 problem and logs the stack.
+func Recoverer(logger *zap.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rvr := recover()
+				if rvr == nil {
+					return
+				}
+
+				// Aborted connections are not errors, let net/http handle them.
+				if rvr == http.ErrAbortHandler {
+					panic(rvr)
+				}
+
+				logger.Error("panic while handling request", zap.String("path", r.URL.Path), zap.Any("panic", rvr), zap.Stack("stack"))
+				api.WriteProblem(w, r, logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+			}()
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// ResponseErrorHandler answers when a handler fails to produce a response.
+func ResponseErrorHandler(logger *zap.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		logger.Error("failed to write response", zap.String("path", r.URL.Path), zap.Error(err))
+		api.WriteProblem(w, r, logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+	}
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/teamimport"
+)
+
+func (h *Handler) ImportTeams(ctx context.Context, request api.ImportTeamsRequestObject) (api.ImportTeamsResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	var format string
+	if request.Params.Format != nil {
+		format = string(*request.Params.Format)
+	}
+
+	teams, problem := h.parseImport(ctx, request.ContentType, format, request.Body)
+	if problem != nil {
+		if problem.Status == http.StatusUnprocessableEntity {
+			return api.ImportTeams422ApplicationProblemPlusJSONResponse(*problem), nil
+		}
+
+		return api.ImportTeams400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(*problem)}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	users, err := h.importTeams(ctx, teams, dryRun)
+	if err != nil {
+		return api.ImportTeams500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to import teams")),
+		}, nil
+	}
+
+	return api.ImportTeams200JSONResponse{Teams: len(teams), Users: users, DryRun: dryRun}, nil
+}
+
+// parseImport reads the uploaded file, format overrides the Content-Type. A rejected file comes back
+// as the problem to answer with.
+func (h *Handler) parseImport(ctx context.Context, contentType string, format string, body io.Reader) ([]domain.Team, *api.Problem) {
+	if format == "" {
+		format = teamimport.FormatFromContentType(contentType)
+	}
+
+	if format == "" {
+		msg := fmt.Sprintf("unsupported content type %q, use text/csv, application/yaml or the format parameter", contentType)
+		problem := api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, msg)
+		return nil, &problem
+	}
+
+	teams, err := teamimport.Parse(body, format)
+	if err != nil {
+		var rowErrs teamimport.Errors
+		if errors.As(err, &rowErrs) {
+			h.logger.Warn("ImportTeams: invalid rows", zap.Int("rows", len(rowErrs)))
+			problem := api.NewProblem(ctx, http.StatusUnprocessableEntity, api.CodeInvalidRows, rowErrs.Error(), toAPIImportErrors(rowErrs)...)
+			return nil, &problem
+		}
+
+		h.logger.Warn("ImportTeams: failed to parse file", zap.Error(err))
+		problem := api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, err.Error())
+		return nil, &problem
+	}
+
+	return teams, nil
+}
+
+// importTeams saves the parsed teams unless dryRun is set and returns the number of members.
+func (h *Handler) importTeams(ctx context.Context, teams []domain.Team, dryRun bool) (int, error) {
+	users := 0
+	for _, team := range teams {
+		users += len(team.Members)
+	}
+
+	if !dryRun {
+		err := h.svc.ImportTeams(ctx, teams)
+		if err != nil {
+			h.logger.Error("ImportTeams: failed to import teams", zap.Error(err))
+			return 0, err
+		}
+	}
+
+	h.logger.Info("ImportTeams: successfully imported teams", zap.Int("teams", len(teams)), zap.Int("users", users), zap.Bool("dry_run", dryRun))
+	return users, nil
+}
@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/api/handler"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository/memory"
+	"reviewer-service/internal/service"
+)
+
+func newImportHandler(t *testing.T) (*handler.Handler, *memory.Client) {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	svc := service.New(repo, notifier.Multi{}, rand.New(rand.NewPCG(1, 2)), zap.NewNop())
+	return handler.New(repo, svc, nil, time.Second, zap.NewNop()), repo
+}
+
+func importCSV(t *testing.T, h *handler.Handler, body string, dryRun bool) api.ImportTeamsResponseObject {
+	t.Helper()
+
+	resp, err := h.ImportTeams(context.Background(), api.ImportTeamsRequestObject{
+		Params:      api.ImportTeamsParams{DryRun: &dryRun},
+		ContentType: "text/csv",
+		Body:        strings.NewReader(body),
+	})
+	if err != nil {
+		t.Fatalf("ImportTeams: %v", err)
+	}
+
+	return resp
+}
+
+func TestImportTeamsIsAllOrNothing(t *testing.T) {
+	h, repo := newImportHandler(t)
+
+	// The valid rows around the bad one are not written either.
+	input := "team_name,user_id,username,is_active\n" +
+		"backend,u1,Alice,true\n" +
+		"backend,u 2,Bob,true\n" +
+		"frontend,u3,Carol,maybe\n" +
+		"frontend,u4,Dave,true\n"
+
+	resp := importCSV(t, h, input, false)
+
+	problem, ok := resp.(api.ImportTeams422ApplicationProblemPlusJSONResponse)
+	if !ok {
+		t.Fatalf("ImportTeams = %T, want 422", resp)
+	}
+
+	want := []api.ProblemFieldError{
+		{Line: 3, Field: "user_id"},
+		{Line: 4, Field: "is_active"},
+	}
+
+	if problem.Code != api.CodeInvalidRows || len(problem.Errors) != len(want) {
+		t.Fatalf("problem = %+v, want %s on %+v", problem, api.CodeInvalidRows, want)
+	}
+
+	for i, w := range want {
+		if problem.Errors[i].Line != w.Line || problem.Errors[i].Field != w.Field || problem.Errors[i].Message == "" {
+			t.Errorf("errors[%d] = %+v, want line %d, field %s", i, problem.Errors[i], w.Line, w.Field)
+		}
+	}
+
+	snapshot, err := repo.Dump(context.Background())
+	if err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+
+	if len(snapshot.Teams) != 0 {
+		t.Errorf("rejected import stored %+v", snapshot.Teams)
+	}
+}
+
+func TestImportTeamsDryRun(t *testing.T) {
+	h, repo := newImportHandler(t)
+	input := "team_name,user_id,username,is_active\nbackend,u1,Alice,true\nbackend,u2,Bob,false\n"
+
+	resp := importCSV(t, h, input, true)
+	if r, ok := resp.(api.ImportTeams200JSONResponse); !ok || !r.DryRun || r.Teams != 1 || r.Users != 2 {
+		t.Fatalf("dry run = %+v, want 1 team and 2 users counted", resp)
+	}
+
+	_, err := repo.GetTeam(context.Background(), "backend")
+	if err == nil {
+		t.Error("dry run stored the team")
+	}
+
+	resp = importCSV(t, h, input, false)
+	if r, ok := resp.(api.ImportTeams200JSONResponse); !ok || r.DryRun {
+		t.Fatalf("import = %+v, want 200", resp)
+	}
+
+	team, err := repo.GetTeam(context.Background(), "backend")
+	if err != nil || len(team.Members) != 2 {
+		t.Errorf("GetTeam = %+v, %v, want both members", team, err)
+	}
+}
@@ -2,82 +2,55 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
 
 	"go.uber.org/zap"
 
 	"reviewer-service/internal/api"
 	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
 )
 
-type ReassignPRRequest struct {
-	PullRequestId string `json:"pull_request_id"`
-	OldUserId     string `json:"old_user_id"`
-}
-
-func ReassignPR(repo repository.Repository, requestTimeout time.Duration, logger *zap.Logger) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
-		defer cancel()
-
-		var req ReassignPRRequest
-		err := json.NewDecoder(r.Body).Decode(&req)
-		if err != nil {
-			logger.Warn("ReassignPR: failed to decode body", zap.Error(err))
-			writeError(w, logger, "failed to decode body", http.StatusBadRequest)
-			return
-		}
+func (h *Handler) ReassignPullRequest(ctx context.Context, request api.ReassignPullRequestRequestObject) (api.ReassignPullRequestResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
 
-		pr, err := repo.ReassignReviewer(ctx, req.OldUserId, req.PullRequestId)
-		if err != nil {
-			switch {
-			case errors.Is(err, repository.ErrPRNotFound):
-				logger.Warn("ReassignPR: pull request not found", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
-				api.WriteApiError(w, logger, api.ErrNotFound, api.CodeNotFound, http.StatusNotFound)
-				return
+	req := request.Body
 
-			case errors.Is(err, repository.ErrNoCandidate):
-				logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
-				api.WriteApiError(w, logger, api.ErrNoCandidate, api.CodeNoCandidate, http.StatusConflict)
-				return
+	pr, newReviewer, err := h.svc.ReassignReviewer(ctx, req.PullRequestId, req.OldUserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPRNotFound):
+			h.logger.Warn("ReassignPR: pull request not found", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
+			return api.ReassignPullRequest404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, api.ErrNotFound)), nil
 
-			case errors.Is(err, repository.ErrPRMerged):
-				logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
-				api.WriteApiError(w, logger, api.ErrPRMerged, api.CodePRMerged, http.StatusConflict)
-				return
+		case errors.Is(err, service.ErrNoCandidate):
+			h.logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
+			return api.ReassignPullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeNoCandidate, api.ErrNoCandidate)), nil
 
-			case errors.Is(err, repository.ErrReviewerNotAssigned):
-				logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
-				api.WriteApiError(w, logger, api.ErrNotAssigned, api.CodeNotAssigned, http.StatusConflict)
-				return
-			}
+		case errors.Is(err, service.ErrPRMerged):
+			h.logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
+			return api.ReassignPullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodePRMerged, api.ErrPRMerged)), nil
 
-			logger.Error("ReassignPR: failed to get pull request status", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
-			writeError(w, logger, "failed to get pull request", http.StatusInternalServerError)
-			return
-		}
-
-		apiPR := api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			Status:            pr.Status,
-			AssignedReviewers: pr.AssignedReviewers,
-			CreatedAt:         pr.CreatedAt,
-			MergedAt:          pr.MergedAt,
-		}
+		case errors.Is(err, service.ErrReviewerNotAssigned):
+			h.logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
+			return api.ReassignPullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeNotAssigned, api.ErrNotAssigned)), nil
 
-		resp := map[string]api.PullRequest{"pull_request": apiPR}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		err = json.NewEncoder(w).Encode(resp)
-		if err != nil {
-			logger.Error("ReassignPR: failed to encode response", zap.Error(err))
+		case errors.Is(err, repository.ErrPRChanged):
+			h.logger.Warn("ReassignPR: "+err.Error(), zap.String("pull_request_id", req.PullRequestId))
+			return api.ReassignPullRequest409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodePRChanged, api.ErrPRChanged)), nil
 		}
 
-		logger.Info("ReassignPR: successfully created pull request", zap.String("pull_request_id", apiPR.PullRequestId))
+		h.logger.Error("ReassignPR: failed to get pull request status", zap.String("pull_request_id", req.PullRequestId), zap.Error(err))
+		return api.ReassignPullRequest500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to get pull request")),
+		}, nil
 	}
+
+	h.logger.Info("ReassignPR: successfully reassigned reviewer", zap.String("pull_request_id", pr.PullRequestId))
+	return api.ReassignPullRequest200JSONResponse{
+		PullRequest: toAPIPullRequestV1(pr),
+		ReplacedBy:  newReviewer,
+	}, nil
 }
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+func (h *Handler) SetRole(ctx context.Context, request api.SetRoleRequestObject) (api.SetRoleResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	user, err := h.svc.SetRole(ctx, req.UserId, string(req.Role))
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownRole) {
+			h.logger.Warn("SetRole: unknown role", zap.String("role", string(req.Role)))
+			msg := fmt.Sprintf("unknown role: %s", req.Role)
+			return api.SetRole400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, msg)),
+			}, nil
+		}
+
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.logger.Warn("SetRole: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", req.UserId, api.ErrNotFound)
+			return api.SetRole404ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)), nil
+		}
+
+		h.logger.Error("SetRole: failed to set role", zap.String("user_id", req.UserId), zap.Error(err))
+		return api.SetRole500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to set role")),
+		}, nil
+	}
+
+	h.logger.Info("SetRole: successfully set role", zap.String("user_id", user.UserID), zap.String("role", user.Role))
+	return api.SetRole200JSONResponse{User: toAPIUser(user)}, nil
+}
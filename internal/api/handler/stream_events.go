@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+)
+
+const (
+	streamHeartbeat  = 15 * time.Second
+	streamReplayPage = 500
+)
+
+// eventStream implements api.StreamEventsResponseObject itself because the generated
+// text/event-stream response copies an io.Reader without flushing between events.
+type eventStream struct {
+	ctx    context.Context
+	h      *Handler
+	filter domain.EventFilter
+	// lastID is nil for clients that do not resume.
+	lastID *int64
+}
+
+func (h *Handler) StreamEvents(ctx context.Context, request api.StreamEventsRequestObject) (api.StreamEventsResponseObject, error) {
+	var filter domain.EventFilter
+	if request.Params.UserId != nil {
+		filter.UserID = *request.Params.UserId
+	}
+	if request.Params.TeamName != nil {
+		filter.TeamName = *request.Params.TeamName
+	}
+
+	return eventStream{
+		ctx:    ctx,
+		h:      h,
+		filter: filter,
+		lastID: request.Params.LastEventID,
+	}, nil
+}
+
+func (s eventStream) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	logger := s.h.logger
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		logger.Error("StreamEvents: streaming unsupported")
+		return api.StreamEvents500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(s.ctx, http.StatusInternalServerError, api.CodeInternal, "streaming unsupported")),
+		}.VisitStreamEventsResponse(w)
+	}
+
+	// Subscribe before replaying so that nothing published in between is lost,
+	// duplicates are skipped by event id.
+	sub := s.h.broker.Subscribe(s.filter)
+	defer s.h.broker.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var lastID int64
+	if s.lastID != nil {
+		lastID = *s.lastID
+
+		for {
+			replay, err := s.h.repo.GetEventsAfter(s.ctx, lastID, s.filter, streamReplayPage)
+			if err != nil {
+				logger.Error("StreamEvents: failed to replay events", zap.Error(err))
+				return nil
+			}
+
+			for _, event := range replay {
+				err = writeEvent(w, event)
+				if err != nil {
+					logger.Warn("StreamEvents: failed to write event", zap.Error(err))
+					return nil
+				}
+				lastID = event.EventID
+			}
+			flusher.Flush()
+
+			if len(replay) < streamReplayPage {
+				break
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	logger.Info("StreamEvents: client subscribed", zap.String("user_id", s.filter.UserID), zap.String("team_name", s.filter.TeamName))
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			logger.Info("StreamEvents: client disconnected")
+			return nil
+
+		case <-heartbeat.C:
+			_, err := fmt.Fprint(w, ": heartbeat\n\n")
+			if err != nil {
+				return nil
+			}
+			flusher.Flush()
+
+		case event, ok := <-sub.Events():
+			if !ok {
+				logger.Warn("StreamEvents: subscription dropped")
+				return nil
+			}
+
+			if event.EventID <= lastID {
+				continue
+			}
+
+			err := writeEvent(w, event)
+			if err != nil {
+				logger.Warn("StreamEvents: failed to write event", zap.Error(err))
+				return nil
+			}
+			lastID = event.EventID
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event domain.Event) error {
+	data, err := json.Marshal(toAPIEvent(event))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.EventID, event.Type, data)
+	return err
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+func (h *Handler) CreatePullRequestV2(ctx context.Context, request api.CreatePullRequestV2RequestObject) (api.CreatePullRequestV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	pr, err := h.svc.CreatePR(ctx, req.PullRequestId, req.PullRequestName, req.AuthorId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPRAlreadyExists):
+			h.logger.Warn("CreatePullRequestV2: pull request already exists", zap.Error(err))
+			return api.CreatePullRequestV2409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodePRExists, api.ErrPRExists)), nil
+
+		case errors.Is(err, service.ErrReviewersNotFound):
+			h.logger.Warn("CreatePullRequestV2: no reviewer candidate", zap.String("author_id", req.AuthorId), zap.Error(err))
+			return api.CreatePullRequestV2409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeNoReviewers, api.ErrNoReviewers)), nil
+
+		case errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrTeamNotFound):
+			h.logger.Warn("CreatePullRequestV2: not found", zap.Error(err))
+			msg := fmt.Sprintf("author %s %s", req.AuthorId, api.ErrNotFound)
+			return api.CreatePullRequestV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("CreatePullRequestV2: failed to save pull request", zap.Error(err))
+		return api.CreatePullRequestV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to save pull request")),
+		}, nil
+	}
+
+	h.logger.Info("CreatePullRequestV2: successfully created pull request", zap.String("pull_request_id", pr.PullRequestId))
+	return api.CreatePullRequestV2201JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (h *Handler) MergePullRequestV2(ctx context.Context, request api.MergePullRequestV2RequestObject) (api.MergePullRequestV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	pr, err := h.svc.MergePR(ctx, request.PullRequestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.logger.Warn("MergePullRequestV2: pull request not found", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.PullRequestId, api.ErrNotFound)
+			return api.MergePullRequestV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("MergePullRequestV2: failed to merge pull request", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+		return api.MergePullRequestV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to merge pull request")),
+		}, nil
+	}
+
+	h.logger.Info("MergePullRequestV2: successfully merged pull request", zap.String("pull_request_id", request.PullRequestId))
+	return api.MergePullRequestV2200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (h *Handler) ReassignPullRequestV2(ctx context.Context, request api.ReassignPullRequestV2RequestObject) (api.ReassignPullRequestV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	oldUserID := request.Body.OldUserId
+
+	pr, newReviewer, err := h.svc.ReassignReviewer(ctx, request.PullRequestId, oldUserID)
+	if err != nil {
+		var code, msg string
+
+		switch {
+		case errors.Is(err, repository.ErrPRNotFound):
+			h.logger.Warn("ReassignPullRequestV2: pull request not found", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+			msg = fmt.Sprintf("%s %s", request.PullRequestId, api.ErrNotFound)
+			return api.ReassignPullRequestV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+
+		case errors.Is(err, service.ErrNoCandidate):
+			code, msg = api.CodeNoCandidate, api.ErrNoCandidate
+
+		case errors.Is(err, service.ErrPRMerged):
+			code, msg = api.CodePRMerged, api.ErrPRMerged
+
+		case errors.Is(err, service.ErrReviewerNotAssigned):
+			code, msg = api.CodeNotAssigned, api.ErrNotAssigned
+
+		case errors.Is(err, repository.ErrPRChanged):
+			code, msg = api.CodePRChanged, api.ErrPRChanged
+
+		default:
+			h.logger.Error("ReassignPullRequestV2: failed to reassign reviewer", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+			return api.ReassignPullRequestV2500ApplicationProblemPlusJSONResponse{
+				InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to reassign reviewer")),
+			}, nil
+		}
+
+		h.logger.Warn("ReassignPullRequestV2: "+err.Error(), zap.String("pull_request_id", request.PullRequestId))
+		return api.ReassignPullRequestV2409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, code, msg)), nil
+	}
+
+	h.logger.Info("ReassignPullRequestV2: successfully reassigned reviewer", zap.String("pull_request_id", pr.PullRequestId))
+	return api.ReassignPullRequestV2200JSONResponse{Pr: toAPIPullRequest(pr), ReplacedBy: newReviewer}, nil
+}
+
+func (h *Handler) ArchivePullRequestV2(ctx context.Context, request api.ArchivePullRequestV2RequestObject) (api.ArchivePullRequestV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	archivedAt, err := h.svc.ArchivePR(ctx, request.PullRequestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.logger.Warn("ArchivePullRequestV2: pull request not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.PullRequestId, api.ErrNotFound)
+			return api.ArchivePullRequestV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("ArchivePullRequestV2: failed to archive pull request", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+		return api.ArchivePullRequestV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive pull request")),
+		}, nil
+	}
+
+	h.logger.Info("ArchivePullRequestV2: successfully archived pull request", zap.String("pull_request_id", request.PullRequestId))
+	return api.ArchivePullRequestV2200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestorePullRequestV2(ctx context.Context, request api.RestorePullRequestV2RequestObject) (api.RestorePullRequestV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	err := h.svc.RestorePR(ctx, request.PullRequestId)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			h.logger.Warn("RestorePullRequestV2: pull request not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.PullRequestId, api.ErrNotFound)
+			return api.RestorePullRequestV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("RestorePullRequestV2: failed to restore pull request", zap.String("pull_request_id", request.PullRequestId), zap.Error(err))
+		return api.RestorePullRequestV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore pull request")),
+		}, nil
+	}
+
+	h.logger.Info("RestorePullRequestV2: successfully restored pull request", zap.String("pull_request_id", request.PullRequestId))
+	return api.RestorePullRequestV2204Response{}, nil
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/repository"
+)
+
+func (h *Handler) CreateTeamV2(ctx context.Context, request api.CreateTeamV2RequestObject) (api.CreateTeamV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	team := request.Body
+
+	err := h.svc.CreateTeam(ctx, toDomainTeam(*team))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTeamAlreadyExists):
+			h.logger.Warn("CreateTeamV2: team already exists", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", team.TeamName, api.ErrTeamExists)
+			return api.CreateTeamV2409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeTeamExists, msg)), nil
+
+		case errors.Is(err, repository.ErrDuplicateKey):
+			h.logger.Warn("CreateTeamV2: duplicate key", zap.Error(err))
+			return api.CreateTeamV2400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, "duplicate key")),
+			}, nil
+		}
+
+		h.logger.Error("CreateTeamV2: failed to save team", zap.Error(err))
+		return api.CreateTeamV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to save team")),
+		}, nil
+	}
+
+	h.logger.Info("CreateTeamV2: successfully saved team", zap.String("team_name", team.TeamName))
+	return api.CreateTeamV2201JSONResponse{Team: *team}, nil
+}
+
+func (h *Handler) ImportTeamsV2(ctx context.Context, request api.ImportTeamsV2RequestObject) (api.ImportTeamsV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	var format string
+	if request.Params.Format != nil {
+		format = string(*request.Params.Format)
+	}
+
+	teams, problem := h.parseImport(ctx, request.ContentType, format, request.Body)
+	if problem != nil {
+		if problem.Status == http.StatusUnprocessableEntity {
+			return api.ImportTeamsV2422ApplicationProblemPlusJSONResponse(*problem), nil
+		}
+
+		return api.ImportTeamsV2400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(*problem)}, nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	users, err := h.importTeams(ctx, teams, dryRun)
+	if err != nil {
+		return api.ImportTeamsV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to import teams")),
+		}, nil
+	}
+
+	return api.ImportTeamsV2200JSONResponse{Teams: len(teams), Users: users, DryRun: dryRun}, nil
+}
+
+func (h *Handler) GetTeamV2(ctx context.Context, request api.GetTeamV2RequestObject) (api.GetTeamV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	team, err := h.svc.GetTeam(ctx, request.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("GetTeamV2: team not found", zap.String("team_name", request.TeamName), zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.TeamName, api.ErrNotFound)
+			return api.GetTeamV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("GetTeamV2: get team failed", zap.Error(err))
+		return api.GetTeamV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "get team failed")),
+		}, nil
+	}
+
+	return api.GetTeamV2200JSONResponse(toAPITeam(team)), nil
+}
+
+func (h *Handler) ArchiveTeamV2(ctx context.Context, request api.ArchiveTeamV2RequestObject) (api.ArchiveTeamV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	archivedAt, err := h.svc.ArchiveTeam(ctx, request.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("ArchiveTeamV2: team not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.TeamName, api.ErrNotFound)
+			return api.ArchiveTeamV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("ArchiveTeamV2: failed to archive team", zap.String("team_name", request.TeamName), zap.Error(err))
+		return api.ArchiveTeamV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive team")),
+		}, nil
+	}
+
+	h.logger.Info("ArchiveTeamV2: successfully archived team", zap.String("team_name", request.TeamName))
+	return api.ArchiveTeamV2200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestoreTeamV2(ctx context.Context, request api.RestoreTeamV2RequestObject) (api.RestoreTeamV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	err := h.svc.RestoreTeam(ctx, request.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			h.logger.Warn("RestoreTeamV2: team not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.TeamName, api.ErrNotFound)
+			return api.RestoreTeamV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("RestoreTeamV2: failed to restore team", zap.String("team_name", request.TeamName), zap.Error(err))
+		return api.RestoreTeamV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore team")),
+		}, nil
+	}
+
+	h.logger.Info("RestoreTeamV2: successfully restored team", zap.String("team_name", request.TeamName))
+	return api.RestoreTeamV2204Response{}, nil
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+// UpdateUserV2 applies is_active before role. Both are checked by authz up front, so only a storage
+// failure can leave the first change without the second.
+func (h *Handler) UpdateUserV2(ctx context.Context, request api.UpdateUserV2RequestObject) (api.UpdateUserV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	req := request.Body
+
+	if req.IsActive == nil && req.Role == nil {
+		return api.UpdateUserV2400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, "nothing to update")),
+		}, nil
+	}
+
+	var (
+		user *domain.User
+		err  error
+	)
+
+	if req.IsActive != nil {
+		user, err = h.svc.SetIsActive(ctx, request.UserId, *req.IsActive)
+	}
+
+	if err == nil && req.Role != nil {
+		user, err = h.svc.SetRole(ctx, request.UserId, string(*req.Role))
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			h.logger.Warn("UpdateUserV2: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.UserId, api.ErrNotFound)
+			return api.UpdateUserV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+
+		case errors.Is(err, service.ErrUnknownRole):
+			h.logger.Warn("UpdateUserV2: unknown role", zap.Error(err))
+			return api.UpdateUserV2400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.BadRequestApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusBadRequest, api.CodeBadRequest, err.Error())),
+			}, nil
+		}
+
+		h.logger.Error("UpdateUserV2: failed to update user", zap.String("user_id", request.UserId), zap.Error(err))
+		return api.UpdateUserV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to update user")),
+		}, nil
+	}
+
+	h.logger.Info("UpdateUserV2: successfully updated user", zap.String("user_id", user.UserID))
+	return api.UpdateUserV2200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) GetUserReviewsV2(ctx context.Context, request api.GetUserReviewsV2RequestObject) (api.GetUserReviewsV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	prs, err := h.svc.GetReviews(ctx, request.UserId)
+	if err != nil {
+		h.logger.Error("GetUserReviewsV2: failed to get reviews", zap.String("user_id", request.UserId), zap.Error(err))
+		return api.GetUserReviewsV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to get reviews")),
+		}, nil
+	}
+
+	apiPRs := make([]api.PullRequestShort, 0, len(prs))
+	for _, pr := range prs {
+		apiPRs = append(apiPRs, toAPIPullRequestShort(pr))
+	}
+
+	return api.GetUserReviewsV2200JSONResponse{UserId: request.UserId, PullRequests: apiPRs}, nil
+}
+
+func (h *Handler) ArchiveUserV2(ctx context.Context, request api.ArchiveUserV2RequestObject) (api.ArchiveUserV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	archivedAt, err := h.svc.ArchiveUser(ctx, request.UserId)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			h.logger.Warn("ArchiveUserV2: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.UserId, api.ErrNotFound)
+			return api.ArchiveUserV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+		}
+
+		h.logger.Error("ArchiveUserV2: failed to archive user", zap.String("user_id", request.UserId), zap.Error(err))
+		return api.ArchiveUserV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to archive user")),
+		}, nil
+	}
+
+	h.logger.Info("ArchiveUserV2: successfully archived user", zap.String("user_id", request.UserId))
+	return api.ArchiveUserV2200JSONResponse{ArchivedAt: archivedAt}, nil
+}
+
+func (h *Handler) RestoreUserV2(ctx context.Context, request api.RestoreUserV2RequestObject) (api.RestoreUserV2ResponseObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.requestTimeout)
+	defer cancel()
+
+	err := h.svc.RestoreUser(ctx, request.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			h.logger.Warn("RestoreUserV2: user not found", zap.Error(err))
+			msg := fmt.Sprintf("%s %s", request.UserId, api.ErrNotFound)
+			return api.RestoreUserV2404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NotFoundApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusNotFound, api.CodeNotFound, msg)),
+			}, nil
+
+		case errors.Is(err, repository.ErrTeamArchived):
+			h.logger.Warn("RestoreUserV2: team is archived", zap.Error(err))
+			return api.RestoreUserV2409ApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusConflict, api.CodeTeamArchived, api.ErrTeamArchived)), nil
+		}
+
+		h.logger.Error("RestoreUserV2: failed to restore user", zap.String("user_id", request.UserId), zap.Error(err))
+		return api.RestoreUserV2500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.InternalErrorApplicationProblemPlusJSONResponse(api.NewProblem(ctx, http.StatusInternalServerError, api.CodeInternal, "failed to restore user")),
+		}, nil
+	}
+
+	h.logger.Info("RestoreUserV2: successfully restored user", zap.String("user_id", request.UserId))
+	return api.RestoreUserV2204Response{}, nil
+}
@@ -1,43 +0,0 @@
-package api
-
-import "time"
-
-type Team struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
-}
-
-type TeamMember struct {
-	UserID   string `json:"user_id"`
-	UserName string `json:"username"`
-	IsActive bool   `json:"is_active"`
-}
-
-type User struct {
-	UserID   string `json:"user_id"`
-	UserName string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
-}
-
-const (
-	PRStatusOpen   = "OPEN"
-	PRStatusMerged = "MERGED"
-)
-
-type PullRequest struct {
-	PullRequestId     string     `json:"pull_request_id"`
-	PullRequestName   string     `json:"pull_request_name"`
-	AuthorId          string     `json:"author_id"`
-	Status            string     `json:"status"`
-	AssignedReviewers []string   `json:"assigned_reviewers"`
-	CreatedAt         *time.Time `json:"created_at,omitempty"`
-	MergedAt          *time.Time `json:"merged_at,omitempty"`
-}
-
-type PullRequestShort struct {
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorId        string `json:"author_id"`
-	Status          string `json:"status"`
-}
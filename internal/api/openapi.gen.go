@@ -0,0 +1,11082 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	APIKeyScopeAdmin APIKeyScope = "admin"
+	APIKeyScopeRead  APIKeyScope = "read"
+	APIKeyScopeWrite APIKeyScope = "write"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeASSIGNED   EventType = "ASSIGNED"
+	EventTypeMERGED     EventType = "MERGED"
+	EventTypeREASSIGNED EventType = "REASSIGNED"
+)
+
+// Defines values for ProblemCode.
+const (
+	BADREQUEST            ProblemCode = "BAD_REQUEST"
+	FORBIDDEN             ProblemCode = "FORBIDDEN"
+	IDEMPOTENCYINPROGRESS ProblemCode = "IDEMPOTENCY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED  ProblemCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNAL              ProblemCode = "INTERNAL"
+	INVALIDROWS           ProblemCode = "INVALID_ROWS"
+	NOCANDIDATE           ProblemCode = "NO_CANDIDATE"
+	NOREVIEWERS           ProblemCode = "NO_REVIEWERS"
+	NOTASSIGNED           ProblemCode = "NOT_ASSIGNED"
+	NOTFOUND              ProblemCode = "NOT_FOUND"
+	NOTREADY              ProblemCode = "NOT_READY"
+	PRCHANGED             ProblemCode = "PR_CHANGED"
+	PREXISTS              ProblemCode = "PR_EXISTS"
+	PRMERGED              ProblemCode = "PR_MERGED"
+	RATELIMITED           ProblemCode = "RATE_LIMITED"
+	REQUESTTOOLARGE       ProblemCode = "REQUEST_TOO_LARGE"
+	TEAMARCHIVED          ProblemCode = "TEAM_ARCHIVED"
+	TEAMEXISTS            ProblemCode = "TEAM_EXISTS"
+	UNAUTHORIZED          ProblemCode = "UNAUTHORIZED"
+	VALIDATIONFAILED      ProblemCode = "VALIDATION_FAILED"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for Role.
+const (
+	RoleAdmin  Role = "admin"
+	RoleLead   Role = "lead"
+	RoleMember Role = "member"
+)
+
+// Defines values for ImportTeamsParamsFormat.
+const (
+	ImportTeamsParamsFormatCsv  ImportTeamsParamsFormat = "csv"
+	ImportTeamsParamsFormatYaml ImportTeamsParamsFormat = "yaml"
+)
+
+// Defines values for ImportTeamsV2ParamsFormat.
+const (
+	ImportTeamsV2ParamsFormatCsv  ImportTeamsV2ParamsFormat = "csv"
+	ImportTeamsV2ParamsFormatYaml ImportTeamsV2ParamsFormat = "yaml"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt Нет у бессрочных ключей.
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	KeyId     string        `json:"key_id"`
+	Name      string        `json:"name"`
+	RevokedAt *time.Time    `json:"revoked_at,omitempty"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope defines model for APIKeyScope.
+type APIKeyScope string
+
+// ArchiveResult defines model for ArchiveResult.
+type ArchiveResult struct {
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// BuildInfo defines model for BuildInfo.
+type BuildInfo struct {
+	// BuildTime Время сборки из -ldflags
+	BuildTime string `json:"build_time,omitempty"`
+
+	// Commit Коммит из -ldflags, иначе из VCS-метки Go-тулчейна
+	Commit    string `json:"commit,omitempty"`
+	GoVersion string `json:"go_version"`
+
+	// Version Версия из -ldflags, dev для локальной сборки
+	Version string `json:"version"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	// Actor Идентификатор API-ключа, вызвавшего событие
+	Actor          string      `json:"actor,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	EventId        int64       `json:"event_id"`
+	PullRequest    PullRequest `json:"pull_request"`
+	ReplacedUserId string      `json:"replaced_user_id,omitempty"`
+	TeamName       string      `json:"team_name"`
+	Type           EventType   `json:"type"`
+}
+
+// EventType defines model for Event.Type.
+type EventType string
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Status string `json:"status"`
+}
+
+// Problem Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type Problem struct {
+	Code   ProblemCode `json:"code"`
+	Detail string      `json:"detail"`
+
+	// Errors Ошибки отдельных полей или строк файла
+	Errors []ProblemFieldError `json:"errors,omitempty"`
+
+	// Instance Путь запроса
+	Instance string `json:"instance,omitempty"`
+
+	// RequestId Id запроса из заголовка X-Request-Id или выданный сервисом, он же в логах
+	RequestId string `json:"request_id,omitempty"`
+	Status    int    `json:"status"`
+
+	// Title Текст HTTP-статуса
+	Title string `json:"title"`
+
+	// Type Всегда about:blank, тип ошибки задаёт code
+	Type string `json:"type"`
+}
+
+// ProblemCode defines model for Problem.Code.
+type ProblemCode string
+
+// ProblemFieldError defines model for ProblemFieldError.
+type ProblemFieldError struct {
+	// Field Путь к полю, например members[1].user_id, или имя параметра
+	Field string `json:"field,omitempty"`
+
+	// Line Номер строки во входном файле, начиная с 1, только для импорта
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorId          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"createdAt"`
+	MergedAt          *time.Time        `json:"mergedAt"`
+	PullRequestId     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string            `json:"author_id"`
+	PullRequestId   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Status          PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequestStatus.
+type PullRequestStatus string
+
+// PullRequestV1 PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+type PullRequestV1 struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorId          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	MergedAt          *time.Time        `json:"merged_at,omitempty"`
+	PullRequestId     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	// Assigned Сколько PR назначено пользователю за всё время
+	Assigned int `json:"assigned"`
+
+	// Open Сколько из них ещё открыто
+	Open   int    `json:"open"`
+	UserId string `json:"user_id"`
+}
+
+// Role admin управляет всеми командами, lead — своей командой
+type Role string
+
+// Stats defines model for Stats.
+type Stats struct {
+	PullRequests struct {
+		Merged int `json:"merged"`
+		Open   int `json:"open"`
+	} `json:"pull_requests"`
+
+	// Reviewers Пользователи с хотя бы одним назначением, самые загруженные первыми
+	Reviewers []ReviewerStats `json:"reviewers"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	Email    string `json:"email,omitempty"`
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// User defines model for User.
+type User struct {
+	Email    string `json:"email,omitempty"`
+	IsActive bool   `json:"is_active"`
+
+	// Role admin управляет всеми командами, lead — своей командой
+	Role     *Role  `json:"role,omitempty"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PullRequestIdPath defines model for PullRequestIdPath.
+type PullRequestIdPath = string
+
+// TeamNamePath defines model for TeamNamePath.
+type TeamNamePath = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdPath defines model for UserIdPath.
+type UserIdPath = string
+
+// BadRequest Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type BadRequest = Problem
+
+// Forbidden Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type Forbidden = Problem
+
+// InternalError Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type InternalError = Problem
+
+// NotFound Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type NotFound = Problem
+
+// NotReady Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type NotReady = Problem
+
+// PayloadTooLarge Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type PayloadTooLarge = Problem
+
+// TooManyRequests Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type TooManyRequests = Problem
+
+// Unauthorized Ошибка в формате RFC 7807 (application/problem+json). Машинный код ошибки — в поле code.
+type Unauthorized = Problem
+
+// IssueApiKeyJSONBody defines parameters for IssueApiKey.
+type IssueApiKeyJSONBody struct {
+	// ExpiresAt Момент, после которого ключ не принимается. Без него ключ бессрочный.
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// RevokeApiKeyJSONBody defines parameters for RevokeApiKey.
+type RevokeApiKeyJSONBody struct {
+	KeyId string `json:"key_id"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// UserId Только события, где пользователь автор или ревьювер
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только события команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// LastEventID Продолжить поток после указанного события
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// ArchivePullRequestJSONBody defines parameters for ArchivePullRequest.
+type ArchivePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// ReassignPullRequestJSONBody defines parameters for ReassignPullRequest.
+type ReassignPullRequestJSONBody struct {
+	OldUserId     string `json:"old_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// RestorePullRequestJSONBody defines parameters for RestorePullRequest.
+type RestorePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// ArchiveTeamJSONBody defines parameters for ArchiveTeam.
+type ArchiveTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// ImportTeamsParams defines parameters for ImportTeams.
+type ImportTeamsParams struct {
+	// Format Формат файла, по умолчанию определяется по Content-Type (text/csv или application/yaml)
+	Format *ImportTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Только проверить файл, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportTeamsParamsFormat defines parameters for ImportTeams.
+type ImportTeamsParamsFormat string
+
+// RestoreTeamJSONBody defines parameters for RestoreTeam.
+type RestoreTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// ArchiveUserJSONBody defines parameters for ArchiveUser.
+type ArchiveUserJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetReviewParams defines parameters for GetReview.
+type GetReviewParams struct {
+	// UserId Идентификатор пользователя, по умолчанию вызывающий из bearer-токена
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// RestoreUserJSONBody defines parameters for RestoreUser.
+type RestoreUserJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// SetIsActiveJSONBody defines parameters for SetIsActive.
+type SetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// SetRoleJSONBody defines parameters for SetRole.
+type SetRoleJSONBody struct {
+	// Role admin управляет всеми командами, lead — своей командой
+	Role   Role   `json:"role"`
+	UserId string `json:"user_id"`
+}
+
+// CreatePullRequestV2JSONBody defines parameters for CreatePullRequestV2.
+type CreatePullRequestV2JSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// ReassignPullRequestV2JSONBody defines parameters for ReassignPullRequestV2.
+type ReassignPullRequestV2JSONBody struct {
+	OldUserId string `json:"old_user_id"`
+}
+
+// ImportTeamsV2Params defines parameters for ImportTeamsV2.
+type ImportTeamsV2Params struct {
+	// Format Формат файла, по умолчанию определяется по Content-Type (text/csv или application/yaml)
+	Format *ImportTeamsV2ParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Только проверить файл, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ImportTeamsV2ParamsFormat defines parameters for ImportTeamsV2.
+type ImportTeamsV2ParamsFormat string
+
+// UpdateUserV2JSONBody defines parameters for UpdateUserV2.
+type UpdateUserV2JSONBody struct {
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Role admin управляет всеми командами, lead — своей командой
+	Role *Role `json:"role,omitempty"`
+}
+
+// IssueApiKeyJSONRequestBody defines body for IssueApiKey for application/json ContentType.
+type IssueApiKeyJSONRequestBody IssueApiKeyJSONBody
+
+// RevokeApiKeyJSONRequestBody defines body for RevokeApiKey for application/json ContentType.
+type RevokeApiKeyJSONRequestBody RevokeApiKeyJSONBody
+
+// ArchivePullRequestJSONRequestBody defines body for ArchivePullRequest for application/json ContentType.
+type ArchivePullRequestJSONRequestBody ArchivePullRequestJSONBody
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignPullRequestJSONRequestBody defines body for ReassignPullRequest for application/json ContentType.
+type ReassignPullRequestJSONRequestBody ReassignPullRequestJSONBody
+
+// RestorePullRequestJSONRequestBody defines body for RestorePullRequest for application/json ContentType.
+type RestorePullRequestJSONRequestBody RestorePullRequestJSONBody
+
+// AddTeamJSONRequestBody defines body for AddTeam for application/json ContentType.
+type AddTeamJSONRequestBody = Team
+
+// ArchiveTeamJSONRequestBody defines body for ArchiveTeam for application/json ContentType.
+type ArchiveTeamJSONRequestBody ArchiveTeamJSONBody
+
+// RestoreTeamJSONRequestBody defines body for RestoreTeam for application/json ContentType.
+type RestoreTeamJSONRequestBody RestoreTeamJSONBody
+
+// ArchiveUserJSONRequestBody defines body for ArchiveUser for application/json ContentType.
+type ArchiveUserJSONRequestBody ArchiveUserJSONBody
+
+// RestoreUserJSONRequestBody defines body for RestoreUser for application/json ContentType.
+type RestoreUserJSONRequestBody RestoreUserJSONBody
+
+// SetIsActiveJSONRequestBody defines body for SetIsActive for application/json ContentType.
+type SetIsActiveJSONRequestBody SetIsActiveJSONBody
+
+// SetRoleJSONRequestBody defines body for SetRole for application/json ContentType.
+type SetRoleJSONRequestBody SetRoleJSONBody
+
+// CreatePullRequestV2JSONRequestBody defines body for CreatePullRequestV2 for application/json ContentType.
+type CreatePullRequestV2JSONRequestBody CreatePullRequestV2JSONBody
+
+// ReassignPullRequestV2JSONRequestBody defines body for ReassignPullRequestV2 for application/json ContentType.
+type ReassignPullRequestV2JSONRequestBody ReassignPullRequestV2JSONBody
+
+// CreateTeamV2JSONRequestBody defines body for CreateTeamV2 for application/json ContentType.
+type CreateTeamV2JSONRequestBody = Team
+
+// UpdateUserV2JSONRequestBody defines body for UpdateUserV2 for application/json ContentType.
+type UpdateUserV2JSONRequestBody UpdateUserV2JSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// IssueApiKeyWithBody request with any body
+	IssueApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	IssueApiKey(ctx context.Context, body IssueApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListApiKeys request
+	ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeApiKeyWithBody request with any body
+	RevokeApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RevokeApiKey(ctx context.Context, body RevokeApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchivePullRequestWithBody request with any body
+	ArchivePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ArchivePullRequest(ctx context.Context, body ArchivePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePullRequestWithBody request with any body
+	CreatePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePullRequest(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergePullRequestWithBody request with any body
+	MergePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MergePullRequest(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReassignPullRequestWithBody request with any body
+	ReassignPullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReassignPullRequest(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestorePullRequestWithBody request with any body
+	RestorePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestorePullRequest(ctx context.Context, body RestorePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStats request
+	GetStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTeamWithBody request with any body
+	AddTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTeam(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveTeamWithBody request with any body
+	ArchiveTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ArchiveTeam(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeam request
+	GetTeam(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTeamsWithBody request with any body
+	ImportTeamsWithBody(ctx context.Context, params *ImportTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTeamWithBody request with any body
+	RestoreTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestoreTeam(ctx context.Context, body RestoreTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveUserWithBody request with any body
+	ArchiveUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ArchiveUser(ctx context.Context, body ArchiveUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReview request
+	GetReview(ctx context.Context, params *GetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreUserWithBody request with any body
+	RestoreUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RestoreUser(ctx context.Context, body RestoreUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetIsActiveWithBody request with any body
+	SetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetIsActive(ctx context.Context, body SetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetRoleWithBody request with any body
+	SetRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetRole(ctx context.Context, body SetRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePullRequestV2WithBody request with any body
+	CreatePullRequestV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePullRequestV2(ctx context.Context, body CreatePullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchivePullRequestV2 request
+	ArchivePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MergePullRequestV2 request
+	MergePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReassignPullRequestV2WithBody request with any body
+	ReassignPullRequestV2WithBody(ctx context.Context, pullRequestId PullRequestIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReassignPullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, body ReassignPullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestorePullRequestV2 request
+	RestorePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTeamV2WithBody request with any body
+	CreateTeamV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTeamV2(ctx context.Context, body CreateTeamV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTeamsV2WithBody request with any body
+	ImportTeamsV2WithBody(ctx context.Context, params *ImportTeamsV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveTeamV2 request
+	ArchiveTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamV2 request
+	GetTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTeamV2 request
+	RestoreTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveUserV2 request
+	ArchiveUserV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserV2WithBody request with any body
+	UpdateUserV2WithBody(ctx context.Context, userId UserIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserV2(ctx context.Context, userId UserIdPath, body UpdateUserV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreUserV2 request
+	RestoreUserV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserReviewsV2 request
+	GetUserReviewsV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) IssueApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueApiKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueApiKey(ctx context.Context, body IssueApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueApiKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeApiKey(ctx context.Context, body RevokeApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchivePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchivePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchivePullRequest(ctx context.Context, body ArchivePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchivePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePullRequest(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MergePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MergePullRequest(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReassignPullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReassignPullRequest(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePullRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePullRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePullRequest(ctx context.Context, body RestorePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePullRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTeam(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveTeam(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTeam(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTeamsWithBody(ctx context.Context, params *ImportTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTeamsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTeamWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTeamRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTeam(ctx context.Context, body RestoreTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTeamRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveUser(ctx context.Context, body ArchiveUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReview(ctx context.Context, params *GetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreUser(ctx context.Context, body RestoreUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetIsActive(ctx context.Context, body SetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetRoleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRoleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetRole(ctx context.Context, body SetRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRoleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePullRequestV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestV2RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePullRequestV2(ctx context.Context, body CreatePullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePullRequestV2Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchivePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchivePullRequestV2Request(c.Server, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MergePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergePullRequestV2Request(c.Server, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReassignPullRequestV2WithBody(ctx context.Context, pullRequestId PullRequestIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestV2RequestWithBody(c.Server, pullRequestId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReassignPullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, body ReassignPullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReassignPullRequestV2Request(c.Server, pullRequestId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestorePullRequestV2(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestorePullRequestV2Request(c.Server, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTeamV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTeamV2RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTeamV2(ctx context.Context, body CreateTeamV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTeamV2Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTeamsV2WithBody(ctx context.Context, params *ImportTeamsV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTeamsV2RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTeamV2Request(c.Server, teamName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamV2Request(c.Server, teamName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTeamV2(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTeamV2Request(c.Server, teamName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveUserV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveUserV2Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserV2WithBody(ctx context.Context, userId UserIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserV2RequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserV2(ctx context.Context, userId UserIdPath, body UpdateUserV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserV2Request(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreUserV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreUserV2Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserReviewsV2(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserReviewsV2Request(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewIssueApiKeyRequest calls the generic IssueApiKey builder with application/json body
+func NewIssueApiKeyRequest(server string, body IssueApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewIssueApiKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewIssueApiKeyRequestWithBody generates requests for IssueApiKey with any type of body
+func NewIssueApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/apiKeys/issue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListApiKeysRequest generates requests for ListApiKeys
+func NewListApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/apiKeys/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeApiKeyRequest calls the generic RevokeApiKey builder with application/json body
+func NewRevokeApiKeyRequest(server string, body RevokeApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRevokeApiKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRevokeApiKeyRequestWithBody generates requests for RevokeApiKey with any type of body
+func NewRevokeApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/apiKeys/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewArchivePullRequestRequest calls the generic ArchivePullRequest builder with application/json body
+func NewArchivePullRequestRequest(server string, body ArchivePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewArchivePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewArchivePullRequestRequestWithBody generates requests for ArchivePullRequest with any type of body
+func NewArchivePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/archive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePullRequestRequest calls the generic CreatePullRequest builder with application/json body
+func NewCreatePullRequestRequest(server string, body CreatePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePullRequestRequestWithBody generates requests for CreatePullRequest with any type of body
+func NewCreatePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMergePullRequestRequest calls the generic MergePullRequest builder with application/json body
+func NewMergePullRequestRequest(server string, body MergePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMergePullRequestRequestWithBody generates requests for MergePullRequest with any type of body
+func NewMergePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReassignPullRequestRequest calls the generic ReassignPullRequest builder with application/json body
+func NewReassignPullRequestRequest(server string, body ReassignPullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReassignPullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReassignPullRequestRequestWithBody generates requests for ReassignPullRequest with any type of body
+func NewReassignPullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestorePullRequestRequest calls the generic RestorePullRequest builder with application/json body
+func NewRestorePullRequestRequest(server string, body RestorePullRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestorePullRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRestorePullRequestRequestWithBody generates requests for RestorePullRequest with any type of body
+func NewRestorePullRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/restore")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStatsRequest generates requests for GetStats
+func NewGetStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTeamRequest calls the generic AddTeam builder with application/json body
+func NewAddTeamRequest(server string, body AddTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddTeamRequestWithBody generates requests for AddTeam with any type of body
+func NewAddTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewArchiveTeamRequest calls the generic ArchiveTeam builder with application/json body
+func NewArchiveTeamRequest(server string, body ArchiveTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewArchiveTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewArchiveTeamRequestWithBody generates requests for ArchiveTeam with any type of body
+func NewArchiveTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/archive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamRequest generates requests for GetTeam
+func NewGetTeamRequest(server string, params *GetTeamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportTeamsRequestWithBody generates requests for ImportTeams with any type of body
+func NewImportTeamsRequestWithBody(server string, params *ImportTeamsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreTeamRequest calls the generic RestoreTeam builder with application/json body
+func NewRestoreTeamRequest(server string, body RestoreTeamJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestoreTeamRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRestoreTeamRequestWithBody generates requests for RestoreTeam with any type of body
+func NewRestoreTeamRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/restore")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewArchiveUserRequest calls the generic ArchiveUser builder with application/json body
+func NewArchiveUserRequest(server string, body ArchiveUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewArchiveUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewArchiveUserRequestWithBody generates requests for ArchiveUser with any type of body
+func NewArchiveUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/archive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetReviewRequest generates requests for GetReview
+func NewGetReviewRequest(server string, params *GetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreUserRequest calls the generic RestoreUser builder with application/json body
+func NewRestoreUserRequest(server string, body RestoreUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRestoreUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRestoreUserRequestWithBody generates requests for RestoreUser with any type of body
+func NewRestoreUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/restore")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetIsActiveRequest calls the generic SetIsActive builder with application/json body
+func NewSetIsActiveRequest(server string, body SetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetIsActiveRequestWithBody generates requests for SetIsActive with any type of body
+func NewSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetRoleRequest calls the generic SetRole builder with application/json body
+func NewSetRoleRequest(server string, body SetRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetRoleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetRoleRequestWithBody generates requests for SetRole with any type of body
+func NewSetRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setRole")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePullRequestV2Request calls the generic CreatePullRequestV2 builder with application/json body
+func NewCreatePullRequestV2Request(server string, body CreatePullRequestV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePullRequestV2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePullRequestV2RequestWithBody generates requests for CreatePullRequestV2 with any type of body
+func NewCreatePullRequestV2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pull-requests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewArchivePullRequestV2Request generates requests for ArchivePullRequestV2
+func NewArchivePullRequestV2Request(server string, pullRequestId PullRequestIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pull_request_id", runtime.ParamLocationPath, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pull-requests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMergePullRequestV2Request generates requests for MergePullRequestV2
+func NewMergePullRequestV2Request(server string, pullRequestId PullRequestIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pull_request_id", runtime.ParamLocationPath, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pull-requests/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReassignPullRequestV2Request calls the generic ReassignPullRequestV2 builder with application/json body
+func NewReassignPullRequestV2Request(server string, pullRequestId PullRequestIdPath, body ReassignPullRequestV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReassignPullRequestV2RequestWithBody(server, pullRequestId, "application/json", bodyReader)
+}
+
+// NewReassignPullRequestV2RequestWithBody generates requests for ReassignPullRequestV2 with any type of body
+func NewReassignPullRequestV2RequestWithBody(server string, pullRequestId PullRequestIdPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pull_request_id", runtime.ParamLocationPath, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pull-requests/%s/reassign", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestorePullRequestV2Request generates requests for RestorePullRequestV2
+func NewRestorePullRequestV2Request(server string, pullRequestId PullRequestIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pull_request_id", runtime.ParamLocationPath, pullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/pull-requests/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTeamV2Request calls the generic CreateTeamV2 builder with application/json body
+func NewCreateTeamV2Request(server string, body CreateTeamV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTeamV2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTeamV2RequestWithBody generates requests for CreateTeamV2 with any type of body
+func NewCreateTeamV2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/teams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportTeamsV2RequestWithBody generates requests for ImportTeamsV2 with any type of body
+func NewImportTeamsV2RequestWithBody(server string, params *ImportTeamsV2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/teams/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewArchiveTeamV2Request generates requests for ArchiveTeamV2
+func NewArchiveTeamV2Request(server string, teamName TeamNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team_name", runtime.ParamLocationPath, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/teams/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTeamV2Request generates requests for GetTeamV2
+func NewGetTeamV2Request(server string, teamName TeamNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team_name", runtime.ParamLocationPath, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/teams/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTeamV2Request generates requests for RestoreTeamV2
+func NewRestoreTeamV2Request(server string, teamName TeamNamePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team_name", runtime.ParamLocationPath, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/teams/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewArchiveUserV2Request generates requests for ArchiveUserV2
+func NewArchiveUserV2Request(server string, userId UserIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserV2Request calls the generic UpdateUserV2 builder with application/json body
+func NewUpdateUserV2Request(server string, userId UserIdPath, body UpdateUserV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserV2RequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUpdateUserV2RequestWithBody generates requests for UpdateUserV2 with any type of body
+func NewUpdateUserV2RequestWithBody(server string, userId UserIdPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreUserV2Request generates requests for RestoreUserV2
+func NewRestoreUserV2Request(server string, userId UserIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserReviewsV2Request generates requests for GetUserReviewsV2
+func NewGetUserReviewsV2Request(server string, userId UserIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/reviews", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// IssueApiKeyWithBodyWithResponse request with any body
+	IssueApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueApiKeyResponse, error)
+
+	IssueApiKeyWithResponse(ctx context.Context, body IssueApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueApiKeyResponse, error)
+
+	// ListApiKeysWithResponse request
+	ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResponse, error)
+
+	// RevokeApiKeyWithBodyWithResponse request with any body
+	RevokeApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error)
+
+	RevokeApiKeyWithResponse(ctx context.Context, body RevokeApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// ArchivePullRequestWithBodyWithResponse request with any body
+	ArchivePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchivePullRequestResponse, error)
+
+	ArchivePullRequestWithResponse(ctx context.Context, body ArchivePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchivePullRequestResponse, error)
+
+	// CreatePullRequestWithBodyWithResponse request with any body
+	CreatePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error)
+
+	CreatePullRequestWithResponse(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error)
+
+	// MergePullRequestWithBodyWithResponse request with any body
+	MergePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error)
+
+	MergePullRequestWithResponse(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error)
+
+	// ReassignPullRequestWithBodyWithResponse request with any body
+	ReassignPullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error)
+
+	ReassignPullRequestWithResponse(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error)
+
+	// RestorePullRequestWithBodyWithResponse request with any body
+	RestorePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePullRequestResponse, error)
+
+	RestorePullRequestWithResponse(ctx context.Context, body RestorePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePullRequestResponse, error)
+
+	// GetStatsWithResponse request
+	GetStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsResponse, error)
+
+	// AddTeamWithBodyWithResponse request with any body
+	AddTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamResponse, error)
+
+	AddTeamWithResponse(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamResponse, error)
+
+	// ArchiveTeamWithBodyWithResponse request with any body
+	ArchiveTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error)
+
+	ArchiveTeamWithResponse(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error)
+
+	// GetTeamWithResponse request
+	GetTeamWithResponse(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*GetTeamResponse, error)
+
+	// ImportTeamsWithBodyWithResponse request with any body
+	ImportTeamsWithBodyWithResponse(ctx context.Context, params *ImportTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTeamsResponse, error)
+
+	// RestoreTeamWithBodyWithResponse request with any body
+	RestoreTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreTeamResponse, error)
+
+	RestoreTeamWithResponse(ctx context.Context, body RestoreTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreTeamResponse, error)
+
+	// ArchiveUserWithBodyWithResponse request with any body
+	ArchiveUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveUserResponse, error)
+
+	ArchiveUserWithResponse(ctx context.Context, body ArchiveUserJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveUserResponse, error)
+
+	// GetReviewWithResponse request
+	GetReviewWithResponse(ctx context.Context, params *GetReviewParams, reqEditors ...RequestEditorFn) (*GetReviewResponse, error)
+
+	// RestoreUserWithBodyWithResponse request with any body
+	RestoreUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreUserResponse, error)
+
+	RestoreUserWithResponse(ctx context.Context, body RestoreUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreUserResponse, error)
+
+	// SetIsActiveWithBodyWithResponse request with any body
+	SetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetIsActiveResponse, error)
+
+	SetIsActiveWithResponse(ctx context.Context, body SetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*SetIsActiveResponse, error)
+
+	// SetRoleWithBodyWithResponse request with any body
+	SetRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRoleResponse, error)
+
+	SetRoleWithResponse(ctx context.Context, body SetRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRoleResponse, error)
+
+	// CreatePullRequestV2WithBodyWithResponse request with any body
+	CreatePullRequestV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestV2Response, error)
+
+	CreatePullRequestV2WithResponse(ctx context.Context, body CreatePullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestV2Response, error)
+
+	// ArchivePullRequestV2WithResponse request
+	ArchivePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*ArchivePullRequestV2Response, error)
+
+	// MergePullRequestV2WithResponse request
+	MergePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*MergePullRequestV2Response, error)
+
+	// ReassignPullRequestV2WithBodyWithResponse request with any body
+	ReassignPullRequestV2WithBodyWithResponse(ctx context.Context, pullRequestId PullRequestIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestV2Response, error)
+
+	ReassignPullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, body ReassignPullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestV2Response, error)
+
+	// RestorePullRequestV2WithResponse request
+	RestorePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*RestorePullRequestV2Response, error)
+
+	// CreateTeamV2WithBodyWithResponse request with any body
+	CreateTeamV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTeamV2Response, error)
+
+	CreateTeamV2WithResponse(ctx context.Context, body CreateTeamV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTeamV2Response, error)
+
+	// ImportTeamsV2WithBodyWithResponse request with any body
+	ImportTeamsV2WithBodyWithResponse(ctx context.Context, params *ImportTeamsV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTeamsV2Response, error)
+
+	// ArchiveTeamV2WithResponse request
+	ArchiveTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*ArchiveTeamV2Response, error)
+
+	// GetTeamV2WithResponse request
+	GetTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*GetTeamV2Response, error)
+
+	// RestoreTeamV2WithResponse request
+	RestoreTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*RestoreTeamV2Response, error)
+
+	// ArchiveUserV2WithResponse request
+	ArchiveUserV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*ArchiveUserV2Response, error)
+
+	// UpdateUserV2WithBodyWithResponse request with any body
+	UpdateUserV2WithBodyWithResponse(ctx context.Context, userId UserIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserV2Response, error)
+
+	UpdateUserV2WithResponse(ctx context.Context, userId UserIdPath, body UpdateUserV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserV2Response, error)
+
+	// RestoreUserV2WithResponse request
+	RestoreUserV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*RestoreUserV2Response, error)
+
+	// GetUserReviewsV2WithResponse request
+	GetUserReviewsV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*GetUserReviewsV2Response, error)
+}
+
+type IssueApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Key    APIKey `json:"key"`
+		Secret string `json:"secret"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Keys []APIKey `json:"keys"`
+	}
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Key APIKey `json:"key"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchivePullRequestResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchivePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchivePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+		PullRequest PullRequestV1 `json:"pull_request"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON429 *TooManyRequests
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergePullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+		PullRequest PullRequestV1 `json:"pull_request"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r MergePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReassignPullRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+		PullRequest PullRequestV1 `json:"pull_request"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReassignPullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReassignPullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestorePullRequestResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestorePullRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestorePullRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Stats
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team Team `json:"team"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveTeamResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Team
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		DryRun bool `json:"dry_run"`
+		Teams  int  `json:"teams"`
+		Users  int  `json:"users"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON413 *PayloadTooLarge
+	ApplicationproblemJSON422 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTeamResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserId       string             `json:"user_id"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User User `json:"user"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r SetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetRoleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User User `json:"user"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r SetRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePullRequestV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON429 *TooManyRequests
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePullRequestV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePullRequestV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchivePullRequestV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchivePullRequestV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchivePullRequestV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergePullRequestV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r MergePullRequestV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergePullRequestV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReassignPullRequestV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReassignPullRequestV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReassignPullRequestV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestorePullRequestV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestorePullRequestV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestorePullRequestV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTeamV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team Team `json:"team"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTeamV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTeamV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTeamsV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		DryRun bool `json:"dry_run"`
+		Teams  int  `json:"teams"`
+		Users  int  `json:"users"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON413 *PayloadTooLarge
+	ApplicationproblemJSON422 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTeamsV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTeamsV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveTeamV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveTeamV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveTeamV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Team
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTeamV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTeamV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTeamV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveUserV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ArchiveResult
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveUserV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveUserV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User User `json:"user"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreUserV2Response struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreUserV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreUserV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserReviewsV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserId       string             `json:"user_id"`
+	}
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON500 *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserReviewsV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserReviewsV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// IssueApiKeyWithBodyWithResponse request with arbitrary body returning *IssueApiKeyResponse
+func (c *ClientWithResponses) IssueApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IssueApiKeyResponse, error) {
+	rsp, err := c.IssueApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueApiKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) IssueApiKeyWithResponse(ctx context.Context, body IssueApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*IssueApiKeyResponse, error) {
+	rsp, err := c.IssueApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueApiKeyResponse(rsp)
+}
+
+// ListApiKeysWithResponse request returning *ListApiKeysResponse
+func (c *ClientWithResponses) ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResponse, error) {
+	rsp, err := c.ListApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApiKeysResponse(rsp)
+}
+
+// RevokeApiKeyWithBodyWithResponse request with arbitrary body returning *RevokeApiKeyResponse
+func (c *ClientWithResponses) RevokeApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error) {
+	rsp, err := c.RevokeApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) RevokeApiKeyWithResponse(ctx context.Context, body RevokeApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*RevokeApiKeyResponse, error) {
+	rsp, err := c.RevokeApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiKeyResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// ArchivePullRequestWithBodyWithResponse request with arbitrary body returning *ArchivePullRequestResponse
+func (c *ClientWithResponses) ArchivePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchivePullRequestResponse, error) {
+	rsp, err := c.ArchivePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchivePullRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) ArchivePullRequestWithResponse(ctx context.Context, body ArchivePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchivePullRequestResponse, error) {
+	rsp, err := c.ArchivePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchivePullRequestResponse(rsp)
+}
+
+// CreatePullRequestWithBodyWithResponse request with arbitrary body returning *CreatePullRequestResponse
+func (c *ClientWithResponses) CreatePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error) {
+	rsp, err := c.CreatePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePullRequestWithResponse(ctx context.Context, body CreatePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestResponse, error) {
+	rsp, err := c.CreatePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestResponse(rsp)
+}
+
+// MergePullRequestWithBodyWithResponse request with arbitrary body returning *MergePullRequestResponse
+func (c *ClientWithResponses) MergePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error) {
+	rsp, err := c.MergePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePullRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) MergePullRequestWithResponse(ctx context.Context, body MergePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*MergePullRequestResponse, error) {
+	rsp, err := c.MergePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePullRequestResponse(rsp)
+}
+
+// ReassignPullRequestWithBodyWithResponse request with arbitrary body returning *ReassignPullRequestResponse
+func (c *ClientWithResponses) ReassignPullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error) {
+	rsp, err := c.ReassignPullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReassignPullRequestWithResponse(ctx context.Context, body ReassignPullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestResponse, error) {
+	rsp, err := c.ReassignPullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestResponse(rsp)
+}
+
+// RestorePullRequestWithBodyWithResponse request with arbitrary body returning *RestorePullRequestResponse
+func (c *ClientWithResponses) RestorePullRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestorePullRequestResponse, error) {
+	rsp, err := c.RestorePullRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePullRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestorePullRequestWithResponse(ctx context.Context, body RestorePullRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*RestorePullRequestResponse, error) {
+	rsp, err := c.RestorePullRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePullRequestResponse(rsp)
+}
+
+// GetStatsWithResponse request returning *GetStatsResponse
+func (c *ClientWithResponses) GetStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatsResponse, error) {
+	rsp, err := c.GetStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatsResponse(rsp)
+}
+
+// AddTeamWithBodyWithResponse request with arbitrary body returning *AddTeamResponse
+func (c *ClientWithResponses) AddTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTeamResponse, error) {
+	rsp, err := c.AddTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTeamWithResponse(ctx context.Context, body AddTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTeamResponse, error) {
+	rsp, err := c.AddTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTeamResponse(rsp)
+}
+
+// ArchiveTeamWithBodyWithResponse request with arbitrary body returning *ArchiveTeamResponse
+func (c *ClientWithResponses) ArchiveTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error) {
+	rsp, err := c.ArchiveTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) ArchiveTeamWithResponse(ctx context.Context, body ArchiveTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveTeamResponse, error) {
+	rsp, err := c.ArchiveTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTeamResponse(rsp)
+}
+
+// GetTeamWithResponse request returning *GetTeamResponse
+func (c *ClientWithResponses) GetTeamWithResponse(ctx context.Context, params *GetTeamParams, reqEditors ...RequestEditorFn) (*GetTeamResponse, error) {
+	rsp, err := c.GetTeam(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamResponse(rsp)
+}
+
+// ImportTeamsWithBodyWithResponse request with arbitrary body returning *ImportTeamsResponse
+func (c *ClientWithResponses) ImportTeamsWithBodyWithResponse(ctx context.Context, params *ImportTeamsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTeamsResponse, error) {
+	rsp, err := c.ImportTeamsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTeamsResponse(rsp)
+}
+
+// RestoreTeamWithBodyWithResponse request with arbitrary body returning *RestoreTeamResponse
+func (c *ClientWithResponses) RestoreTeamWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreTeamResponse, error) {
+	rsp, err := c.RestoreTeamWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTeamResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestoreTeamWithResponse(ctx context.Context, body RestoreTeamJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreTeamResponse, error) {
+	rsp, err := c.RestoreTeam(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTeamResponse(rsp)
+}
+
+// ArchiveUserWithBodyWithResponse request with arbitrary body returning *ArchiveUserResponse
+func (c *ClientWithResponses) ArchiveUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ArchiveUserResponse, error) {
+	rsp, err := c.ArchiveUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) ArchiveUserWithResponse(ctx context.Context, body ArchiveUserJSONRequestBody, reqEditors ...RequestEditorFn) (*ArchiveUserResponse, error) {
+	rsp, err := c.ArchiveUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveUserResponse(rsp)
+}
+
+// GetReviewWithResponse request returning *GetReviewResponse
+func (c *ClientWithResponses) GetReviewWithResponse(ctx context.Context, params *GetReviewParams, reqEditors ...RequestEditorFn) (*GetReviewResponse, error) {
+	rsp, err := c.GetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReviewResponse(rsp)
+}
+
+// RestoreUserWithBodyWithResponse request with arbitrary body returning *RestoreUserResponse
+func (c *ClientWithResponses) RestoreUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RestoreUserResponse, error) {
+	rsp, err := c.RestoreUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) RestoreUserWithResponse(ctx context.Context, body RestoreUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RestoreUserResponse, error) {
+	rsp, err := c.RestoreUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreUserResponse(rsp)
+}
+
+// SetIsActiveWithBodyWithResponse request with arbitrary body returning *SetIsActiveResponse
+func (c *ClientWithResponses) SetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetIsActiveResponse, error) {
+	rsp, err := c.SetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetIsActiveWithResponse(ctx context.Context, body SetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*SetIsActiveResponse, error) {
+	rsp, err := c.SetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetIsActiveResponse(rsp)
+}
+
+// SetRoleWithBodyWithResponse request with arbitrary body returning *SetRoleResponse
+func (c *ClientWithResponses) SetRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRoleResponse, error) {
+	rsp, err := c.SetRoleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetRoleWithResponse(ctx context.Context, body SetRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRoleResponse, error) {
+	rsp, err := c.SetRole(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetRoleResponse(rsp)
+}
+
+// CreatePullRequestV2WithBodyWithResponse request with arbitrary body returning *CreatePullRequestV2Response
+func (c *ClientWithResponses) CreatePullRequestV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePullRequestV2Response, error) {
+	rsp, err := c.CreatePullRequestV2WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestV2Response(rsp)
+}
+
+func (c *ClientWithResponses) CreatePullRequestV2WithResponse(ctx context.Context, body CreatePullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePullRequestV2Response, error) {
+	rsp, err := c.CreatePullRequestV2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePullRequestV2Response(rsp)
+}
+
+// ArchivePullRequestV2WithResponse request returning *ArchivePullRequestV2Response
+func (c *ClientWithResponses) ArchivePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*ArchivePullRequestV2Response, error) {
+	rsp, err := c.ArchivePullRequestV2(ctx, pullRequestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchivePullRequestV2Response(rsp)
+}
+
+// MergePullRequestV2WithResponse request returning *MergePullRequestV2Response
+func (c *ClientWithResponses) MergePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*MergePullRequestV2Response, error) {
+	rsp, err := c.MergePullRequestV2(ctx, pullRequestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergePullRequestV2Response(rsp)
+}
+
+// ReassignPullRequestV2WithBodyWithResponse request with arbitrary body returning *ReassignPullRequestV2Response
+func (c *ClientWithResponses) ReassignPullRequestV2WithBodyWithResponse(ctx context.Context, pullRequestId PullRequestIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReassignPullRequestV2Response, error) {
+	rsp, err := c.ReassignPullRequestV2WithBody(ctx, pullRequestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestV2Response(rsp)
+}
+
+func (c *ClientWithResponses) ReassignPullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, body ReassignPullRequestV2JSONRequestBody, reqEditors ...RequestEditorFn) (*ReassignPullRequestV2Response, error) {
+	rsp, err := c.ReassignPullRequestV2(ctx, pullRequestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReassignPullRequestV2Response(rsp)
+}
+
+// RestorePullRequestV2WithResponse request returning *RestorePullRequestV2Response
+func (c *ClientWithResponses) RestorePullRequestV2WithResponse(ctx context.Context, pullRequestId PullRequestIdPath, reqEditors ...RequestEditorFn) (*RestorePullRequestV2Response, error) {
+	rsp, err := c.RestorePullRequestV2(ctx, pullRequestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestorePullRequestV2Response(rsp)
+}
+
+// CreateTeamV2WithBodyWithResponse request with arbitrary body returning *CreateTeamV2Response
+func (c *ClientWithResponses) CreateTeamV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTeamV2Response, error) {
+	rsp, err := c.CreateTeamV2WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTeamV2Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateTeamV2WithResponse(ctx context.Context, body CreateTeamV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTeamV2Response, error) {
+	rsp, err := c.CreateTeamV2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTeamV2Response(rsp)
+}
+
+// ImportTeamsV2WithBodyWithResponse request with arbitrary body returning *ImportTeamsV2Response
+func (c *ClientWithResponses) ImportTeamsV2WithBodyWithResponse(ctx context.Context, params *ImportTeamsV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTeamsV2Response, error) {
+	rsp, err := c.ImportTeamsV2WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTeamsV2Response(rsp)
+}
+
+// ArchiveTeamV2WithResponse request returning *ArchiveTeamV2Response
+func (c *ClientWithResponses) ArchiveTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*ArchiveTeamV2Response, error) {
+	rsp, err := c.ArchiveTeamV2(ctx, teamName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTeamV2Response(rsp)
+}
+
+// GetTeamV2WithResponse request returning *GetTeamV2Response
+func (c *ClientWithResponses) GetTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*GetTeamV2Response, error) {
+	rsp, err := c.GetTeamV2(ctx, teamName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamV2Response(rsp)
+}
+
+// RestoreTeamV2WithResponse request returning *RestoreTeamV2Response
+func (c *ClientWithResponses) RestoreTeamV2WithResponse(ctx context.Context, teamName TeamNamePath, reqEditors ...RequestEditorFn) (*RestoreTeamV2Response, error) {
+	rsp, err := c.RestoreTeamV2(ctx, teamName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTeamV2Response(rsp)
+}
+
+// ArchiveUserV2WithResponse request returning *ArchiveUserV2Response
+func (c *ClientWithResponses) ArchiveUserV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*ArchiveUserV2Response, error) {
+	rsp, err := c.ArchiveUserV2(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveUserV2Response(rsp)
+}
+
+// UpdateUserV2WithBodyWithResponse request with arbitrary body returning *UpdateUserV2Response
+func (c *ClientWithResponses) UpdateUserV2WithBodyWithResponse(ctx context.Context, userId UserIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserV2Response, error) {
+	rsp, err := c.UpdateUserV2WithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserV2Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserV2WithResponse(ctx context.Context, userId UserIdPath, body UpdateUserV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserV2Response, error) {
+	rsp, err := c.UpdateUserV2(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserV2Response(rsp)
+}
+
+// RestoreUserV2WithResponse request returning *RestoreUserV2Response
+func (c *ClientWithResponses) RestoreUserV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*RestoreUserV2Response, error) {
+	rsp, err := c.RestoreUserV2(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreUserV2Response(rsp)
+}
+
+// GetUserReviewsV2WithResponse request returning *GetUserReviewsV2Response
+func (c *ClientWithResponses) GetUserReviewsV2WithResponse(ctx context.Context, userId UserIdPath, reqEditors ...RequestEditorFn) (*GetUserReviewsV2Response, error) {
+	rsp, err := c.GetUserReviewsV2(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserReviewsV2Response(rsp)
+}
+
+// ParseIssueApiKeyResponse parses an HTTP response from a IssueApiKeyWithResponse call
+func ParseIssueApiKeyResponse(rsp *http.Response) (*IssueApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IssueApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Key    APIKey `json:"key"`
+			Secret string `json:"secret"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListApiKeysResponse parses an HTTP response from a ListApiKeysWithResponse call
+func ParseListApiKeysResponse(rsp *http.Response) (*ListApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Keys []APIKey `json:"keys"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeApiKeyResponse parses an HTTP response from a RevokeApiKeyWithResponse call
+func ParseRevokeApiKeyResponse(rsp *http.Response) (*RevokeApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Key APIKey `json:"key"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchivePullRequestResponse parses an HTTP response from a ArchivePullRequestWithResponse call
+func ParseArchivePullRequestResponse(rsp *http.Response) (*ArchivePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchivePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePullRequestResponse parses an HTTP response from a CreatePullRequestWithResponse call
+func ParseCreatePullRequestResponse(rsp *http.Response) (*CreatePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+			PullRequest PullRequestV1 `json:"pull_request"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMergePullRequestResponse parses an HTTP response from a MergePullRequestWithResponse call
+func ParseMergePullRequestResponse(rsp *http.Response) (*MergePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+			PullRequest PullRequestV1 `json:"pull_request"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReassignPullRequestResponse parses an HTTP response from a ReassignPullRequestWithResponse call
+func ParseReassignPullRequestResponse(rsp *http.Response) (*ReassignPullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReassignPullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+			PullRequest PullRequestV1 `json:"pull_request"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestorePullRequestResponse parses an HTTP response from a RestorePullRequestWithResponse call
+func ParseRestorePullRequestResponse(rsp *http.Response) (*RestorePullRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestorePullRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatsResponse parses an HTTP response from a GetStatsWithResponse call
+func ParseGetStatsResponse(rsp *http.Response) (*GetStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTeamResponse parses an HTTP response from a AddTeamWithResponse call
+func ParseAddTeamResponse(rsp *http.Response) (*AddTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team Team `json:"team"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveTeamResponse parses an HTTP response from a ArchiveTeamWithResponse call
+func ParseArchiveTeamResponse(rsp *http.Response) (*ArchiveTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamResponse parses an HTTP response from a GetTeamWithResponse call
+func ParseGetTeamResponse(rsp *http.Response) (*GetTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTeamsResponse parses an HTTP response from a ImportTeamsWithResponse call
+func ParseImportTeamsResponse(rsp *http.Response) (*ImportTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			DryRun bool `json:"dry_run"`
+			Teams  int  `json:"teams"`
+			Users  int  `json:"users"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreTeamResponse parses an HTTP response from a RestoreTeamWithResponse call
+func ParseRestoreTeamResponse(rsp *http.Response) (*RestoreTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveUserResponse parses an HTTP response from a ArchiveUserWithResponse call
+func ParseArchiveUserResponse(rsp *http.Response) (*ArchiveUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReviewResponse parses an HTTP response from a GetReviewWithResponse call
+func ParseGetReviewResponse(rsp *http.Response) (*GetReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserId       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreUserResponse parses an HTTP response from a RestoreUserWithResponse call
+func ParseRestoreUserResponse(rsp *http.Response) (*RestoreUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetIsActiveResponse parses an HTTP response from a SetIsActiveWithResponse call
+func ParseSetIsActiveResponse(rsp *http.Response) (*SetIsActiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetIsActiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User User `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetRoleResponse parses an HTTP response from a SetRoleWithResponse call
+func ParseSetRoleResponse(rsp *http.Response) (*SetRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetRoleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User User `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePullRequestV2Response parses an HTTP response from a CreatePullRequestV2WithResponse call
+func ParseCreatePullRequestV2Response(rsp *http.Response) (*CreatePullRequestV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePullRequestV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchivePullRequestV2Response parses an HTTP response from a ArchivePullRequestV2WithResponse call
+func ParseArchivePullRequestV2Response(rsp *http.Response) (*ArchivePullRequestV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchivePullRequestV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMergePullRequestV2Response parses an HTTP response from a MergePullRequestV2WithResponse call
+func ParseMergePullRequestV2Response(rsp *http.Response) (*MergePullRequestV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MergePullRequestV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReassignPullRequestV2Response parses an HTTP response from a ReassignPullRequestV2WithResponse call
+func ParseReassignPullRequestV2Response(rsp *http.Response) (*ReassignPullRequestV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReassignPullRequestV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestorePullRequestV2Response parses an HTTP response from a RestorePullRequestV2WithResponse call
+func ParseRestorePullRequestV2Response(rsp *http.Response) (*RestorePullRequestV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestorePullRequestV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTeamV2Response parses an HTTP response from a CreateTeamV2WithResponse call
+func ParseCreateTeamV2Response(rsp *http.Response) (*CreateTeamV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTeamV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team Team `json:"team"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTeamsV2Response parses an HTTP response from a ImportTeamsV2WithResponse call
+func ParseImportTeamsV2Response(rsp *http.Response) (*ImportTeamsV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTeamsV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			DryRun bool `json:"dry_run"`
+			Teams  int  `json:"teams"`
+			Users  int  `json:"users"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveTeamV2Response parses an HTTP response from a ArchiveTeamV2WithResponse call
+func ParseArchiveTeamV2Response(rsp *http.Response) (*ArchiveTeamV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveTeamV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamV2Response parses an HTTP response from a GetTeamV2WithResponse call
+func ParseGetTeamV2Response(rsp *http.Response) (*GetTeamV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreTeamV2Response parses an HTTP response from a RestoreTeamV2WithResponse call
+func ParseRestoreTeamV2Response(rsp *http.Response) (*RestoreTeamV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreTeamV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveUserV2Response parses an HTTP response from a ArchiveUserV2WithResponse call
+func ParseArchiveUserV2Response(rsp *http.Response) (*ArchiveUserV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveUserV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArchiveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserV2Response parses an HTTP response from a UpdateUserV2WithResponse call
+func ParseUpdateUserV2Response(rsp *http.Response) (*UpdateUserV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User User `json:"user"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreUserV2Response parses an HTTP response from a RestoreUserV2WithResponse call
+func ParseRestoreUserV2Response(rsp *http.Response) (*RestoreUserV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreUserV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserReviewsV2Response parses an HTTP response from a GetUserReviewsV2WithResponse call
+func ParseGetUserReviewsV2Response(rsp *http.Response) (*GetUserReviewsV2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserReviewsV2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserId       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys/issue)
+	IssueApiKey(w http.ResponseWriter, r *http.Request)
+	// Список API-ключей, включая отозванные
+	// (GET /admin/apiKeys/list)
+	ListApiKeys(w http.ResponseWriter, r *http.Request)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	RevokeApiKey(w http.ResponseWriter, r *http.Request)
+	// Поток событий ревью (Server-Sent Events)
+	// (GET /events/stream)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+	// Архивировать PR, он пропадает из списков и статистики
+	// (POST /pullRequest/archive)
+	ArchivePullRequest(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(w http.ResponseWriter, r *http.Request)
+	// Восстановить PR из архива
+	// (POST /pullRequest/restore)
+	RestorePullRequest(w http.ResponseWriter, r *http.Request)
+	// Статистика по PR и назначениям ревьюверов
+	// (GET /stats)
+	GetStats(w http.ResponseWriter, r *http.Request)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(w http.ResponseWriter, r *http.Request)
+	// Архивировать команду вместе с её участниками
+	// (POST /team/archive)
+	ArchiveTeam(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// Массово создать или обновить команды и пользователей из CSV или YAML
+	// (POST /team/import)
+	ImportTeams(w http.ResponseWriter, r *http.Request, params ImportTeamsParams)
+	// Восстановить команду и участников, архивированных вместе с ней
+	// (POST /team/restore)
+	RestoreTeam(w http.ResponseWriter, r *http.Request)
+	// Архивировать пользователя, он перестаёт назначаться ревьювером
+	// (POST /users/archive)
+	ArchiveUser(w http.ResponseWriter, r *http.Request)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetReview(w http.ResponseWriter, r *http.Request, params GetReviewParams)
+	// Восстановить пользователя из архива
+	// (POST /users/restore)
+	RestoreUser(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetIsActive(w http.ResponseWriter, r *http.Request)
+	// Назначить роль пользователю в его команде
+	// (POST /users/setRole)
+	SetRole(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /v2/pull-requests)
+	CreatePullRequestV2(w http.ResponseWriter, r *http.Request)
+	// Архивировать PR
+	// (DELETE /v2/pull-requests/{pull_request_id})
+	ArchivePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /v2/pull-requests/{pull_request_id}/merge)
+	MergePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath)
+	// Переназначить ревьювера на другого из его команды
+	// (POST /v2/pull-requests/{pull_request_id}/reassign)
+	ReassignPullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath)
+	// Восстановить архивный PR
+	// (POST /v2/pull-requests/{pull_request_id}/restore)
+	RestorePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /v2/teams)
+	CreateTeamV2(w http.ResponseWriter, r *http.Request)
+	// Массово создать или обновить команды и пользователей из CSV или YAML
+	// (POST /v2/teams/import)
+	ImportTeamsV2(w http.ResponseWriter, r *http.Request, params ImportTeamsV2Params)
+	// Архивировать команду вместе с её участниками
+	// (DELETE /v2/teams/{team_name})
+	ArchiveTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath)
+	// Получить команду с участниками
+	// (GET /v2/teams/{team_name})
+	GetTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath)
+	// Восстановить команду и участников, архивированных вместе с ней
+	// (POST /v2/teams/{team_name}/restore)
+	RestoreTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath)
+	// Архивировать пользователя, он перестаёт назначаться ревьювером
+	// (DELETE /v2/users/{user_id})
+	ArchiveUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath)
+	// Изменить активность и/или роль пользователя
+	// (PATCH /v2/users/{user_id})
+	UpdateUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath)
+	// Восстановить архивного пользователя
+	// (POST /v2/users/{user_id}/restore)
+	RestoreUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /v2/users/{user_id}/reviews)
+	GetUserReviewsV2(w http.ResponseWriter, r *http.Request, userId UserIdPath)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Выпустить API-ключ
+// (POST /admin/apiKeys/issue)
+func (_ Unimplemented) IssueApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список API-ключей, включая отозванные
+// (GET /admin/apiKeys/list)
+func (_ Unimplemented) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API-ключ
+// (POST /admin/apiKeys/revoke)
+func (_ Unimplemented) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток событий ревью (Server-Sent Events)
+// (GET /events/stream)
+func (_ Unimplemented) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать PR, он пропадает из списков и статистики
+// (POST /pullRequest/archive)
+func (_ Unimplemented) ArchivePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить PR из архива
+// (POST /pullRequest/restore)
+func (_ Unimplemented) RestorePullRequest(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика по PR и назначениям ревьюверов
+// (GET /stats)
+func (_ Unimplemented) GetStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) AddTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать команду вместе с её участниками
+// (POST /team/archive)
+func (_ Unimplemented) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массово создать или обновить команды и пользователей из CSV или YAML
+// (POST /team/import)
+func (_ Unimplemented) ImportTeams(w http.ResponseWriter, r *http.Request, params ImportTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить команду и участников, архивированных вместе с ней
+// (POST /team/restore)
+func (_ Unimplemented) RestoreTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать пользователя, он перестаёт назначаться ревьювером
+// (POST /users/archive)
+func (_ Unimplemented) ArchiveUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetReview(w http.ResponseWriter, r *http.Request, params GetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить пользователя из архива
+// (POST /users/restore)
+func (_ Unimplemented) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) SetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить роль пользователю в его команде
+// (POST /users/setRole)
+func (_ Unimplemented) SetRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /v2/pull-requests)
+func (_ Unimplemented) CreatePullRequestV2(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать PR
+// (DELETE /v2/pull-requests/{pull_request_id})
+func (_ Unimplemented) ArchivePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /v2/pull-requests/{pull_request_id}/merge)
+func (_ Unimplemented) MergePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить ревьювера на другого из его команды
+// (POST /v2/pull-requests/{pull_request_id}/reassign)
+func (_ Unimplemented) ReassignPullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить архивный PR
+// (POST /v2/pull-requests/{pull_request_id}/restore)
+func (_ Unimplemented) RestorePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /v2/teams)
+func (_ Unimplemented) CreateTeamV2(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массово создать или обновить команды и пользователей из CSV или YAML
+// (POST /v2/teams/import)
+func (_ Unimplemented) ImportTeamsV2(w http.ResponseWriter, r *http.Request, params ImportTeamsV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать команду вместе с её участниками
+// (DELETE /v2/teams/{team_name})
+func (_ Unimplemented) ArchiveTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /v2/teams/{team_name})
+func (_ Unimplemented) GetTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить команду и участников, архивированных вместе с ней
+// (POST /v2/teams/{team_name}/restore)
+func (_ Unimplemented) RestoreTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать пользователя, он перестаёт назначаться ревьювером
+// (DELETE /v2/users/{user_id})
+func (_ Unimplemented) ArchiveUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить активность и/или роль пользователя
+// (PATCH /v2/users/{user_id})
+func (_ Unimplemented) UpdateUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить архивного пользователя
+// (POST /v2/users/{user_id}/restore)
+func (_ Unimplemented) RestoreUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /v2/users/{user_id}/reviews)
+func (_ Unimplemented) GetUserReviewsV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// IssueApiKey operation middleware
+func (siw *ServerInterfaceWrapper) IssueApiKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchivePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ArchivePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchivePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignPullRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignPullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestorePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) RestorePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestorePullRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeam operation middleware
+func (siw *ServerInterfaceWrapper) AddTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveTeam operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTeams operation middleware
+func (siw *ServerInterfaceWrapper) ImportTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTeamsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreTeam operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveUser operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReviewParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreUser operation middleware
+func (siw *ServerInterfaceWrapper) RestoreUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) SetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetRole operation middleware
+func (siw *ServerInterfaceWrapper) SetRole(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetRole(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequestV2 operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequestV2(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequestV2(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchivePullRequestV2 operation middleware
+func (siw *ServerInterfaceWrapper) ArchivePullRequestV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchivePullRequestV2(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequestV2 operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequestV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequestV2(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignPullRequestV2 operation middleware
+func (siw *ServerInterfaceWrapper) ReassignPullRequestV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignPullRequestV2(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestorePullRequestV2 operation middleware
+func (siw *ServerInterfaceWrapper) RestorePullRequestV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pull_request_id" -------------
+	var pullRequestId PullRequestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pull_request_id", chi.URLParam(r, "pull_request_id"), &pullRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestorePullRequestV2(w, r, pullRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTeamV2 operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeamV2(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTeamV2(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTeamsV2 operation middleware
+func (siw *ServerInterfaceWrapper) ImportTeamsV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTeamsV2Params
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTeamsV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveTeamV2 operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveTeamV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNamePath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveTeamV2(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNamePath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamV2(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreTeamV2 operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTeamV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team_name" -------------
+	var teamName TeamNamePath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team_name", chi.URLParam(r, "team_name"), &teamName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTeamV2(w, r, teamName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveUserV2 operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveUserV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveUserV2(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUserV2 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUserV2(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreUserV2 operation middleware
+func (siw *ServerInterfaceWrapper) RestoreUserV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreUserV2(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReviewsV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReviewsV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReviewsV2(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/apiKeys/issue", wrapper.IssueApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/apiKeys/list", wrapper.ListApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/apiKeys/revoke", wrapper.RevokeApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.StreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/archive", wrapper.ArchivePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignPullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/restore", wrapper.RestorePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats", wrapper.GetStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.AddTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/archive", wrapper.ArchiveTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.ImportTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/restore", wrapper.RestoreTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/archive", wrapper.ArchiveUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/restore", wrapper.RestoreUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.SetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setRole", wrapper.SetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/pull-requests", wrapper.CreatePullRequestV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/pull-requests/{pull_request_id}", wrapper.ArchivePullRequestV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/pull-requests/{pull_request_id}/merge", wrapper.MergePullRequestV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/pull-requests/{pull_request_id}/reassign", wrapper.ReassignPullRequestV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/pull-requests/{pull_request_id}/restore", wrapper.RestorePullRequestV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/teams", wrapper.CreateTeamV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/teams/import", wrapper.ImportTeamsV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/teams/{team_name}", wrapper.ArchiveTeamV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/teams/{team_name}", wrapper.GetTeamV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/teams/{team_name}/restore", wrapper.RestoreTeamV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/users/{user_id}", wrapper.ArchiveUserV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/users/{user_id}", wrapper.UpdateUserV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/users/{user_id}/restore", wrapper.RestoreUserV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/users/{user_id}/reviews", wrapper.GetUserReviewsV2)
+	})
+
+	return r
+}
+
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ForbiddenApplicationProblemPlusJSONResponse Problem
+
+type InternalErrorApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type NotReadyApplicationProblemPlusJSONResponse Problem
+
+type PayloadTooLargeApplicationProblemPlusJSONResponse Problem
+
+type TooManyRequestsResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+type TooManyRequestsApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type UnauthorizedApplicationProblemPlusJSONResponse Problem
+
+type IssueApiKeyRequestObject struct {
+	Body *IssueApiKeyJSONRequestBody
+}
+
+type IssueApiKeyResponseObject interface {
+	VisitIssueApiKeyResponse(w http.ResponseWriter) error
+}
+
+type IssueApiKey201JSONResponse struct {
+	Key    APIKey `json:"key"`
+	Secret string `json:"secret"`
+}
+
+func (response IssueApiKey201JSONResponse) VisitIssueApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueApiKey400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response IssueApiKey400ApplicationProblemPlusJSONResponse) VisitIssueApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueApiKey401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response IssueApiKey401ApplicationProblemPlusJSONResponse) VisitIssueApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueApiKey403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response IssueApiKey403ApplicationProblemPlusJSONResponse) VisitIssueApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueApiKey500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response IssueApiKey500ApplicationProblemPlusJSONResponse) VisitIssueApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeysRequestObject struct {
+}
+
+type ListApiKeysResponseObject interface {
+	VisitListApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListApiKeys200JSONResponse struct {
+	Keys []APIKey `json:"keys"`
+}
+
+func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListApiKeys401ApplicationProblemPlusJSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListApiKeys403ApplicationProblemPlusJSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListApiKeys500ApplicationProblemPlusJSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKeyRequestObject struct {
+	Body *RevokeApiKeyJSONRequestBody
+}
+
+type RevokeApiKeyResponseObject interface {
+	VisitRevokeApiKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeApiKey200JSONResponse struct {
+	Key APIKey `json:"key"`
+}
+
+func (response RevokeApiKey200JSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeApiKey400ApplicationProblemPlusJSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeApiKey401ApplicationProblemPlusJSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeApiKey403ApplicationProblemPlusJSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey404ApplicationProblemPlusJSONResponse Problem
+
+func (response RevokeApiKey404ApplicationProblemPlusJSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeApiKey500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeApiKey500ApplicationProblemPlusJSONResponse) VisitRevokeApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamEventsRequestObject struct {
+	Params StreamEventsParams
+}
+
+type StreamEventsResponseObject interface {
+	VisitStreamEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamEvents200TexteventStreamResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamEvents400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response StreamEvents400ApplicationProblemPlusJSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamEvents500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response StreamEvents500ApplicationProblemPlusJSONResponse) VisitStreamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestRequestObject struct {
+	Body *ArchivePullRequestJSONRequestBody
+}
+
+type ArchivePullRequestResponseObject interface {
+	VisitArchivePullRequestResponse(w http.ResponseWriter) error
+}
+
+type ArchivePullRequest200JSONResponse ArchiveResult
+
+func (response ArchivePullRequest200JSONResponse) VisitArchivePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequest400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequest400ApplicationProblemPlusJSONResponse) VisitArchivePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequest403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequest403ApplicationProblemPlusJSONResponse) VisitArchivePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequest404ApplicationProblemPlusJSONResponse Problem
+
+func (response ArchivePullRequest404ApplicationProblemPlusJSONResponse) VisitArchivePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequest500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequest500ApplicationProblemPlusJSONResponse) VisitArchivePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestRequestObject struct {
+	Body *CreatePullRequestJSONRequestBody
+}
+
+type CreatePullRequestResponseObject interface {
+	VisitCreatePullRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePullRequest201JSONResponse struct {
+	// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+	PullRequest PullRequestV1 `json:"pull_request"`
+}
+
+func (response CreatePullRequest201JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequest400ApplicationProblemPlusJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest404ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePullRequest404ApplicationProblemPlusJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePullRequest409ApplicationProblemPlusJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequest429ApplicationProblemPlusJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePullRequest500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequest500ApplicationProblemPlusJSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestRequestObject struct {
+	Body *MergePullRequestJSONRequestBody
+}
+
+type MergePullRequestResponseObject interface {
+	VisitMergePullRequestResponse(w http.ResponseWriter) error
+}
+
+type MergePullRequest200JSONResponse struct {
+	// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+	PullRequest PullRequestV1 `json:"pull_request"`
+}
+
+func (response MergePullRequest200JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequest400ApplicationProblemPlusJSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequest403ApplicationProblemPlusJSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest404ApplicationProblemPlusJSONResponse Problem
+
+func (response MergePullRequest404ApplicationProblemPlusJSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequest500ApplicationProblemPlusJSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestRequestObject struct {
+	Body *ReassignPullRequestJSONRequestBody
+}
+
+type ReassignPullRequestResponseObject interface {
+	VisitReassignPullRequestResponse(w http.ResponseWriter) error
+}
+
+type ReassignPullRequest200JSONResponse struct {
+	// PullRequest PR в ответах v1 — метки времени в snake_case, как их всегда отдавал v1
+	PullRequest PullRequestV1 `json:"pull_request"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response ReassignPullRequest200JSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequest400ApplicationProblemPlusJSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequest403ApplicationProblemPlusJSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest404ApplicationProblemPlusJSONResponse Problem
+
+func (response ReassignPullRequest404ApplicationProblemPlusJSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest409ApplicationProblemPlusJSONResponse Problem
+
+func (response ReassignPullRequest409ApplicationProblemPlusJSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequest500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequest500ApplicationProblemPlusJSONResponse) VisitReassignPullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequestRequestObject struct {
+	Body *RestorePullRequestJSONRequestBody
+}
+
+type RestorePullRequestResponseObject interface {
+	VisitRestorePullRequestResponse(w http.ResponseWriter) error
+}
+
+type RestorePullRequest204Response struct {
+}
+
+func (response RestorePullRequest204Response) VisitRestorePullRequestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestorePullRequest400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequest400ApplicationProblemPlusJSONResponse) VisitRestorePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequest403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequest403ApplicationProblemPlusJSONResponse) VisitRestorePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequest404ApplicationProblemPlusJSONResponse Problem
+
+func (response RestorePullRequest404ApplicationProblemPlusJSONResponse) VisitRestorePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequest500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequest500ApplicationProblemPlusJSONResponse) VisitRestorePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStats500ApplicationProblemPlusJSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamRequestObject struct {
+	Body *AddTeamJSONRequestBody
+}
+
+type AddTeamResponseObject interface {
+	VisitAddTeamResponse(w http.ResponseWriter) error
+}
+
+type AddTeam201JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response AddTeam201JSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam400ApplicationProblemPlusJSONResponse Problem
+
+func (response AddTeam400ApplicationProblemPlusJSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response AddTeam403ApplicationProblemPlusJSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeam500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AddTeam500ApplicationProblemPlusJSONResponse) VisitAddTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamRequestObject struct {
+	Body *ArchiveTeamJSONRequestBody
+}
+
+type ArchiveTeamResponseObject interface {
+	VisitArchiveTeamResponse(w http.ResponseWriter) error
+}
+
+type ArchiveTeam200JSONResponse ArchiveResult
+
+func (response ArchiveTeam200JSONResponse) VisitArchiveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeam400ApplicationProblemPlusJSONResponse) VisitArchiveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeam403ApplicationProblemPlusJSONResponse) VisitArchiveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeam404ApplicationProblemPlusJSONResponse Problem
+
+func (response ArchiveTeam404ApplicationProblemPlusJSONResponse) VisitArchiveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeam500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeam500ApplicationProblemPlusJSONResponse) VisitArchiveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRequestObject struct {
+	Params GetTeamParams
+}
+
+type GetTeamResponseObject interface {
+	VisitGetTeamResponse(w http.ResponseWriter) error
+}
+
+type GetTeam200JSONResponse Team
+
+func (response GetTeam200JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeam400ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetTeam404ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeam500ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsRequestObject struct {
+	Params      ImportTeamsParams
+	ContentType string
+	Body        io.Reader
+}
+
+type ImportTeamsResponseObject interface {
+	VisitImportTeamsResponse(w http.ResponseWriter) error
+}
+
+type ImportTeams200JSONResponse struct {
+	DryRun bool `json:"dry_run"`
+	Teams  int  `json:"teams"`
+	Users  int  `json:"users"`
+}
+
+func (response ImportTeams200JSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeams400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeams400ApplicationProblemPlusJSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeams403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeams403ApplicationProblemPlusJSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeams413ApplicationProblemPlusJSONResponse struct {
+	PayloadTooLargeApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeams413ApplicationProblemPlusJSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeams422ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTeams422ApplicationProblemPlusJSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeams500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeams500ApplicationProblemPlusJSONResponse) VisitImportTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeamRequestObject struct {
+	Body *RestoreTeamJSONRequestBody
+}
+
+type RestoreTeamResponseObject interface {
+	VisitRestoreTeamResponse(w http.ResponseWriter) error
+}
+
+type RestoreTeam204Response struct {
+}
+
+func (response RestoreTeam204Response) VisitRestoreTeamResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeam400ApplicationProblemPlusJSONResponse) VisitRestoreTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeam403ApplicationProblemPlusJSONResponse) VisitRestoreTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeam404ApplicationProblemPlusJSONResponse Problem
+
+func (response RestoreTeam404ApplicationProblemPlusJSONResponse) VisitRestoreTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeam500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeam500ApplicationProblemPlusJSONResponse) VisitRestoreTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserRequestObject struct {
+	Body *ArchiveUserJSONRequestBody
+}
+
+type ArchiveUserResponseObject interface {
+	VisitArchiveUserResponse(w http.ResponseWriter) error
+}
+
+type ArchiveUser200JSONResponse ArchiveResult
+
+func (response ArchiveUser200JSONResponse) VisitArchiveUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUser400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUser400ApplicationProblemPlusJSONResponse) VisitArchiveUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUser403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUser403ApplicationProblemPlusJSONResponse) VisitArchiveUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response ArchiveUser404ApplicationProblemPlusJSONResponse) VisitArchiveUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUser500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUser500ApplicationProblemPlusJSONResponse) VisitArchiveUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewRequestObject struct {
+	Params GetReviewParams
+}
+
+type GetReviewResponseObject interface {
+	VisitGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetReview200JSONResponse) VisitGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReview400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetReview400ApplicationProblemPlusJSONResponse) VisitGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReview403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetReview403ApplicationProblemPlusJSONResponse) VisitGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReview500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetReview500ApplicationProblemPlusJSONResponse) VisitGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserRequestObject struct {
+	Body *RestoreUserJSONRequestBody
+}
+
+type RestoreUserResponseObject interface {
+	VisitRestoreUserResponse(w http.ResponseWriter) error
+}
+
+type RestoreUser204Response struct {
+}
+
+func (response RestoreUser204Response) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreUser400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUser400ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUser403ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser404ApplicationProblemPlusJSONResponse Problem
+
+func (response RestoreUser404ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser409ApplicationProblemPlusJSONResponse Problem
+
+func (response RestoreUser409ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUser500ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetIsActiveRequestObject struct {
+	Body *SetIsActiveJSONRequestBody
+}
+
+type SetIsActiveResponseObject interface {
+	VisitSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type SetIsActive200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetIsActive200JSONResponse) VisitSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetIsActive400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SetIsActive400ApplicationProblemPlusJSONResponse) VisitSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetIsActive403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SetIsActive403ApplicationProblemPlusJSONResponse) VisitSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetIsActive404ApplicationProblemPlusJSONResponse Problem
+
+func (response SetIsActive404ApplicationProblemPlusJSONResponse) VisitSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetIsActive500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SetIsActive500ApplicationProblemPlusJSONResponse) VisitSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRoleRequestObject struct {
+	Body *SetRoleJSONRequestBody
+}
+
+type SetRoleResponseObject interface {
+	VisitSetRoleResponse(w http.ResponseWriter) error
+}
+
+type SetRole200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetRole200JSONResponse) VisitSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRole400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SetRole400ApplicationProblemPlusJSONResponse) VisitSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRole403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SetRole403ApplicationProblemPlusJSONResponse) VisitSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRole404ApplicationProblemPlusJSONResponse Problem
+
+func (response SetRole404ApplicationProblemPlusJSONResponse) VisitSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRole500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SetRole500ApplicationProblemPlusJSONResponse) VisitSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestV2RequestObject struct {
+	Body *CreatePullRequestV2JSONRequestBody
+}
+
+type CreatePullRequestV2ResponseObject interface {
+	VisitCreatePullRequestV2Response(w http.ResponseWriter) error
+}
+
+type CreatePullRequestV2201JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response CreatePullRequestV2201JSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequestV2400ApplicationProblemPlusJSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequestV2404ApplicationProblemPlusJSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreatePullRequestV2409ApplicationProblemPlusJSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequestV2429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequestV2429ApplicationProblemPlusJSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePullRequestV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePullRequestV2500ApplicationProblemPlusJSONResponse) VisitCreatePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestV2RequestObject struct {
+	PullRequestId PullRequestIdPath `json:"pull_request_id"`
+}
+
+type ArchivePullRequestV2ResponseObject interface {
+	VisitArchivePullRequestV2Response(w http.ResponseWriter) error
+}
+
+type ArchivePullRequestV2200JSONResponse ArchiveResult
+
+func (response ArchivePullRequestV2200JSONResponse) VisitArchivePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequestV2400ApplicationProblemPlusJSONResponse) VisitArchivePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequestV2403ApplicationProblemPlusJSONResponse) VisitArchivePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequestV2404ApplicationProblemPlusJSONResponse) VisitArchivePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchivePullRequestV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchivePullRequestV2500ApplicationProblemPlusJSONResponse) VisitArchivePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestV2RequestObject struct {
+	PullRequestId PullRequestIdPath `json:"pull_request_id"`
+}
+
+type MergePullRequestV2ResponseObject interface {
+	VisitMergePullRequestV2Response(w http.ResponseWriter) error
+}
+
+type MergePullRequestV2200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response MergePullRequestV2200JSONResponse) VisitMergePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequestV2400ApplicationProblemPlusJSONResponse) VisitMergePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequestV2403ApplicationProblemPlusJSONResponse) VisitMergePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequestV2404ApplicationProblemPlusJSONResponse) VisitMergePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequestV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response MergePullRequestV2500ApplicationProblemPlusJSONResponse) VisitMergePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2RequestObject struct {
+	PullRequestId PullRequestIdPath `json:"pull_request_id"`
+	Body          *ReassignPullRequestV2JSONRequestBody
+}
+
+type ReassignPullRequestV2ResponseObject interface {
+	VisitReassignPullRequestV2Response(w http.ResponseWriter) error
+}
+
+type ReassignPullRequestV2200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response ReassignPullRequestV2200JSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequestV2400ApplicationProblemPlusJSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequestV2403ApplicationProblemPlusJSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequestV2404ApplicationProblemPlusJSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response ReassignPullRequestV2409ApplicationProblemPlusJSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignPullRequestV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReassignPullRequestV2500ApplicationProblemPlusJSONResponse) VisitReassignPullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequestV2RequestObject struct {
+	PullRequestId PullRequestIdPath `json:"pull_request_id"`
+}
+
+type RestorePullRequestV2ResponseObject interface {
+	VisitRestorePullRequestV2Response(w http.ResponseWriter) error
+}
+
+type RestorePullRequestV2204Response struct {
+}
+
+func (response RestorePullRequestV2204Response) VisitRestorePullRequestV2Response(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestorePullRequestV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequestV2400ApplicationProblemPlusJSONResponse) VisitRestorePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequestV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequestV2403ApplicationProblemPlusJSONResponse) VisitRestorePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequestV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequestV2404ApplicationProblemPlusJSONResponse) VisitRestorePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePullRequestV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePullRequestV2500ApplicationProblemPlusJSONResponse) VisitRestorePullRequestV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamV2RequestObject struct {
+	Body *CreateTeamV2JSONRequestBody
+}
+
+type CreateTeamV2ResponseObject interface {
+	VisitCreateTeamV2Response(w http.ResponseWriter) error
+}
+
+type CreateTeamV2201JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response CreateTeamV2201JSONResponse) VisitCreateTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeamV2400ApplicationProblemPlusJSONResponse) VisitCreateTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeamV2403ApplicationProblemPlusJSONResponse) VisitCreateTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response CreateTeamV2409ApplicationProblemPlusJSONResponse) VisitCreateTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeamV2500ApplicationProblemPlusJSONResponse) VisitCreateTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2RequestObject struct {
+	Params      ImportTeamsV2Params
+	ContentType string
+	Body        io.Reader
+}
+
+type ImportTeamsV2ResponseObject interface {
+	VisitImportTeamsV2Response(w http.ResponseWriter) error
+}
+
+type ImportTeamsV2200JSONResponse struct {
+	DryRun bool `json:"dry_run"`
+	Teams  int  `json:"teams"`
+	Users  int  `json:"users"`
+}
+
+func (response ImportTeamsV2200JSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeamsV2400ApplicationProblemPlusJSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeamsV2403ApplicationProblemPlusJSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2413ApplicationProblemPlusJSONResponse struct {
+	PayloadTooLargeApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeamsV2413ApplicationProblemPlusJSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2422ApplicationProblemPlusJSONResponse Problem
+
+func (response ImportTeamsV2422ApplicationProblemPlusJSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTeamsV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ImportTeamsV2500ApplicationProblemPlusJSONResponse) VisitImportTeamsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamV2RequestObject struct {
+	TeamName TeamNamePath `json:"team_name"`
+}
+
+type ArchiveTeamV2ResponseObject interface {
+	VisitArchiveTeamV2Response(w http.ResponseWriter) error
+}
+
+type ArchiveTeamV2200JSONResponse ArchiveResult
+
+func (response ArchiveTeamV2200JSONResponse) VisitArchiveTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeamV2400ApplicationProblemPlusJSONResponse) VisitArchiveTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeamV2403ApplicationProblemPlusJSONResponse) VisitArchiveTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeamV2404ApplicationProblemPlusJSONResponse) VisitArchiveTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveTeamV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveTeamV2500ApplicationProblemPlusJSONResponse) VisitArchiveTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamV2RequestObject struct {
+	TeamName TeamNamePath `json:"team_name"`
+}
+
+type GetTeamV2ResponseObject interface {
+	VisitGetTeamV2Response(w http.ResponseWriter) error
+}
+
+type GetTeamV2200JSONResponse Team
+
+func (response GetTeamV2200JSONResponse) VisitGetTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamV2400ApplicationProblemPlusJSONResponse) VisitGetTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamV2404ApplicationProblemPlusJSONResponse) VisitGetTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeamV2500ApplicationProblemPlusJSONResponse) VisitGetTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeamV2RequestObject struct {
+	TeamName TeamNamePath `json:"team_name"`
+}
+
+type RestoreTeamV2ResponseObject interface {
+	VisitRestoreTeamV2Response(w http.ResponseWriter) error
+}
+
+type RestoreTeamV2204Response struct {
+}
+
+func (response RestoreTeamV2204Response) VisitRestoreTeamV2Response(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreTeamV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeamV2400ApplicationProblemPlusJSONResponse) VisitRestoreTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeamV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeamV2403ApplicationProblemPlusJSONResponse) VisitRestoreTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeamV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeamV2404ApplicationProblemPlusJSONResponse) VisitRestoreTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTeamV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreTeamV2500ApplicationProblemPlusJSONResponse) VisitRestoreTeamV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserV2RequestObject struct {
+	UserId UserIdPath `json:"user_id"`
+}
+
+type ArchiveUserV2ResponseObject interface {
+	VisitArchiveUserV2Response(w http.ResponseWriter) error
+}
+
+type ArchiveUserV2200JSONResponse ArchiveResult
+
+func (response ArchiveUserV2200JSONResponse) VisitArchiveUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUserV2400ApplicationProblemPlusJSONResponse) VisitArchiveUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUserV2403ApplicationProblemPlusJSONResponse) VisitArchiveUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUserV2404ApplicationProblemPlusJSONResponse) VisitArchiveUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveUserV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ArchiveUserV2500ApplicationProblemPlusJSONResponse) VisitArchiveUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserV2RequestObject struct {
+	UserId UserIdPath `json:"user_id"`
+	Body   *UpdateUserV2JSONRequestBody
+}
+
+type UpdateUserV2ResponseObject interface {
+	VisitUpdateUserV2Response(w http.ResponseWriter) error
+}
+
+type UpdateUserV2200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response UpdateUserV2200JSONResponse) VisitUpdateUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateUserV2400ApplicationProblemPlusJSONResponse) VisitUpdateUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateUserV2403ApplicationProblemPlusJSONResponse) VisitUpdateUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateUserV2404ApplicationProblemPlusJSONResponse) VisitUpdateUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateUserV2500ApplicationProblemPlusJSONResponse) VisitUpdateUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserV2RequestObject struct {
+	UserId UserIdPath `json:"user_id"`
+}
+
+type RestoreUserV2ResponseObject interface {
+	VisitRestoreUserV2Response(w http.ResponseWriter) error
+}
+
+type RestoreUserV2204Response struct {
+}
+
+func (response RestoreUserV2204Response) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreUserV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUserV2400ApplicationProblemPlusJSONResponse) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUserV2403ApplicationProblemPlusJSONResponse) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserV2404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUserV2404ApplicationProblemPlusJSONResponse) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserV2409ApplicationProblemPlusJSONResponse Problem
+
+func (response RestoreUserV2409ApplicationProblemPlusJSONResponse) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RestoreUserV2500ApplicationProblemPlusJSONResponse) VisitRestoreUserV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewsV2RequestObject struct {
+	UserId UserIdPath `json:"user_id"`
+}
+
+type GetUserReviewsV2ResponseObject interface {
+	VisitGetUserReviewsV2Response(w http.ResponseWriter) error
+}
+
+type GetUserReviewsV2200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUserReviewsV2200JSONResponse) VisitGetUserReviewsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewsV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserReviewsV2400ApplicationProblemPlusJSONResponse) VisitGetUserReviewsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewsV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserReviewsV2403ApplicationProblemPlusJSONResponse) VisitGetUserReviewsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviewsV2500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserReviewsV2500ApplicationProblemPlusJSONResponse) VisitGetUserReviewsV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Выпустить API-ключ
+	// (POST /admin/apiKeys/issue)
+	IssueApiKey(ctx context.Context, request IssueApiKeyRequestObject) (IssueApiKeyResponseObject, error)
+	// Список API-ключей, включая отозванные
+	// (GET /admin/apiKeys/list)
+	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
+	// Отозвать API-ключ
+	// (POST /admin/apiKeys/revoke)
+	RevokeApiKey(ctx context.Context, request RevokeApiKeyRequestObject) (RevokeApiKeyResponseObject, error)
+	// Поток событий ревью (Server-Sent Events)
+	// (GET /events/stream)
+	StreamEvents(ctx context.Context, request StreamEventsRequestObject) (StreamEventsResponseObject, error)
+	// Архивировать PR, он пропадает из списков и статистики
+	// (POST /pullRequest/archive)
+	ArchivePullRequest(ctx context.Context, request ArchivePullRequestRequestObject) (ArchivePullRequestResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, request CreatePullRequestRequestObject) (CreatePullRequestResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, request MergePullRequestRequestObject) (MergePullRequestResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignPullRequest(ctx context.Context, request ReassignPullRequestRequestObject) (ReassignPullRequestResponseObject, error)
+	// Восстановить PR из архива
+	// (POST /pullRequest/restore)
+	RestorePullRequest(ctx context.Context, request RestorePullRequestRequestObject) (RestorePullRequestResponseObject, error)
+	// Статистика по PR и назначениям ревьюверов
+	// (GET /stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	AddTeam(ctx context.Context, request AddTeamRequestObject) (AddTeamResponseObject, error)
+	// Архивировать команду вместе с её участниками
+	// (POST /team/archive)
+	ArchiveTeam(ctx context.Context, request ArchiveTeamRequestObject) (ArchiveTeamResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(ctx context.Context, request GetTeamRequestObject) (GetTeamResponseObject, error)
+	// Массово создать или обновить команды и пользователей из CSV или YAML
+	// (POST /team/import)
+	ImportTeams(ctx context.Context, request ImportTeamsRequestObject) (ImportTeamsResponseObject, error)
+	// Восстановить команду и участников, архивированных вместе с ней
+	// (POST /team/restore)
+	RestoreTeam(ctx context.Context, request RestoreTeamRequestObject) (RestoreTeamResponseObject, error)
+	// Архивировать пользователя, он перестаёт назначаться ревьювером
+	// (POST /users/archive)
+	ArchiveUser(ctx context.Context, request ArchiveUserRequestObject) (ArchiveUserResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetReview(ctx context.Context, request GetReviewRequestObject) (GetReviewResponseObject, error)
+	// Восстановить пользователя из архива
+	// (POST /users/restore)
+	RestoreUser(ctx context.Context, request RestoreUserRequestObject) (RestoreUserResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetIsActive(ctx context.Context, request SetIsActiveRequestObject) (SetIsActiveResponseObject, error)
+	// Назначить роль пользователю в его команде
+	// (POST /users/setRole)
+	SetRole(ctx context.Context, request SetRoleRequestObject) (SetRoleResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /v2/pull-requests)
+	CreatePullRequestV2(ctx context.Context, request CreatePullRequestV2RequestObject) (CreatePullRequestV2ResponseObject, error)
+	// Архивировать PR
+	// (DELETE /v2/pull-requests/{pull_request_id})
+	ArchivePullRequestV2(ctx context.Context, request ArchivePullRequestV2RequestObject) (ArchivePullRequestV2ResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /v2/pull-requests/{pull_request_id}/merge)
+	MergePullRequestV2(ctx context.Context, request MergePullRequestV2RequestObject) (MergePullRequestV2ResponseObject, error)
+	// Переназначить ревьювера на другого из его команды
+	// (POST /v2/pull-requests/{pull_request_id}/reassign)
+	ReassignPullRequestV2(ctx context.Context, request ReassignPullRequestV2RequestObject) (ReassignPullRequestV2ResponseObject, error)
+	// Восстановить архивный PR
+	// (POST /v2/pull-requests/{pull_request_id}/restore)
+	RestorePullRequestV2(ctx context.Context, request RestorePullRequestV2RequestObject) (RestorePullRequestV2ResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /v2/teams)
+	CreateTeamV2(ctx context.Context, request CreateTeamV2RequestObject) (CreateTeamV2ResponseObject, error)
+	// Массово создать или обновить команды и пользователей из CSV или YAML
+	// (POST /v2/teams/import)
+	ImportTeamsV2(ctx context.Context, request ImportTeamsV2RequestObject) (ImportTeamsV2ResponseObject, error)
+	// Архивировать команду вместе с её участниками
+	// (DELETE /v2/teams/{team_name})
+	ArchiveTeamV2(ctx context.Context, request ArchiveTeamV2RequestObject) (ArchiveTeamV2ResponseObject, error)
+	// Получить команду с участниками
+	// (GET /v2/teams/{team_name})
+	GetTeamV2(ctx context.Context, request GetTeamV2RequestObject) (GetTeamV2ResponseObject, error)
+	// Восстановить команду и участников, архивированных вместе с ней
+	// (POST /v2/teams/{team_name}/restore)
+	RestoreTeamV2(ctx context.Context, request RestoreTeamV2RequestObject) (RestoreTeamV2ResponseObject, error)
+	// Архивировать пользователя, он перестаёт назначаться ревьювером
+	// (DELETE /v2/users/{user_id})
+	ArchiveUserV2(ctx context.Context, request ArchiveUserV2RequestObject) (ArchiveUserV2ResponseObject, error)
+	// Изменить активность и/или роль пользователя
+	// (PATCH /v2/users/{user_id})
+	UpdateUserV2(ctx context.Context, request UpdateUserV2RequestObject) (UpdateUserV2ResponseObject, error)
+	// Восстановить архивного пользователя
+	// (POST /v2/users/{user_id}/restore)
+	RestoreUserV2(ctx context.Context, request RestoreUserV2RequestObject) (RestoreUserV2ResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /v2/users/{user_id}/reviews)
+	GetUserReviewsV2(ctx context.Context, request GetUserReviewsV2RequestObject) (GetUserReviewsV2ResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// IssueApiKey operation middleware
+func (sh *strictHandler) IssueApiKey(w http.ResponseWriter, r *http.Request) {
+	var request IssueApiKeyRequestObject
+
+	var body IssueApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueApiKey(ctx, request.(IssueApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IssueApiKeyResponseObject); ok {
+		if err := validResponse.VisitIssueApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApiKeys operation middleware
+func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiKeys(ctx, request.(ListApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
+		if err := validResponse.VisitListApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeApiKey operation middleware
+func (sh *strictHandler) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+	var request RevokeApiKeyRequestObject
+
+	var body RevokeApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeApiKey(ctx, request.(RevokeApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeApiKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamEvents operation middleware
+func (sh *strictHandler) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	var request StreamEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamEvents(ctx, request.(StreamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamEventsResponseObject); ok {
+		if err := validResponse.VisitStreamEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchivePullRequest operation middleware
+func (sh *strictHandler) ArchivePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request ArchivePullRequestRequestObject
+
+	var body ArchivePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchivePullRequest(ctx, request.(ArchivePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchivePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchivePullRequestResponseObject); ok {
+		if err := validResponse.VisitArchivePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePullRequest operation middleware
+func (sh *strictHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request CreatePullRequestRequestObject
+
+	var body CreatePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePullRequest(ctx, request.(CreatePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePullRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePullRequest operation middleware
+func (sh *strictHandler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request MergePullRequestRequestObject
+
+	var body MergePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePullRequest(ctx, request.(MergePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePullRequestResponseObject); ok {
+		if err := validResponse.VisitMergePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignPullRequest operation middleware
+func (sh *strictHandler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
+	var request ReassignPullRequestRequestObject
+
+	var body ReassignPullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignPullRequest(ctx, request.(ReassignPullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignPullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignPullRequestResponseObject); ok {
+		if err := validResponse.VisitReassignPullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestorePullRequest operation middleware
+func (sh *strictHandler) RestorePullRequest(w http.ResponseWriter, r *http.Request) {
+	var request RestorePullRequestRequestObject
+
+	var body RestorePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestorePullRequest(ctx, request.(RestorePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestorePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestorePullRequestResponseObject); ok {
+		if err := validResponse.VisitRestorePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	var request GetStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddTeam operation middleware
+func (sh *strictHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
+	var request AddTeamRequestObject
+
+	var body AddTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTeam(ctx, request.(AddTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddTeamResponseObject); ok {
+		if err := validResponse.VisitAddTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveTeam operation middleware
+func (sh *strictHandler) ArchiveTeam(w http.ResponseWriter, r *http.Request) {
+	var request ArchiveTeamRequestObject
+
+	var body ArchiveTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveTeam(ctx, request.(ArchiveTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveTeamResponseObject); ok {
+		if err := validResponse.VisitArchiveTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeam operation middleware
+func (sh *strictHandler) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	var request GetTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeam(ctx, request.(GetTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamResponseObject); ok {
+		if err := validResponse.VisitGetTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportTeams operation middleware
+func (sh *strictHandler) ImportTeams(w http.ResponseWriter, r *http.Request, params ImportTeamsParams) {
+	var request ImportTeamsRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportTeams(ctx, request.(ImportTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportTeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportTeamsResponseObject); ok {
+		if err := validResponse.VisitImportTeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreTeam operation middleware
+func (sh *strictHandler) RestoreTeam(w http.ResponseWriter, r *http.Request) {
+	var request RestoreTeamRequestObject
+
+	var body RestoreTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreTeam(ctx, request.(RestoreTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreTeamResponseObject); ok {
+		if err := validResponse.VisitRestoreTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveUser operation middleware
+func (sh *strictHandler) ArchiveUser(w http.ResponseWriter, r *http.Request) {
+	var request ArchiveUserRequestObject
+
+	var body ArchiveUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveUser(ctx, request.(ArchiveUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveUserResponseObject); ok {
+		if err := validResponse.VisitArchiveUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReview operation middleware
+func (sh *strictHandler) GetReview(w http.ResponseWriter, r *http.Request, params GetReviewParams) {
+	var request GetReviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReview(ctx, request.(GetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReviewResponseObject); ok {
+		if err := validResponse.VisitGetReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreUser operation middleware
+func (sh *strictHandler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	var request RestoreUserRequestObject
+
+	var body RestoreUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreUser(ctx, request.(RestoreUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreUserResponseObject); ok {
+		if err := validResponse.VisitRestoreUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetIsActive operation middleware
+func (sh *strictHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
+	var request SetIsActiveRequestObject
+
+	var body SetIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetIsActive(ctx, request.(SetIsActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetIsActive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetIsActiveResponseObject); ok {
+		if err := validResponse.VisitSetIsActiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetRole operation middleware
+func (sh *strictHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	var request SetRoleRequestObject
+
+	var body SetRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetRole(ctx, request.(SetRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetRoleResponseObject); ok {
+		if err := validResponse.VisitSetRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePullRequestV2 operation middleware
+func (sh *strictHandler) CreatePullRequestV2(w http.ResponseWriter, r *http.Request) {
+	var request CreatePullRequestV2RequestObject
+
+	var body CreatePullRequestV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePullRequestV2(ctx, request.(CreatePullRequestV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePullRequestV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePullRequestV2ResponseObject); ok {
+		if err := validResponse.VisitCreatePullRequestV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchivePullRequestV2 operation middleware
+func (sh *strictHandler) ArchivePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	var request ArchivePullRequestV2RequestObject
+
+	request.PullRequestId = pullRequestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchivePullRequestV2(ctx, request.(ArchivePullRequestV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchivePullRequestV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchivePullRequestV2ResponseObject); ok {
+		if err := validResponse.VisitArchivePullRequestV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePullRequestV2 operation middleware
+func (sh *strictHandler) MergePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	var request MergePullRequestV2RequestObject
+
+	request.PullRequestId = pullRequestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePullRequestV2(ctx, request.(MergePullRequestV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePullRequestV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePullRequestV2ResponseObject); ok {
+		if err := validResponse.VisitMergePullRequestV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignPullRequestV2 operation middleware
+func (sh *strictHandler) ReassignPullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	var request ReassignPullRequestV2RequestObject
+
+	request.PullRequestId = pullRequestId
+
+	var body ReassignPullRequestV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignPullRequestV2(ctx, request.(ReassignPullRequestV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignPullRequestV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignPullRequestV2ResponseObject); ok {
+		if err := validResponse.VisitReassignPullRequestV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestorePullRequestV2 operation middleware
+func (sh *strictHandler) RestorePullRequestV2(w http.ResponseWriter, r *http.Request, pullRequestId PullRequestIdPath) {
+	var request RestorePullRequestV2RequestObject
+
+	request.PullRequestId = pullRequestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestorePullRequestV2(ctx, request.(RestorePullRequestV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestorePullRequestV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestorePullRequestV2ResponseObject); ok {
+		if err := validResponse.VisitRestorePullRequestV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTeamV2 operation middleware
+func (sh *strictHandler) CreateTeamV2(w http.ResponseWriter, r *http.Request) {
+	var request CreateTeamV2RequestObject
+
+	var body CreateTeamV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTeamV2(ctx, request.(CreateTeamV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTeamV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTeamV2ResponseObject); ok {
+		if err := validResponse.VisitCreateTeamV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportTeamsV2 operation middleware
+func (sh *strictHandler) ImportTeamsV2(w http.ResponseWriter, r *http.Request, params ImportTeamsV2Params) {
+	var request ImportTeamsV2RequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportTeamsV2(ctx, request.(ImportTeamsV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportTeamsV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportTeamsV2ResponseObject); ok {
+		if err := validResponse.VisitImportTeamsV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveTeamV2 operation middleware
+func (sh *strictHandler) ArchiveTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	var request ArchiveTeamV2RequestObject
+
+	request.TeamName = teamName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveTeamV2(ctx, request.(ArchiveTeamV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveTeamV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveTeamV2ResponseObject); ok {
+		if err := validResponse.VisitArchiveTeamV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamV2 operation middleware
+func (sh *strictHandler) GetTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	var request GetTeamV2RequestObject
+
+	request.TeamName = teamName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamV2(ctx, request.(GetTeamV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamV2ResponseObject); ok {
+		if err := validResponse.VisitGetTeamV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreTeamV2 operation middleware
+func (sh *strictHandler) RestoreTeamV2(w http.ResponseWriter, r *http.Request, teamName TeamNamePath) {
+	var request RestoreTeamV2RequestObject
+
+	request.TeamName = teamName
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreTeamV2(ctx, request.(RestoreTeamV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreTeamV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreTeamV2ResponseObject); ok {
+		if err := validResponse.VisitRestoreTeamV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveUserV2 operation middleware
+func (sh *strictHandler) ArchiveUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	var request ArchiveUserV2RequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveUserV2(ctx, request.(ArchiveUserV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveUserV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveUserV2ResponseObject); ok {
+		if err := validResponse.VisitArchiveUserV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateUserV2 operation middleware
+func (sh *strictHandler) UpdateUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	var request UpdateUserV2RequestObject
+
+	request.UserId = userId
+
+	var body UpdateUserV2JSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateUserV2(ctx, request.(UpdateUserV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateUserV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateUserV2ResponseObject); ok {
+		if err := validResponse.VisitUpdateUserV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreUserV2 operation middleware
+func (sh *strictHandler) RestoreUserV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	var request RestoreUserV2RequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreUserV2(ctx, request.(RestoreUserV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreUserV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreUserV2ResponseObject); ok {
+		if err := validResponse.VisitRestoreUserV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserReviewsV2 operation middleware
+func (sh *strictHandler) GetUserReviewsV2(w http.ResponseWriter, r *http.Request, userId UserIdPath) {
+	var request GetUserReviewsV2RequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserReviewsV2(ctx, request.(GetUserReviewsV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserReviewsV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserReviewsV2ResponseObject); ok {
+		if err := validResponse.VisitGetUserReviewsV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9e28bR5bvVyn0XWDs2aYkynKyEXCBS9t0wsSWtRTtTMbyVVpk2eo12c3tbmqsEQRY",
+	"0niSXHmt9WKAu7g7M5nsLHD/pRUppvXyV6j+CveTXJxTVd3VLz5EUnY8GgwckexH1alT5/k7p9a1qt1o",
+	"2ha1PFebXdeahmM0qEcd/DTfqtfL9J9b1PVKtXnDW4EvTUub1ZrwQdcso0HhU6teX3L4hUtmTdM1+GA6",
+	"tKbNek6L6ppbXaENA25vGE9uUesRPOujGV1rmJb8mNfhsR514AX/834h92sj99up3CcTS7kHf/93mq55",
+	"a014m+s5pvVI29jQtQo1GnNGg3YZm0eNxhL+3eeo8lNT8WFlv/gfW9RZgwfUqFt1zKZn2jAE9ld2wjrs",
+	"kLXZkf+cnbBTdkBYhx37u4QdslN2zNrshO37O5rOB/3P+KAxj/quS52uC9lyqXOOC7gB73GbtuVS5Ldr",
+	"Rk2wG3yq2pZHLfzTaDbrZtUA6k42HXu5Tht//08ukHpdo0+MRrNO+R01eP69wq3SjUKldGdu6WahdKt4",
+	"Q9O1GvUMs67NaoJNSc2mLrFsjzQMr7pCvBVKCvMlIuapa9RxbNgF99e1hyat17RZzWh5K7agT4O6rvEI",
+	"md+xm9Tx1shieMGiRkyXNEzXxYnq4TOSWyV8UsO0zEarQTh9SB3JCg/KaxsPdM31DK/larMzsNSe6cGc",
+	"gWRE0iwgsLFst7zZ5bphPdY21LX7O4c+1Ga1/zYZ7vpJ/qs7Oc/pypclxs5/YgfAtv5T/yn85W+xE3+H",
+	"vSHsNWuzt/5TdupvTpBrhRtL5eI/3i0uVMj/e/oH4m+xA3bETgk7YQfEf8ra7DV7xTr414G/5W/6uzpJ",
+	"rFbs3g47Yh3C3rI23neMdz71dxYt/thNdspO/S22Jx65xfb8bf+Fv0X8Tf8ZO4A7dLj/lB35u8TfJOzU",
+	"/5Z12Cvcn8f84QcwM/8b1vE32RE7gPkRtkc4F0wsWkDHm7azbNZq1BqKO2/eKV8r3bhRnFO5smrU69Qh",
+	"daP62EVelPuPuFW7STV19a+Eqx+OaHxr/1eQWEf+C/8b1par4W+xU3YIZILvToDyhJ342+wnlHU/slMx",
+	"7g1dK1kgEYx6EUg5FOVKc5Viea5wSyWcKZ7OV0qh01V1l8gxkAXqrFKHFMXF46LZvwExgE2RRCf+rr8b",
+	"4TrgWuC4PeS3Nrx0zvZu2i2rNhSF5u5Ulm7euTsXkXhNJ5efmsqjsHuIr1C5aSak0pztkZvignFR5i/s",
+	"wN/0t/2nsA9h/wILsTdsHygl6FCmRm1taDqUi4UbX6l0cKmzalYpCFR3peV5IGNr9m+sCNMom2tBXH/X",
+	"MlYNs24s1+kYCfNDyBCCMD+iWDtlewRFbAdNimPW9rf85xHJ6+/IfYnfgiB86n/LZSwXu6/gUf42jHTe",
+	"WKvbRq1i27cM5xEdisxC2C9V7txZulUof1pMU7TLdm0NaF6H1znEWzEskp+a+YerH39Eltc86qrsmFfo",
+	"L9QaKVqe6a2Rim0TPuTxLcJ/SqWjUBck3CtUHs/9b9kBkZO+XfjV0rU7N75auvZVpbgA76/Y9m3DWhPj",
+	"docjbaFSXLpVul2qxMwXw6OkbjZMj9AnVUprNLKdpz8J6QcEg/GQYEDjI9z3KOr2/B0gETshwI2gWv2t",
+	"KC2Rmw/xV9CxW6xNLoVTXSrOFa7dKt7472BzXp4g7D+Cp/ibqJy3QssBVTrYbDmpn/xtfdESiv45ew0v",
+	"g90Ca+q/iOksvl9K8xOE/W/Whr0GC8/22CHrkLLh0VtA49wvCTtlb2FT+jv4NLAr1Ml1iL9N/G1/E22I",
+	"b8Fu8J+R0CCBZ04sWuwPwFVIBb4/8T2qYgUB+EaaL1yhnrJX/nesw95E3gjDL81HyFaav6wLIUHYAapf",
+	"9ja6IqzDOosWv8Z/xk7ZPifrlqDWITslZeo5a7nCQ486YPHo2go1asIVDEmC/8JXIdcIrgJd/Ig66GqE",
+	"15dpwzAtsIMHucelXopb9X+5ncZegwI9VIYO+pQd+tvgVEWotYcCchMZ5wSJ31HYhx3Bl/Cz/9x/oek9",
+	"xhfS5+xjO2an3EriA9iDFcBFiUv17qNBd87iDof5Wzqc2XB3rnC38tmdcunXUWFjWqtG3awR25G+DKk6",
+	"tEYtzzTqEbk9lQ/lTmRY4xM5/4fvnaRJKtSnsOnZPqy8jl+yDnuN228TpcKJzjfqKX7bZifyWYFihX+/",
+	"4ZZJMGbcDoX50hcUbRTh/pncg6061PBobcnAVXhoOw34S6sZHs15ZoMmnWBdo0+apkNdcU/S84Jtug06",
+	"6MDfDMYk5IwUIAfszYSm9/nCx3QNXM/Z9eRPPA6Q8oNDV+3HA04MXQAki+nRhttrsTlNF6TfIPnGcYw1",
+	"jYcKZEjivpyCGHDwKl1dgAfBM+zlf6JVDx6qvgO2gtVqwOMcasDDfuOYHjzNqDVMS3uQMqWCU10xV2mZ",
+	"uq26l1x+g/88CJliE1OfkDaBay2zXitZD+3ky5fhpyV8S5KP/g33wjE6wGgOCg3UYa9Jrl57WDceuYnB",
+	"6dqT3CM7B1/m3MdmM2fj84x6rmmj48WjQxs6RBGFVkjsUgh2SWWpvEwnaNG2gXn5L/euL+S4e49D+9TO",
+	"gc3Kjjh7w7VDDPCRvbRKHdfkIjCxrspvCVcOrOlN1vF3+TDDCdToKuhtiCqg8aCG+95E6Nxz2eX7IwNN",
+	"W//iqpDwMcarenaaUvp37lj5W6zj/45HJLnCiRhOrK0TMBWEHNzzvxVWBFpsr/wduJ8dDMMfZ5GLMFUh",
+	"qII7TMv7aCa8OlCIeiSy1lOvhIFtLtyadaNKa0sy/jm7fuaZhpHbND7z1qKCp7CwUPp0DrVuuah8uF0s",
+	"f1q8kSKCYnwT0EhcqEcixxGS9JSNn1Gj7q0sCJUe5zA3+D6wHzT7cU/GFrelvU8q9yTX/lmJlLA94v8O",
+	"99ExN+ZJ+eZ18vE/TH1MLmVZOeA6/JG18SknMlQJJtm+GoXpYKAR3Wu0BA8ImEPc8u0ztOJQ1245VRqJ",
+	"rZiW6xlWFW6ahNWYfEQ9EVEXQd9ZbcV2vUljuZqfvpKbgv/lzx6UiVkgdi3CYZVi4fZS8VelhcqCpmvz",
+	"5cjfgs10nJ3CfnN3lq4X5m5AXLbIP5aL90rFL4tlceP1zwpz4Z2SLkoAWNNTw/BpEYPSHF65VL7zJTwd",
+	"B1woX/+sdA9viRmnavg05iSXbhRvz9+pFOeuf7X0RfGrpXLx7kLih9Lc0nz5zqfl4sKCpkcCRUF4MU3x",
+	"yyVP2dMyU9CFjTvc0NxHV/S5NN4E27E30u5ERwRcxEPgeQiLHaHS68t8EtvpJiQaeHwzbkT1L8RCFl5P",
+	"evrbCVdlKM2s7ov420q1RCgGVDB7HXPZ2+RXOSHPc6WaJCeqNDT/hQyIRF1P2THY/2D1/wQmyB5X4j+y",
+	"tv9siOmEcjKposSeXk8LOx3C4pPPKpX5HHdXwfhJJa2iRhJG3iYq7n3WJoqQ0Anq77dR4YdE3Gdt/6W/",
+	"hZKvpyyXGgZnEcxU18TNYot0EfUKbyb0i0iQZfPboczhvNB50JgHRcFgfEoatLFMHfd+/sGE0OF6wAUi",
+	"7RpLIA3Fs3XToqnO2qkYT7iTkQ/B298TYRewDo/D/X3AZwPRLfwvZqjyejQyI61MmO5bzMNtqeOXLNb/",
+	"BIKk43qPNZcXpq6qYkUlLVLXNR9ZtLbk0FWT/oamSUixVHw5X0tvgG9X/xkREazn/gsRM4Po4aWpiYnp",
+	"y6pQzNgd0nPUlbxt2tXCKipkW6ZWq86j/yIXnnhEgzqPhntCPC08u97jmkwLM5Q/fRrAwuCLL3wyUZ18",
+	"vx5JiQfyIGXpe7DPwortpPFQ14X7gGjWizzB+KRVd2ceDaBMNyFy+718cuvNl1HjhXHqtv+MrOa5SRw6",
+	"4WyPxw14CBlucS3jMV2qGi7VCXq8hwQiynBlqHyEtdNGd/KIrIJ1+/MWDwN5rlweDHTL36IAKItfYQBu",
+	"tgZJUbQ/RALs8+Uki5ySzEQQGD7Irv7LgL/93dSYgt2kVs/Xc5P0hO+CA/87/yXn/0NAqYAaT310Zqgh",
+	"vg7ywpCsmhhYKk3tNAsTA5rE30abCXblkb/Lc0x81x7zZFSAS+O4GJ3UqVHjcJxNMGK4r6Jed8reaHog",
+	"lbgVpulancdTs+OoGUuucljKz3xfpdvWcqlS8jYqNfEyuUNTKdhFILHv01gKHTcC1p2/BUbaK8jHc0uv",
+	"w46TrAmpz2MdSNpmx/4OO+Cm+I/+UwTQCAEXpjDAizlmHVWmddvZ0V3VK5Aepbg6+zTaAOwRt2atZnLL",
+	"cl5ZoIdG3aV6Ys3QMu87CwCvuI33pMnqSHRtUPhjxJdRwmRyiFkzFsMZbN60IeIFyiinr86c3ecw3SWj",
+	"6pmrqtRftu06NayYPBkdQpM/dhT0DqVY8ER1SmmkB7RqUgYEdB0THR0hQLtuMbimZ6w3W8JHyXoGwqnc",
+	"242IYA7QassxvbUFGDsnYaFpfkHXCi1vJU3EhRgFriMwWR71RjnSAMJyEcTGBGF/QD/102JFwgEhH4vJ",
+	"OQI5NsBnCFf2VCTllXjYsQBM7KMlh8k4Xbq+k6hLJn9J8L+AqJD5XwjliFfgb1Lxb0NeQ0KgcPD8dz3x",
+	"bhC1MhPCg8LBHQIzNrtoqQANTAb5m2B4cFQrgke/5bBRlOyQUuaRpw47iKhMIrJuwqY+kJekWiuocGFA",
+	"HGPJY9OI2+bQjBC5/ascJHQgJx0KTVxmTBpSw6GOXPBl/HRTmqWff1nR4sn1z7+sEA745eEGHE+H7QXo",
+	"zoWFOwJTuy/gMc8j8BaFdeAq8vmXXyzwae8HltgurOL3KrJNuSedw4gStwI1iaGUz7+sLJUWFu4Wyzr+",
+	"De9aulu+JQNAwXc3S7eKnIS4kXHjIy1Cmq14XpOjDUyRY41tj//wXyAa7A2Zv7NQyanRyV7YjlcADkE4",
+	"UdvfhKv0KEhhLx7XhDhwqUYbTdujVnUNVpdcgt1Bpq9eBS4Dou3Jyy+rmyIFTqQGwSuVW0TiHDpsz9/F",
+	"N59gkA3zgAHa6Tu0jrcnCPs+nBHaO7CIxyJ2KncPLAfHYiCOjh1zQiibcdGC3eE/w31z4r8M8zOBKwpP",
+	"T0R44VkBLQAg1Kwba7Q2SzCgQqLrgPgPmIeA+Kjc+DoAAp0Cwf4T3hudBb5/H62xH9GCU6cTAEQgKvmS",
+	"HfNxH+LP6nv8TTIzPU3SUxJi62B6K7TxonD6RStjBiAOZqY+IRlJjQnC/iwp6e+Qq0+ekAAnH5B913/B",
+	"n4ZxxjbS9w3pn5knFq1Fi4ebI0FlSCOHSWUgdxx1Iy1boB9KQjIzdRVvQxQOiprvWFuOT4YYsjJ95FIA",
+	"8m8TEWW+zEfHsZxtklo8kIJKjEgv/4Uqvfx/Qb2ECQRYr98r2fTfA7KO4K7EXHkIt/0Grzhle7P8F9xk",
+	"QvY9D018LEvgeg4kob+jL1pqxhN4LiOJzyMeUTNO52P5aCZFQBD2p4zl4KMgny/cmYtxtKQEbJY/ARn9",
+	"bYkoBPJkrpviAKlgyGMyMzWVUvOBWhzJ2xHwueNIgkwpxQhRuoJkyGRZwNxuGzR/hSQSkpx5vhf8Obk6",
+	"LQ2CALcuBFZhvjRB2A8YOnuKZCzPX8+FnP1WJAO+EdUkNdp0aBViSTp5knNb1Sp1XdsBr/wQbQgUudwr",
+	"f815FWwoMJk6EUipv8MtjH2UHD+xtrwjCmK9Id5nwi6BnPknH89cvQzbYaFludQjl2BKIWwcVSomoJbu",
+	"5ZcW7s4tFCt8K02uIDLgtzqZBCNu7bcEKcNRKuQSsvSPhMMHLkvk6X7c7/V3pC0nUDEHIkkimbmN+wTD",
+	"jCiwePnEK1HMo2BVZOXPNocEh8hcznl7CgAUw5ryichc3/rbyoU/wZLiSI8SIoGbCjIPP18m0rMmBQzE",
+	"NKjlEVkkcKlCXY9UDPexTm4a9TqZnpq+CnHIAFmk5SemJqZkqMJomtqsdmViauKKhk7ZCprmwszlpps7",
+	"abpuC32Epu2mo6uEuo9twwy7fU9KssimPNAjiVEitQTHbB0hzZ5LXLH/zP8X/9sJHoJykLtKNcjUwki5",
+	"YxFiHa7ZXes4UgCpKhZSm56a/jg3lc9N5StTU7P4/1+HFm/VDHF/s/cFdu9BBFo6iLveDYT5R76VQQRz",
+	"1c2LxLiJLCUxUCcwIQS/pRm3E4S9RJgwXBO7K4HxHATWeSY3fViUZsO0Svy2fI9IUxSpmeKrbsSrTuPl",
+	"odNT+T64KVz+6Ao/pmv9zU3jXrPDEejdvfPHyO7i6vQ5ZW5ZsO78bf87Wfk0MzWVNcCADJNKiSzeku99",
+	"SwSPjTdd6X1TWFm4oWtX+xlZtM4PZu62Gg3DWePIBD7ZTX9LuEMq/hC4GfCnAIjjIWO4PSYL6yYXgY/4",
+	"ukTFzy3T9bj04UHMCNdMDcc1g+6NfsDL7kDMwjNvIvO/y/ZBeYYMBOZS+2fFDT8IMw8cXJUPwNRLuhAR",
+	"iD63WPtiGA5ZV7VnlGfK+PvwOkvC6bUrD6eNT6r52sfLM1Q7qyIKwfkj7ZSQAp4/mwieOh8RnBzygOI1",
+	"wjV61IcVEQeOeAYfKuoccxu8g56lROwcqKnC91pYz0zNDFAMNMI6nLRq3uFlxZ/DdexXbyAo2p10PUek",
+	"qx6lFpPBk+P5UBXw7u8SCYnVSYjOBneC4y54cIAjvrCIQYA6/V14ovSRMcopAMZfm7WveUjjJ4xyxzH2",
+	"/m4s7hJEB3HuyRAhKIKvbxmul8PCgFzpxtcQPvgGiYaJSMValY/jYdtQ5gpXPozUiYAlj4gENooIFkTH",
+	"y52kqGBdQMLjgFxNj/SUuZ+CfYxUyimP1gmiSbJi4zDCdhCRlAjaGCYko71KmFQZT2OaAWY5eD+Y7P4v",
+	"vcfBszv7yD8/BesshOWhyi0yLBEE2pO8mpWLiPBjZMA9yzigz0kPhePRJx7f4rlwh/cnxnBM6fXLAQnU",
+	"KaI1IosDaoZnRAMvHVmk+794UxQinn8m7TC8pMyag7IpyCXeACO3ALELvkMvK1KUfyPEqIj6aLPr8KkZ",
+	"AoYmRZFaNDAho1sS4xiVCaJwToWMDmFyJdBSssnFmc2uFPzV2Oyv+LvGZ4gpJIuUJkJo5WouP5Wbnqnk",
+	"p2evzMxe/ejX/ZflRqsgUxugBOWGGB19hslDESzvZYylBbMS5tiZbbD33JyaL4/LkPrXYB06ItPBjYr5",
+	"siyDEPpexIIPZMVmJCq/R0SdCk8J418dWeIopIiyydHPVaLd2qx2o3irWClCZB1lSk6CjybXY/tiA2cQ",
+	"ETwcjDmI3LmOd4xI7ChIUa2V1/QucigVrakVajXiUtiKZxZUEbTqKJE+Y5WAGeDVCDbq6mCIokHxqeOL",
+	"OGYopiyY832tNa3pWuuK9kAd3/AsFWJ/OT58I8Jj2fpuAKzwvXzXdejLR58vcwNFIGTOLMnP1839V6mw",
+	"JqNA2aS49nf4+D4ZvCeHSEWYAv0aSm+gGcKpCGYhv2MHKrYD0kxGvaWWq6qFnkG56nyZmDVi1DGLR8R7",
+	"1LpTpXvQddt6WDerWV0FId9gl1WobDhY0bQCEvcSNiS7VXCidQQKiGt71WmD5PtOynRiFajBjCybcPAb",
+	"kRuMVA2rZkKihJgW4dvrFy4BB+qsUx1Fu5LuCxhWLEbh1QeKi6t02lMIGyaeoqRtpxIW+HL6k95bLd7J",
+	"akRR31OJ/0KzA/OyYnqiuLsD8Qi0NDpR3LTwVBH7lF74wVH4EV86Qry+7RPAdSWtk6QtghDyQUyR23DD",
+	"hQP0rhyg4VWzUtaT7kCNSnuLsq73V3/LkPkm7q5d3l6MyGFfeGYDB24E9rkTiEYOexNx5ksCAnYsQnUc",
+	"DHYi0mMirovetb97eUhBl3TDhKRLCECH8i00iAwsi3tGJAbtutKzhe/d0UvGyEt+Rj5XT39Jndj7JIIB",
+	"EdW6OnbvSOn7swz7sHVVG4/Ajb2oS5krR31jpD1u47QHWmAt+s6+Uqffi/RQSr1aBE2NX55+yGI+aDme",
+	"kXZKUwNn9/eqK4YlyhojDp+AnO2yI4lE5iDmI8wtikZjegKdLesJwzaSKb5h2L8nbBPdqteJYB8ixkSq",
+	"tlVtOQ61vPqaThxogHl2hzEs34z6ipzI/m4w4zAtxXNRQZuilJkEvym91C3L9ohUT8S2CH8zmS8P4+1e",
+	"l45lure7LxqJbrO3XfzddN9WbbaU6tviVkbAacS9HcarhSl5BaXGOmaRZHP+K3+HHfENoMiJNLfsOHW6",
+	"kVZTSi8t4cCb/FwGqRyIZxNvxXSHWL0RnYEQAb8DIfYlLjTo6CTgBB2gz9sseervjspuTL5AOMqHiKQ+",
+	"hJ/RUsxSKriKYbULv4y70gE2NZqdHrFtGRiRKeal69kOHcy6xFsufOyR+dgzqR0x4y2Vj0TLhcH8lAs/",
+	"cVAQbWovaxFKgy2rpFrHsVP5fsSNyutABDTAlc0cUqG5n1KPtyIYpfcQaQeRl90fpqPtG+6rPUSm5UV5",
+	"XYu4jBu6elk+/TI4gadvLcKnm3rIQyJv2h5RbDX5YI5OE2HWFB3EjpP6gBsoknH4PDgYBBtZGrXaQJiP",
+	"Wq3CDZQzy+Cge8T9dbVwn78tsj5qTb1WqJtViivb7abp6E3X7GU8Z0mp6teaxhpYXW7/2Aic8ThSi56A",
+	"Or1rkiwb1cfUqnWNTsqx9kGoZFuOfoG+ahIuUnLfVlTLmXviR/umBlZqQIluabRzP58rRowumcJ3hvhX",
+	"cz8Ro3Ibq8mxQJyXpHLxhT2JLoXrCm0qJwHWJm0OidPObJygRkKB2bopPw9/VyTdmRFuw0q81K12Rntz",
+	"DN1yLrBqF1i1DLGTsHpZe7zItZgYYXuYRcGzPUCmsAP/ZYZg6S0ZFJQayobJ9WAPbChyQli8j2hfIuJT",
+	"6gnxEMPEpxEnvGQyetpoHxjp99yqSpVr/RlV8RoOBX0dC1X8PGBF57SFvo/XdvSpgXtvFOj11GuXmI2m",
+	"7O+aqUxjdPkv3h45q6mQ/3veeU92itgPDm1DpGonPA5LOXURInQnHGPCY1vJvihBkTb20lGxJIol8iLa",
+	"pQibfWyKtgLYHSrQG+m9ApMPEgmHiHUTaWggdckb3hYBBvUaYUCopbBLw/WFe7NpHYS+DpZEF7tUl9tT",
+	"x2ZqerCbv9Y5Yxzx6CFrE7wACZXWwoS1Jxatrwq3b83yt7iz5H7IAbrsyg3fyleT4N382ToJ3r7xYOPB",
+	"12n1RCVkH85/vcqJ/ktpnBL20NdFH5dtrK6CE2V4f4MXGJYSNVYH0qYMG79c52IgV1lrUnIJK0+q7qpc",
+	"L1UyrBmN+uWM4h1R9aIKO9kxs+quaroGN6ee9dG1jkjdGmJbyxnrPRnd380YbM1ZW3JaVtrpY0GXPK6B",
+	"sgzcX07+MqJvtP4ZcNESqkFv5XVUN7oB//4P8bCJqt3QQV4oF07r1+xlXUcDeNFKLzdaNi3DWUs/gTrR",
+	"21Up8EF2OSDXF+7JNQdujx7OMGLbV9JfWvTcM5HhKJcHubL87uDmtM6G4knrGc1o3T66p3piD/Lr9eB9",
+	"g3rseGJpdxEpWVVF1J6HCZ3v4474GaoIq5we8mzjyCEoQbwhT/AIPnyMaIGUfjh5aJLJ4wmuqGeLy6P8",
+	"BD+QRa1hrC3TRS16tPj0tHqOX9OxQc9D53pxCusYYxhS4ydL7E5STx8/iByyMBoz6Y9oAW1y3alqarSZ",
+	"Elo6xZbqxtjipJfXcXkyaJxC2lOhgXX2XNlFuOIi6/VzcmSycmDxWEAn6dCcsj1dDfEEwYQwfx+PIIDk",
+	"eTPw9lScoGjWDNXmELFF7Ig8xGaN5b3OtkvHAoXMaHp8EW28iDZ2b76fBccbY9QxHRa4G1TRclSOqJF9",
+	"6W9Fs6/4EB7DSMdLSVlz16VOiqxRApNcnEjXekOVMY+ox4uzBotOint6OdnZp4p2IU6WAx7vN9yRVhLv",
+	"zZxTz21/N+08HowYuXB/fbzA5gd6F0nf48CN/o4ajJ/clHJSwxnON4kOpi+fUu0kNl/+hejkm86D5yYY",
+	"Rx+unS//wt/pox9On5DMHiJGhnRj8mWSQ2tcVc6c3fG4sGUuXJH3wVA4E24/jhNRjquNIEUAyyzNyzOC",
+	"l0cPD8mSj5hoCG1S3hZYGC28k/pemvMFND3wX47ZvcsccxfgY4Z4C7y1pHxL+Gou9UpuITgupl8Zt6Dc",
+	"NoSMU9KsQnyNROq9i5OEMpV+1+NrRu/stcShQknSpkWyeia2u5hW8k3dNi0qwTTa9Gf9/FnJFyonaWSY",
+	"BxfyfjQy6q8p8sn/HUhI9mOsNwNe2cm2SHvLqkLl+me9nD2XevLYv77z63+BEbGOSNFCvAzP+ou5qtB4",
+	"XxyqxMPV/KJYwDvT54sevMQPUlAb0gdnOf1FXIeP9zf5MRr+ZngkAk/JQpZfDljk3mWRzR5PGnRXbqLM",
+	"BU4UiAUMU9tYCrIOIcD5YWLyOMQonvyMwnuQ88nOV5LjyM6/qfCFnP1A5eyfEmVtgTzJPtt1L61wLdIw",
+	"/GySNtEPJrOteKLj3L3pi55zFz3nzumUi6YzQPAuOT/n/Du4db9lzvZu2i2rdtFS7aKl2kVLtZ9JS7VU",
+	"jZnS3xUdlTrlHRV69YsWanQIu3GoxO18WQ0zKdiB8zb3+peX4+wZnL32gxY3KLeXavOGt8JLbftvTAVa",
+	"b+g36hnWXLxp3/Bc+G709fvUse08Oficeqv1KfGiPdPGxrQpXdaGc0ISfdbeu15qsY1xPq3NRrSpz6s/",
+	"mPPhdQUbl/MwEuN0kx37LxHGfBAcQZUZP4kvqDyq80ds4qAHhmqqwc9N3X57ho27+9D42gz1L2ZDWMIY",
+	"pWy821CacZCSwEd1nJ7D/5BVcWZuNzSreWB1vtyTAYJalm4xOEDrDqf3hiuUfc96ibz/zTrGzfKfjKMj",
+	"iFzW0QSBzr0byAfT2yMiGlJLji8KjC8KjHsVGKO+uCgxvigxvigxvigxvigxvigx/lBLjKPmktq3pXdW",
+	"RnGs3lU6Jo5pTi/rbP8tJmdG2RZrQ89s6jp+Jjj3rk/nnZgYTUemjbO2E4sm27Jrl2fXh3tBjwBaFiOl",
+	"lb7Edn1GFI21/ybjaOdZAi/VRxws11t5AP7uXSuPTCTjRYb/3VQ5DyzD4D41Qm941ZUky91tAmpJ4bgR",
+	"FeL0n/5smJb6bX7AWpz+0d4bF8DrD3mf/jt7LY4akUmTeJ0JfNmZFDZ/L6R0SulJhkQ/uxUQ36LdbIAs",
+	"pZBmA2QK759dTu2cc9JnrAM9v+yfTAyPgGd5pXqXMyngCRxs644c03XRVOGiqcJwFg3yNXVcXJF1nAit",
+	"thwIj8GjCk3zC7pWaAFS6v4DCOJfw2YpwTcPgvGsy6g7N9w39OALPlDli0iqXfm+uEqt6DfihJPwi8+o",
+	"UfdW1G8KUNsHM/n/AwCY6GrGY9AAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,182 @@
+// Package auth authenticates HTTP requests with API keys and checks their scopes.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"reviewer-service/internal/domain"
+)
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
+// Scopes lists the known scopes from the weakest, every scope includes the ones before it.
+var Scopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// Header carries the API key.
+const Header = "X-API-Key"
+
+// keyPrefix starts every issued key so that leaked keys are easy to find in code and logs.
+const keyPrefix = "rsk"
+
+// BootstrapKeyID identifies the admin key taken from the config, it is never stored.
+const BootstrapKeyID = "bootstrap"
+
+var (
+	ErrUnknownScope  = errors.New("unknown scope")
+	ErrNoScopes      = errors.New("at least one scope is required")
+	ErrInvalidExpiry = errors.New("expires_at must be after the time the key is issued")
+	// ErrNoCredentialSource is returned by New when authentication is on but nothing could ever pass it.
+	ErrNoCredentialSource = errors.New("auth is enabled without AUTH_ADMIN_KEY or a JWT issuer, JWKS URL or JWKS file")
+)
+
+type Config struct {
+	// Enabled turns on authentication for every HTTP endpoint.
+	Enabled bool `env:"AUTH_ENABLED" env-default:"false"`
+	// AdminKey is accepted with the admin scope, it is meant for issuing the first keys.
+	AdminKey string `env:"AUTH_ADMIN_KEY"`
+	// JWT accepts bearer tokens next to API keys when an issuer or JWKS is configured.
+	JWT JWTConfig
+}
+
+// Identity is the caller behind an authenticated request. API keys fill KeyID and Name,
+// bearer tokens fill UserID.
+type Identity struct {
+	KeyID  string
+	Name   string
+	UserID string
+	Scopes []string
+}
+
+// Allows reports whether the identity has scope or a stronger one.
+func (i Identity) Allows(scope string) bool {
+	need := slices.Index(Scopes, scope)
+	for _, s := range i.Scopes {
+		if slices.Index(Scopes, s) >= need {
+			return true
+		}
+	}
+
+	return false
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the caller, ok is false for unauthenticated requests.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// Actor names the caller in the audit trail: the key id for API keys, "user:<user_id>" for
+// bearer tokens and empty for unauthenticated requests.
+func Actor(ctx context.Context) string {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if identity.KeyID == "" && identity.UserID != "" {
+		return "user:" + identity.UserID
+	}
+
+	return identity.KeyID
+}
+
+// UserID returns the user_id of a caller authenticated with a bearer token.
+func UserID(ctx context.Context) string {
+	identity, _ := IdentityFromContext(ctx)
+	return identity.UserID
+}
+
+// NewKey generates a key and returns its plaintext, which is shown once, and the record to store.
+// A nil expiresAt issues a key that never expires.
+func NewKey(name string, scopes []string, createdAt time.Time, expiresAt *time.Time) (string, domain.APIKey, error) {
+	err := ValidateScopes(scopes)
+	if err != nil {
+		return "", domain.APIKey{}, err
+	}
+
+	if expiresAt != nil && !expiresAt.After(createdAt) {
+		return "", domain.APIKey{}, ErrInvalidExpiry
+	}
+
+	id := make([]byte, 6)
+	secret := make([]byte, 32)
+
+	_, err = rand.Read(id)
+	if err != nil {
+		return "", domain.APIKey{}, fmt.Errorf("failed to generate key id: %w", err)
+	}
+
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", domain.APIKey{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	keyID := hex.EncodeToString(id)
+	plaintext := fmt.Sprintf("%s_%s_%s", keyPrefix, keyID, base64.RawURLEncoding.EncodeToString(secret))
+
+	return plaintext, domain.APIKey{
+		KeyID:     keyID,
+		Name:      name,
+		Hash:      Hash(plaintext),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Hash is what the store keeps instead of the key. Keys are random, so a plain SHA-256 is enough.
+func Hash(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return ErrNoScopes
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return fmt.Errorf("%w: %s", ErrUnknownScope, scope)
+		}
+	}
+
+	return nil
+}
+
+// requiredScope maps a request to the scope it needs: admin endpoints need admin,
+// reads need read and everything else needs write.
+func requiredScope(method string, path string) string {
+	switch {
+	case strings.HasPrefix(path, "/admin/"):
+		return ScopeAdmin
+	case method == "GET" || method == "HEAD":
+		return ScopeRead
+	default:
+		return ScopeWrite
+	}
+}
+
+func isBootstrapKey(cfg *Config, key string) bool {
+	return cfg.AdminKey != "" && subtle.ConstantTimeCompare(Hash(cfg.AdminKey), Hash(key)) == 1
+}
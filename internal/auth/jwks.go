@@ -0,0 +1,314 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// refreshCooldown limits how often an unknown kid may trigger a refetch,
+// so a stream of forged tokens cannot hammer the issuer.
+const refreshCooldown = time.Minute
+
+// maxJWKSSize caps the JWKS and discovery documents.
+const maxJWKSSize = 1 << 20
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type publicKey struct {
+	key crypto.PublicKey
+	// alg is empty when the JWK does not pin an algorithm.
+	alg string
+}
+
+// keySet caches the issuer's signing keys. Keys are refetched once the cache is older than ttl
+// and when a token names a kid the cache does not know, which is how rotated keys are picked up.
+type keySet struct {
+	cfg    *JWTConfig
+	client *http.Client
+	logger *zap.Logger
+
+	// group runs one fetch at a time, concurrent callers share its result.
+	group singleflight.Group
+	// jwksURL is only touched by the fetch in flight.
+	jwksURL string
+
+	mu        sync.Mutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+}
+
+func newKeySet(cfg *JWTConfig, logger *zap.Logger) *keySet {
+	return &keySet{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: cfg.FetchTimeout},
+		logger:  logger,
+		jwksURL: cfg.JWKSURL,
+	}
+}
+
+func (s *keySet) get(ctx context.Context, kid string) (publicKey, error) {
+	s.mu.Lock()
+	stale := time.Since(s.fetchedAt) > s.cfg.CacheTTL
+	key, ok := s.keys[kid]
+	refresh := stale || (!ok && time.Since(s.fetchedAt) > refreshCooldown)
+	s.mu.Unlock()
+
+	if refresh {
+		err := s.refresh(ctx)
+
+		s.mu.Lock()
+		keys := s.keys
+		s.mu.Unlock()
+
+		if err != nil {
+			if keys == nil {
+				return publicKey{}, err
+			}
+			// Keep serving the cached keys while the issuer is unavailable.
+			s.logger.Warn("keySet: failed to refresh jwks, using cached keys", zap.Error(err))
+		}
+
+		key, ok = keys[kid]
+	}
+
+	if !ok {
+		return publicKey{}, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+
+	return key, nil
+}
+
+// refresh fetches the keys without holding mu and swaps them in under it. Callers that arrive while
+// a fetch is in flight wait for that fetch, each for no longer than its own ctx allows.
+func (s *keySet) refresh(ctx context.Context) error {
+	result := s.group.DoChan("jwks", func() (any, error) {
+		// The fetch is shared, so it must not fail because the caller that started it went away.
+		keys, err := s.fetchKeys(context.WithoutCancel(ctx))
+
+		s.mu.Lock()
+		// Failed attempts count too, otherwise an unreachable issuer would be hit on every request.
+		// fetchedAt moves only now, so callers arriving meanwhile still decide to refresh and join.
+		s.fetchedAt = time.Now()
+		if err == nil {
+			s.keys = keys
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("keySet: loaded jwks", zap.Int("keys", len(keys)))
+		return nil, nil
+	})
+
+	select {
+	case res := <-result:
+		return res.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *keySet) fetchKeys(ctx context.Context) (map[string]publicKey, error) {
+	data, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJWKS(data)
+}
+
+func (s *keySet) load(ctx context.Context) ([]byte, error) {
+	if s.cfg.JWKSFile != "" {
+		data, err := os.ReadFile(s.cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwks file: %w", err)
+		}
+
+		return data, nil
+	}
+
+	if s.jwksURL == "" {
+		jwksURL, err := s.discover(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.jwksURL = jwksURL
+	}
+
+	return s.fetch(ctx, s.jwksURL)
+}
+
+// discover finds the JWKS location in the issuer's OpenID configuration.
+func (s *keySet) discover(ctx context.Context) (string, error) {
+	data, err := s.fetch(ctx, strings.TrimSuffix(s.cfg.Issuer, "/")+"/.well-known/openid-configuration")
+	if err != nil {
+		return "", err
+	}
+
+	var doc struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode openid configuration: %w", err)
+	}
+
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("openid configuration has no jwks_uri")
+	}
+
+	return doc.JWKSURI, nil
+}
+
+func (s *keySet) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", url, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+
+	return data, nil
+}
+
+// parseJWKS keeps the RSA and EC signing keys and skips everything else.
+func parseJWKS(data []byte) (map[string]publicKey, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make(map[string]publicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+
+		switch k.Kty {
+		case "RSA":
+			key, err = parseRSAKey(k)
+		case "EC":
+			key, err = parseECKey(k)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = publicKey{key: key, alg: k.Alg}
+	}
+
+	return keys, nil
+}
+
+func parseRSAKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) < 256 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("unsupported rsa key")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+func parseECKey(k jwk) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x: %w", err)
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y: %w", err)
+	}
+
+	size := (curve.Params().BitSize + 7) / 8
+	if len(x) != size || len(y) != size {
+		return nil, fmt.Errorf("invalid point size")
+	}
+
+	point := append([]byte{4}, append(x, y...)...)
+
+	key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+	if err != nil {
+		return nil, fmt.Errorf("invalid point: %w", err)
+	}
+
+	return key, nil
+}
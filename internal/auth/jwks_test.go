@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// jwksServer serves one RSA key as k1 and holds every request while blocked.
+type jwksServer struct {
+	*httptest.Server
+	hits    atomic.Int32
+	entered chan struct{}
+	release chan struct{}
+	blocked atomic.Bool
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "k1",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &jwksServer{entered: make(chan struct{}, 100), release: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.hits.Add(1)
+		if s.blocked.Load() {
+			s.entered <- struct{}{}
+			<-s.release
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func newTestKeySet(url string) *keySet {
+	return newKeySet(&JWTConfig{JWKSURL: url, CacheTTL: time.Hour, FetchTimeout: 5 * time.Second}, zap.NewNop())
+}
+
+func TestKeySetSharesRefresh(t *testing.T) {
+	srv := newJWKSServer(t)
+	srv.blocked.Store(true)
+	keys := newTestKeySet(srv.URL)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Go(func() {
+			_, err := keys.get(context.Background(), "k1")
+			errs <- err
+		})
+	}
+
+	<-srv.entered
+	// Give the other callers time to join the fetch in flight.
+	time.Sleep(100 * time.Millisecond)
+	close(srv.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("get: %v", err)
+		}
+	}
+
+	if n := srv.hits.Load(); n != 1 {
+		t.Errorf("jwks fetched %d times, want 1", n)
+	}
+}
+
+func TestKeySetServesCachedKeyDuringRefresh(t *testing.T) {
+	srv := newJWKSServer(t)
+	keys := newTestKeySet(srv.URL)
+
+	_, err := keys.get(context.Background(), "k1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+
+	// Let an unknown kid trigger a refetch, then hold that fetch.
+	keys.mu.Lock()
+	keys.fetchedAt = time.Now().Add(-2 * refreshCooldown)
+	keys.mu.Unlock()
+	srv.blocked.Store(true)
+
+	unknown := make(chan error, 1)
+	go func() {
+		_, err := keys.get(context.Background(), "rotated")
+		unknown <- err
+	}()
+	<-srv.entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err = keys.get(ctx, "k1")
+	if err != nil {
+		t.Errorf("get cached key during refresh: %v", err)
+	}
+
+	close(srv.release)
+
+	err = <-unknown
+	if !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("get unknown kid error = %v, want %v", err, ErrUnknownKey)
+	}
+}
+
+func TestKeySetRefreshHonoursContext(t *testing.T) {
+	srv := newJWKSServer(t)
+	srv.blocked.Store(true)
+	defer close(srv.release)
+	keys := newTestKeySet(srv.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := keys.get(ctx, "k1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("get error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type JWTConfig struct {
+	// Issuer is compared with the iss claim, when JWKSURL is empty the keys are found through
+	// the issuer's /.well-known/openid-configuration.
+	Issuer   string `env:"JWT_ISSUER"`
+	Audience string `env:"JWT_AUDIENCE"`
+	JWKSURL  string `env:"JWT_JWKS_URL"`
+	// JWKSFile is read instead of JWKSURL, it is meant for offline setups and tests.
+	JWKSFile string `env:"JWT_JWKS_FILE"`
+	// UserIDClaim names the claim that holds the user_id of the caller.
+	UserIDClaim string `env:"JWT_USER_ID_CLAIM" env-default:"sub"`
+	// Scope is granted to every valid token.
+	Scope        string        `env:"JWT_SCOPE" env-default:"write"`
+	CacheTTL     time.Duration `env:"JWT_JWKS_CACHE_TTL" env-default:"1h"`
+	FetchTimeout time.Duration `env:"JWT_JWKS_FETCH_TIMEOUT" env-default:"5s"`
+	// Leeway absorbs clock skew when checking exp and nbf.
+	Leeway time.Duration `env:"JWT_LEEWAY" env-default:"1m"`
+}
+
+func (c *JWTConfig) Enabled() bool {
+	return c.Issuer != "" || c.JWKSURL != "" || c.JWKSFile != ""
+}
+
+// Verifier checks bearer tokens signed by the configured issuer.
+type Verifier struct {
+	cfg  *JWTConfig
+	keys *keySet
+}
+
+func NewVerifier(cfg *JWTConfig, logger *zap.Logger) (*Verifier, error) {
+	if !slices.Contains(Scopes, cfg.Scope) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownScope, cfg.Scope)
+	}
+
+	if cfg.UserIDClaim == "" {
+		return nil, fmt.Errorf("JWT_USER_ID_CLAIM is empty")
+	}
+
+	if cfg.JWKSURL == "" && cfg.JWKSFile == "" && cfg.Issuer == "" {
+		return nil, fmt.Errorf("one of JWT_ISSUER, JWT_JWKS_URL or JWT_JWKS_FILE is required")
+	}
+
+	v := &Verifier{cfg: cfg, keys: newKeySet(cfg, logger)}
+
+	// A broken static file is a configuration error, report it at startup.
+	if cfg.JWKSFile != "" {
+		err := v.keys.refresh(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Verify checks the signature and the registered claims of token and returns the caller.
+func (v *Verifier) Verify(ctx context.Context, token string) (Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Identity{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header jwtHeader
+
+	err := decodeSegment(parts[0], &header)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: header: %w", ErrInvalidToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: signature: %w", ErrInvalidToken, err)
+	}
+
+	key, err := v.keys.get(ctx, header.Kid)
+	if err != nil {
+		if errors.Is(err, ErrUnknownKey) {
+			return Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+
+		return Identity{}, err
+	}
+
+	if key.alg != "" && key.alg != header.Alg {
+		return Identity{}, fmt.Errorf("%w: alg %s does not match the key", ErrInvalidToken, header.Alg)
+	}
+
+	err = verifySignature(header.Alg, key.key, []byte(parts[0]+"."+parts[1]), signature)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	var claims map[string]any
+
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: claims: %w", ErrInvalidToken, err)
+	}
+
+	err = v.checkClaims(claims, time.Now())
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, _ := claims[v.cfg.UserIDClaim].(string)
+	if userID == "" {
+		return Identity{}, fmt.Errorf("%w: claim %s is missing", ErrInvalidToken, v.cfg.UserIDClaim)
+	}
+
+	return Identity{UserID: userID, Scopes: []string{v.cfg.Scope}}, nil
+}
+
+func (v *Verifier) checkClaims(claims map[string]any, now time.Time) error {
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return fmt.Errorf("exp is missing")
+	}
+
+	if now.After(exp.Add(v.cfg.Leeway)) {
+		return fmt.Errorf("token expired at %s", exp.Format(time.RFC3339))
+	}
+
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(v.cfg.Leeway).Before(nbf) {
+		return fmt.Errorf("token is not valid before %s", nbf.Format(time.RFC3339))
+	}
+
+	if v.cfg.Issuer != "" && claims["iss"] != v.cfg.Issuer {
+		return fmt.Errorf("unexpected issuer %v", claims["iss"])
+	}
+
+	if v.cfg.Audience != "" && !hasAudience(claims["aud"], v.cfg.Audience) {
+		return fmt.Errorf("audience %s is missing", v.cfg.Audience)
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, dst any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(dst)
+}
+
+func numericDate(v any) (time.Time, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(f), 0), true
+}
+
+// hasAudience accepts aud as a single string or as an array, as RFC 7519 allows both.
+func hasAudience(aud any, want string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == want
+	case []any:
+		return slices.Contains(v, any(want))
+	default:
+		return false
+	}
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed []byte, signature []byte) error {
+	var hash crypto.Hash
+
+	switch alg[min(len(alg), 2):] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(alg, "RS"):
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("alg %s needs an rsa key", alg)
+		}
+
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+
+	case strings.HasPrefix(alg, "PS"):
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("alg %s needs an rsa key", alg)
+		}
+
+		return rsa.VerifyPSS(rsaKey, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+
+	case strings.HasPrefix(alg, "ES"):
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("alg %s needs an ec key", alg)
+		}
+
+		if ecKey.Curve.Params().BitSize != map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}[alg] {
+			return fmt.Errorf("alg %s does not match the key curve", alg)
+		}
+
+		// JWS carries r and s as two fixed-size big-endian numbers, not ASN.1.
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("invalid signature size")
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+}
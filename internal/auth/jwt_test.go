@@ -0,0 +1,234 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	testIssuer   = "https://sso.example.com"
+	testAudience = "reviewer-service"
+)
+
+// newTestVerifier serves key as k1 without an alg and as k2 pinned to RS256 from a JWKS file.
+func newTestVerifier(t *testing.T, key *rsa.PrivateKey) *Verifier {
+	t.Helper()
+
+	jwk := func(kid string, alg string) map[string]string {
+		return map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": alg,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+	}
+
+	body, err := json.Marshal(map[string]any{"keys": []map[string]string{jwk("k1", ""), jwk("k2", "RS256")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+
+	err = os.WriteFile(path, body, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NewVerifier(&JWTConfig{
+		Issuer:      testIssuer,
+		Audience:    testAudience,
+		JWKSFile:    path,
+		UserIDClaim: "sub",
+		Scope:       ScopeWrite,
+		CacheTTL:    time.Hour,
+		Leeway:      time.Minute,
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewVerifier: %v", err)
+	}
+
+	return v
+}
+
+// sign builds a token over the given header and claims, alg picks the RSA scheme.
+func sign(t *testing.T, key *rsa.PrivateKey, header map[string]any, claims map[string]any) string {
+	t.Helper()
+
+	segment := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	signed := segment(header) + "." + segment(claims)
+
+	alg, _ := header["alg"].(string)
+	hash := map[string]crypto.Hash{"256": crypto.SHA256, "384": crypto.SHA384, "512": crypto.SHA512}[alg[min(len(alg), 2):]]
+	if hash == 0 {
+		return signed + "."
+	}
+
+	h := hash.New()
+	h.Write([]byte(signed))
+
+	var signature []byte
+	var err error
+
+	if strings.HasPrefix(alg, "PS") {
+		signature, err = rsa.SignPSS(rand.Reader, key, hash, h.Sum(nil), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	} else {
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, hash, h.Sum(nil))
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := newTestVerifier(t, key)
+	now := time.Now()
+
+	// claims returns valid claims for u1 with the overrides applied, a nil value drops the claim.
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss": testIssuer,
+			"aud": testAudience,
+			"sub": "u1",
+			"exp": now.Add(time.Hour).Unix(),
+			"nbf": now.Add(-time.Hour).Unix(),
+		}
+		for name, value := range overrides {
+			if value == nil {
+				delete(c, name)
+				continue
+			}
+			c[name] = value
+		}
+		return c
+	}
+
+	header := func(alg string, kid string) map[string]any {
+		return map[string]any{"alg": alg, "kid": kid, "typ": "JWT"}
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"Valid", sign(t, key, header("RS256", "k1"), claims(nil)), true},
+		{"AudienceInArray", sign(t, key, header("RS256", "k1"), claims(map[string]any{"aud": []string{"other", testAudience}})), true},
+		{"ExpiredWithinLeeway", sign(t, key, header("RS256", "k1"), claims(map[string]any{"exp": now.Add(-30 * time.Second).Unix()})), true},
+		{"NotBeforeWithinLeeway", sign(t, key, header("RS256", "k1"), claims(map[string]any{"nbf": now.Add(30 * time.Second).Unix()})), true},
+		{"OtherAlgOnUnpinnedKey", sign(t, key, header("PS384", "k1"), claims(nil)), true},
+		{"PinnedAlg", sign(t, key, header("RS256", "k2"), claims(nil)), true},
+
+		{"WrongIssuer", sign(t, key, header("RS256", "k1"), claims(map[string]any{"iss": "https://evil.example.com"})), false},
+		{"MissingIssuer", sign(t, key, header("RS256", "k1"), claims(map[string]any{"iss": nil})), false},
+		{"WrongAudience", sign(t, key, header("RS256", "k1"), claims(map[string]any{"aud": "other"})), false},
+		{"AudienceNotInArray", sign(t, key, header("RS256", "k1"), claims(map[string]any{"aud": []string{"a", "b"}})), false},
+		{"Expired", sign(t, key, header("RS256", "k1"), claims(map[string]any{"exp": now.Add(-2 * time.Minute).Unix()})), false},
+		{"MissingExpiry", sign(t, key, header("RS256", "k1"), claims(map[string]any{"exp": nil})), false},
+		{"NotYetValid", sign(t, key, header("RS256", "k1"), claims(map[string]any{"nbf": now.Add(2 * time.Minute).Unix()})), false},
+		{"MissingUserID", sign(t, key, header("RS256", "k1"), claims(map[string]any{"sub": nil})), false},
+
+		{"AlgAgainstPinnedKey", sign(t, key, header("RS384", "k2"), claims(nil)), false},
+		{"AlgOfOtherKeyType", sign(t, key, header("ES256", "k1"), claims(nil)), false},
+		{"AlgNone", sign(t, key, header("none", "k1"), claims(nil)), false},
+		{"SignedByOtherKey", sign(t, other, header("RS256", "k1"), claims(nil)), false},
+		{"UnknownKid", sign(t, key, header("RS256", "k3"), claims(nil)), false},
+		{"Malformed", "not-a-token", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := v.Verify(context.Background(), tt.token)
+
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("Verify error = %v, want %v", err, ErrInvalidToken)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+
+			if identity.UserID != "u1" || identity.KeyID != "" || !identity.Allows(ScopeWrite) || identity.Allows(ScopeAdmin) {
+				t.Errorf("identity = %+v, want u1 with the write scope", identity)
+			}
+		})
+	}
+}
+
+func TestVerifyTamperedClaims(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := newTestVerifier(t, key)
+	claims := map[string]any{"iss": testIssuer, "aud": testAudience, "sub": "u1", "exp": time.Now().Add(time.Hour).Unix()}
+	token := sign(t, key, map[string]any{"alg": "RS256", "kid": "k1"}, claims)
+
+	// Swap the payload for one naming another user, the signature no longer matches.
+	claims["sub"] = "admin"
+	forged := sign(t, key, map[string]any{"alg": "RS256", "kid": "k1"}, claims)
+	parts, forgedParts := strings.Split(token, "."), strings.Split(forged, ".")
+
+	_, err = v.Verify(context.Background(), parts[0]+"."+forgedParts[1]+"."+parts[2])
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestAuthenticateBearer(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &Authenticator{cfg: &Config{Enabled: true}, verifier: newTestVerifier(t, key), logger: zap.NewNop(), now: time.Now}
+	token := sign(t, key, map[string]any{"alg": "RS256", "kid": "k1"},
+		map[string]any{"iss": testIssuer, "aud": testAudience, "sub": "u1", "exp": time.Now().Add(time.Hour).Unix()})
+
+	identity, err := a.Authenticate(context.Background(), "", "Bearer "+token)
+	if err != nil || identity.UserID != "u1" {
+		t.Errorf("Authenticate = %+v, %v, want u1", identity, err)
+	}
+
+	_, err = a.Authenticate(context.Background(), "", "Basic "+token)
+	if !IsUnauthorized(err) {
+		t.Errorf("Authenticate(basic) error = %v, want unauthorized", err)
+	}
+}
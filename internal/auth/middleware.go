@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+type KeyStore interface {
+	GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error)
+}
+
+// Authenticator resolves the caller from an API key or, when JWT is configured, a bearer token.
+type Authenticator struct {
+	cfg      *Config
+	keys     KeyStore
+	verifier *Verifier
+	logger   *zap.Logger
+	// now is the clock expiry of keys is checked against.
+	now func() time.Time
+}
+
+func New(cfg *Config, keys KeyStore, logger *zap.Logger) (*Authenticator, error) {
+	a := &Authenticator{cfg: cfg, keys: keys, logger: logger, now: time.Now}
+
+	// Without a bootstrap key or tokens no API key can be issued, every request would get 401.
+	if cfg.Enabled && cfg.AdminKey == "" && !cfg.JWT.Enabled() {
+		return nil, ErrNoCredentialSource
+	}
+
+	if cfg.Enabled && cfg.JWT.Enabled() {
+		verifier, err := NewVerifier(&cfg.JWT, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create jwt verifier: %w", err)
+		}
+
+		a.verifier = verifier
+	}
+
+	return a, nil
+}
+
+// Middleware rejects requests without valid credentials or without the scope the endpoint needs,
+// and stores the identity of the caller in the request context.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		if !a.cfg.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		identity, err := a.Authenticate(r.Context(), r.Header.Get(Header), r.Header.Get("Authorization"))
+		if err != nil {
+			if IsUnauthorized(err) {
+				a.logger.Warn("Middleware: unauthorized", zap.String("path", r.URL.Path), zap.Error(err))
+				if a.verifier != nil {
+					w.Header().Set("WWW-Authenticate", `Bearer`)
+				}
+				api.WriteProblem(w, r, a.logger, http.StatusUnauthorized, api.CodeUnauthorized, api.ErrUnauthorized)
+				return
+			}
+
+			a.logger.Error("Middleware: failed to authenticate", zap.Error(err))
+			api.WriteProblem(w, r, a.logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+			return
+		}
+
+		scope := requiredScope(r.Method, r.URL.Path)
+		if !identity.Allows(scope) {
+			a.logger.Warn("Middleware: insufficient scope",
+				zap.String("api_key_id", identity.KeyID),
+				zap.String("user_id", identity.UserID),
+				zap.String("path", r.URL.Path),
+				zap.String("scope", scope),
+			)
+			api.WriteProblem(w, r, a.logger, http.StatusForbidden, api.CodeForbidden, api.ErrForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithIdentity(r.Context(), identity)))
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// Enabled reports whether callers have to authenticate.
+func (a *Authenticator) Enabled() bool {
+	return a.cfg.Enabled
+}
+
+var errNoCredentials = errors.New("no credentials")
+
+// Authenticate resolves the caller from an API key or, without one, an Authorization header.
+// Middleware calls it for HTTP, other transports pass the same values from their metadata.
+func (a *Authenticator) Authenticate(ctx context.Context, key string, authorization string) (Identity, error) {
+	if key != "" {
+		return a.authenticateKey(ctx, key)
+	}
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if ok && a.verifier != nil {
+		return a.verifier.Verify(ctx, strings.TrimSpace(token))
+	}
+
+	return Identity{}, errNoCredentials
+}
+
+// IsUnauthorized reports whether an Authenticate error means missing or bad credentials
+// rather than a failure to check them.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, repository.ErrAPIKeyNotFound) || errors.Is(err, ErrInvalidToken) || errors.Is(err, errNoCredentials)
+}
+
+func (a *Authenticator) authenticateKey(ctx context.Context, key string) (Identity, error) {
+	if isBootstrapKey(a.cfg, key) {
+		return Identity{KeyID: BootstrapKeyID, Name: BootstrapKeyID, Scopes: []string{ScopeAdmin}}, nil
+	}
+
+	stored, err := a.keys.GetAPIKeyByHash(ctx, Hash(key))
+	if err != nil {
+		return Identity{}, err
+	}
+
+	if stored.RevokedAt != nil {
+		return Identity{}, fmt.Errorf("%w: revoked %s", repository.ErrAPIKeyNotFound, stored.KeyID)
+	}
+
+	if stored.ExpiresAt != nil && !a.now().Before(*stored.ExpiresAt) {
+		return Identity{}, fmt.Errorf("%w: expired %s", repository.ErrAPIKeyNotFound, stored.KeyID)
+	}
+
+	return Identity{KeyID: stored.KeyID, Name: stored.Name, Scopes: stored.Scopes}, nil
+}
@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/memory"
+)
+
+const adminKey = "bootstrap-secret"
+
+// newAuthenticator checks keys against a memory store on a clock the test moves.
+func newAuthenticator(t *testing.T) (*Authenticator, *memory.Client, *time.Time) {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	a, err := New(&Config{Enabled: true, AdminKey: adminKey}, repo, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a.now = func() time.Time { return now }
+
+	return a, repo, &now
+}
+
+// issue stores a new key and returns its plaintext.
+func issue(t *testing.T, repo *memory.Client, now time.Time, expiresAt *time.Time, scopes ...string) string {
+	t.Helper()
+
+	plaintext, key, err := NewKey("ci", scopes, now, expiresAt)
+	if err != nil {
+		t.Fatalf("NewKey: %v", err)
+	}
+
+	err = repo.SaveAPIKey(context.Background(), key)
+	if err != nil {
+		t.Fatalf("SaveAPIKey: %v", err)
+	}
+
+	return plaintext
+}
+
+func TestNewKey(t *testing.T) {
+	now := time.Now()
+
+	plaintext, key, err := NewKey("ci", []string{ScopeWrite, ScopeRead, ScopeWrite}, now, nil)
+	if err != nil {
+		t.Fatalf("NewKey: %v", err)
+	}
+
+	if !strings.HasPrefix(plaintext, keyPrefix+"_"+key.KeyID+"_") {
+		t.Errorf("key %q does not start with %s_%s_", plaintext, keyPrefix, key.KeyID)
+	}
+
+	// Only the hash is kept, and it is the hash of the plaintext.
+	if strings.Contains(string(key.Hash), plaintext) || !slices.Equal(key.Hash, Hash(plaintext)) {
+		t.Errorf("Hash = %x, want the SHA-256 of the key", key.Hash)
+	}
+
+	if !slices.Equal(key.Scopes, []string{ScopeRead, ScopeWrite}) || key.ExpiresAt != nil {
+		t.Errorf("key = %+v, want sorted distinct scopes without expiry", key)
+	}
+
+	other, _, err := NewKey("ci", []string{ScopeRead}, now, nil)
+	if err != nil || other == plaintext {
+		t.Errorf("second key = %q, %v, want another key", other, err)
+	}
+
+	past := now.Add(-time.Second)
+
+	tests := []struct {
+		name      string
+		scopes    []string
+		expiresAt *time.Time
+		want      error
+	}{
+		{"NoScopes", nil, nil, ErrNoScopes},
+		{"UnknownScope", []string{ScopeRead, "root"}, nil, ErrUnknownScope},
+		{"ExpiresBeforeIssue", []string{ScopeRead}, &past, ErrInvalidExpiry},
+		{"ExpiresAtIssue", []string{ScopeRead}, &now, ErrInvalidExpiry},
+	}
+
+	for _, tt := range tests {
+		_, _, err := NewKey("ci", tt.scopes, now, tt.expiresAt)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestAuthenticateKey(t *testing.T) {
+	a, repo, now := newAuthenticator(t)
+	ctx := context.Background()
+
+	expiresAt := now.Add(time.Hour)
+	plaintext := issue(t, repo, *now, nil, ScopeWrite)
+	expiring := issue(t, repo, *now, &expiresAt, ScopeRead)
+	revoked := issue(t, repo, *now, nil, ScopeWrite)
+
+	stored, err := repo.GetAPIKeyByHash(ctx, Hash(revoked))
+	if err != nil {
+		t.Fatalf("GetAPIKeyByHash: %v", err)
+	}
+
+	_, err = repo.RevokeAPIKey(ctx, stored.KeyID, *now)
+	if err != nil {
+		t.Fatalf("RevokeAPIKey: %v", err)
+	}
+
+	identity, err := a.Authenticate(ctx, plaintext, "")
+	if err != nil || identity.KeyID == "" || identity.Name != "ci" || !slices.Equal(identity.Scopes, []string{ScopeWrite}) {
+		t.Errorf("Authenticate = %+v, %v, want the ci key with write", identity, err)
+	}
+
+	identity, err = a.Authenticate(ctx, adminKey, "")
+	if err != nil || identity.KeyID != BootstrapKeyID || !identity.Allows(ScopeAdmin) {
+		t.Errorf("Authenticate(bootstrap) = %+v, %v, want the admin bootstrap key", identity, err)
+	}
+
+	_, err = a.Authenticate(ctx, expiring, "")
+	if err != nil {
+		t.Errorf("Authenticate(before expiry): %v", err)
+	}
+
+	*now = expiresAt
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"Unknown", plaintext + "x"},
+		{"Revoked", revoked},
+		{"Expired", expiring},
+		{"Missing", ""},
+	}
+
+	for _, tt := range tests {
+		_, err := a.Authenticate(ctx, tt.key, "")
+		if !IsUnauthorized(err) {
+			t.Errorf("%s: error = %v, want unauthorized", tt.name, err)
+		}
+	}
+}
+
+func TestMiddlewareScopes(t *testing.T) {
+	a, repo, now := newAuthenticator(t)
+
+	keys := map[string]string{
+		ScopeRead:  issue(t, repo, *now, nil, ScopeRead),
+		ScopeWrite: issue(t, repo, *now, nil, ScopeWrite),
+		ScopeAdmin: issue(t, repo, *now, nil, ScopeAdmin),
+	}
+
+	var got Identity
+	handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = IdentityFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		scope  string
+		method string
+		path   string
+		want   int
+	}{
+		{ScopeRead, http.MethodGet, "/team/get", http.StatusOK},
+		{ScopeRead, http.MethodPost, "/pullRequest/create", http.StatusForbidden},
+		{ScopeRead, http.MethodGet, "/admin/apiKeys/list", http.StatusForbidden},
+		{ScopeWrite, http.MethodGet, "/team/get", http.StatusOK},
+		{ScopeWrite, http.MethodPost, "/pullRequest/create", http.StatusOK},
+		{ScopeWrite, http.MethodGet, "/admin/apiKeys/list", http.StatusForbidden},
+		{ScopeAdmin, http.MethodPost, "/pullRequest/create", http.StatusOK},
+		{ScopeAdmin, http.MethodPost, "/admin/apiKeys/issue", http.StatusOK},
+		{"", http.MethodGet, "/team/get", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		got = Identity{}
+
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if tt.scope != "" {
+			req.Header.Set(Header, keys[tt.scope])
+		}
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tt.want {
+			t.Errorf("%s %s with %q: status = %d, want %d", tt.method, tt.path, tt.scope, rec.Code, tt.want)
+		}
+
+		if tt.want == http.StatusOK && !slices.Equal(got.Scopes, []string{tt.scope}) {
+			t.Errorf("%s %s with %q: identity = %+v, want the calling key", tt.method, tt.path, tt.scope, got)
+		}
+	}
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	a, err := New(&Config{}, nil, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/apiKeys/issue", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200 without authentication", rec.Code)
+	}
+}
+
+func TestNewRefusesWithoutCredentialSource(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want error
+	}{
+		{"EnabledWithoutSource", Config{Enabled: true}, ErrNoCredentialSource},
+		{"EnabledWithAdminKey", Config{Enabled: true, AdminKey: adminKey}, nil},
+		{"Disabled", Config{}, nil},
+	}
+
+	for _, tt := range tests {
+		_, err := New(&tt.cfg, nil, zap.NewNop())
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	if !IsUnauthorized(repository.ErrAPIKeyNotFound) || IsUnauthorized(errors.New("connection refused")) {
+		t.Error("IsUnauthorized must only accept credential errors")
+	}
+}
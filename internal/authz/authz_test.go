@@ -0,0 +1,236 @@
+package authz_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/authz"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository/memory"
+)
+
+// newStore holds two teams with a lead and members each, an admin in a third team and a pull
+// request per team:
+//
+//	backend:  lead l1, members m1 and m2, pr-b by m1 reviewed by m2
+//	frontend: lead l2, member f1, pr-f by f1 reviewed by l2
+//	ops:      admin a1
+func newStore(t *testing.T) *memory.Client {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	teams := map[string][]string{
+		"backend":  {"l1", "m1", "m2"},
+		"frontend": {"l2", "f1"},
+		"ops":      {"a1"},
+	}
+
+	for name, ids := range teams {
+		team := &domain.Team{TeamName: name}
+		for _, id := range ids {
+			team.Members = append(team.Members, domain.TeamMember{UserID: id, UserName: id, IsActive: true})
+		}
+
+		err := repo.SaveTeam(ctx, team)
+		if err != nil {
+			t.Fatalf("SaveTeam(%s): %v", name, err)
+		}
+	}
+
+	for id, role := range map[string]string{"l1": domain.RoleLead, "l2": domain.RoleLead, "a1": domain.RoleAdmin} {
+		_, err := repo.SetUserRole(ctx, id, role)
+		if err != nil {
+			t.Fatalf("SetUserRole(%s): %v", id, err)
+		}
+	}
+
+	now := time.Now()
+	for _, pr := range []domain.PullRequest{
+		{PullRequestId: "pr-b", PullRequestName: "b", AuthorId: "m1", Status: domain.PRStatusOpen, AssignedReviewers: []string{"m2"}, CreatedAt: &now},
+		{PullRequestId: "pr-f", PullRequestName: "f", AuthorId: "f1", Status: domain.PRStatusOpen, AssignedReviewers: []string{"l2"}, CreatedAt: &now},
+	} {
+		err := repo.SavePR(ctx, pr)
+		if err != nil {
+			t.Fatalf("SavePR(%s): %v", pr.PullRequestId, err)
+		}
+	}
+
+	return repo
+}
+
+func user(id string) auth.Identity {
+	return auth.Identity{UserID: id, Scopes: []string{auth.ScopeWrite}}
+}
+
+func key(scopes ...string) auth.Identity {
+	return auth.Identity{KeyID: "key-1", Name: "ci", Scopes: scopes}
+}
+
+func archiveTeam(name string) any {
+	return api.ArchiveTeamV2RequestObject{TeamName: name}
+}
+
+func setIsActive(userID string) any {
+	return api.SetIsActiveRequestObject{Body: &api.SetIsActiveJSONRequestBody{UserId: userID, IsActive: false}}
+}
+
+func setRole(userID string, role api.Role) any {
+	return api.SetRoleRequestObject{Body: &api.SetRoleJSONRequestBody{UserId: userID, Role: role}}
+}
+
+func getReview(userID string) any {
+	return api.GetReviewRequestObject{Params: api.GetReviewParams{UserId: &userID}}
+}
+
+func userReviews(userID string) any {
+	return api.GetUserReviewsV2RequestObject{UserId: userID}
+}
+
+func merge(prID string) any {
+	return api.MergePullRequestV2RequestObject{PullRequestId: prID}
+}
+
+func reassign(prID string, oldUserID string) any {
+	return api.ReassignPullRequestV2RequestObject{PullRequestId: prID, Body: &api.ReassignPullRequestV2JSONRequestBody{OldUserId: oldUserID}}
+}
+
+func TestAuthorize(t *testing.T) {
+	repo := newStore(t)
+	authorizer := authz.New(repo, zap.NewNop())
+
+	admin := user("a1")
+	lead := user("l1")
+	author := user("m1")
+	reviewer := user("m2")
+	unknown := user("ghost")
+	service := key(auth.ScopeWrite)
+	adminKey := key(auth.ScopeAdmin)
+
+	// Own team is backend for l1, m1 and m2, other team is frontend.
+	tests := []struct {
+		name     string
+		identity auth.Identity
+		request  any
+		want     bool
+	}{
+		{"Admin/CreateTeam", admin, api.CreateTeamV2RequestObject{}, true},
+		{"Admin/ManageOtherTeam", admin, archiveTeam("frontend"), true},
+		{"Admin/DeactivateOtherTeamUser", admin, setIsActive("f1"), true},
+		{"Admin/GrantAdmin", admin, setRole("m1", api.RoleAdmin), true},
+		{"Admin/ReadOtherTeamReviews", admin, userReviews("f1"), true},
+		{"Admin/MergeOtherTeamPR", admin, merge("pr-f"), true},
+		{"Admin/MergeMissingPR", admin, merge("missing"), true},
+		{"Admin/ReassignOtherTeamPR", admin, reassign("pr-f", "l2"), true},
+
+		{"Lead/CreateTeam", lead, api.AddTeamRequestObject{}, false},
+		{"Lead/ManageOwnTeam", lead, archiveTeam("backend"), true},
+		{"Lead/ManageOtherTeam", lead, archiveTeam("frontend"), false},
+		{"Lead/DeactivateOwnTeamUser", lead, setIsActive("m1"), true},
+		{"Lead/DeactivateOtherTeamUser", lead, setIsActive("f1"), false},
+		{"Lead/DeactivateMissingUser", lead, setIsActive("ghost"), false},
+		{"Lead/PromoteOwnTeamUser", lead, setRole("m1", api.RoleLead), true},
+		{"Lead/PromoteOtherTeamUser", lead, setRole("f1", api.RoleLead), false},
+		{"Lead/GrantAdmin", lead, setRole("m1", api.RoleAdmin), false},
+		{"Lead/ReadOwnTeamReviews", lead, userReviews("m2"), true},
+		{"Lead/ReadOtherTeamReviews", lead, getReview("f1"), false},
+		{"Lead/ReadMissingUserReviews", lead, getReview("ghost"), false},
+		{"Lead/MergeOwnTeamPR", lead, merge("pr-b"), true},
+		{"Lead/MergeOtherTeamPR", lead, merge("pr-f"), false},
+		{"Lead/MergeMissingPR", lead, merge("missing"), false},
+		{"Lead/ReassignOwnTeamPR", lead, reassign("pr-b", "m2"), true},
+		{"Lead/ReassignOtherTeamPR", lead, reassign("pr-f", "l2"), false},
+
+		{"Member/CreateTeam", author, api.CreateTeamV2RequestObject{}, false},
+		{"Member/ManageOwnTeam", author, archiveTeam("backend"), false},
+		{"Member/DeactivateOwnTeamUser", author, setIsActive("m2"), false},
+		{"Member/PromoteSelf", author, setRole("m1", api.RoleLead), false},
+		{"Member/ReadOwnReviews", author, getReview("m1"), true},
+		{"Member/ReadOwnReviewsByDefault", author, api.GetReviewRequestObject{}, true},
+		{"Member/ReadOwnTeamReviews", author, getReview("m2"), false},
+		{"Member/ReadOwnTeamReviewsV2", author, userReviews("m2"), false},
+		{"Member/ReadOtherTeamReviews", author, userReviews("f1"), false},
+		{"Member/MergeOwnPR", author, merge("pr-b"), true},
+		{"Member/MergeOwnTeamPR", reviewer, merge("pr-b"), false},
+		{"Member/MergeOtherTeamPR", author, merge("pr-f"), false},
+		{"Member/ReassignOwnReview", reviewer, reassign("pr-b", "m2"), true},
+		{"Member/ReassignOthersReview", author, reassign("pr-b", "m2"), false},
+		{"Member/ReassignOtherTeamReview", reviewer, reassign("pr-f", "l2"), false},
+		{"Member/UnrestrictedRequest", author, api.GetStatsRequestObject{}, true},
+
+		{"Unknown/MergeOtherTeamPR", unknown, merge("pr-b"), false},
+		{"Unknown/ReadOwnReviews", unknown, getReview("ghost"), true},
+		{"Unknown/ReadOthersReviews", unknown, getReview("m1"), false},
+		{"Unknown/ManageTeam", unknown, archiveTeam("backend"), false},
+
+		{"Service/CreateTeam", service, api.ImportTeamsRequestObject{}, false},
+		{"Service/ManageTeam", service, archiveTeam("backend"), false},
+		{"Service/DeactivateUser", service, setIsActive("m1"), false},
+		{"Service/ReadAnyReviews", service, getReview("f1"), true},
+		{"Service/MergeAnyPR", service, merge("pr-f"), true},
+		{"Service/MergeMissingPR", service, merge("missing"), true},
+		{"Service/ReassignAnyPR", service, reassign("pr-b", "m2"), true},
+		{"Service/ReadOnlyKey", key(auth.ScopeRead), merge("pr-b"), true},
+
+		{"AdminKey/CreateTeam", adminKey, api.CreateTeamV2RequestObject{}, true},
+		{"AdminKey/ManageTeam", adminKey, archiveTeam("frontend"), true},
+		{"AdminKey/GrantAdmin", adminKey, setRole("m1", api.RoleAdmin), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.WithIdentity(context.Background(), tt.identity)
+
+			got, err := authorizer.Authorize(ctx, tt.request)
+			if err != nil {
+				t.Fatalf("Authorize: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Authorize = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeWithoutIdentity(t *testing.T) {
+	authorizer := authz.New(newStore(t), zap.NewNop())
+
+	// Authentication is off, nobody is checked.
+	allowed, err := authorizer.Authorize(context.Background(), api.CreateTeamV2RequestObject{})
+	if err != nil || !allowed {
+		t.Errorf("Authorize = %v, %v, want allowed", allowed, err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	authorizer := authz.New(newStore(t), zap.NewNop())
+
+	var called bool
+	next := func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		called = true
+		return nil, nil
+	}
+
+	ctx := auth.WithIdentity(context.Background(), user("m1"))
+	req := httptest.NewRequest(http.MethodPost, "/v2/teams", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	_, err := authorizer.Middleware(next, "CreateTeamV2")(ctx, rec, req, api.CreateTeamV2RequestObject{})
+	if err != nil {
+		t.Fatalf("Middleware: %v", err)
+	}
+
+	if called || rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, handler called = %v, want 403 without the handler", rec.Code, called)
+	}
+}
@@ -0,0 +1,279 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+type Store interface {
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
+}
+
+// Authorizer applies the role policy to typed requests before they reach the handlers.
+type Authorizer struct {
+	store  Store
+	logger *zap.Logger
+}
+
+func New(store Store, logger *zap.Logger) *Authorizer {
+	return &Authorizer{store: store, logger: logger}
+}
+
+// Middleware is an api.StrictMiddlewareFunc that answers 403 when Authorize refuses the request.
+func (a *Authorizer) Middleware(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		allowed, err := a.Authorize(ctx, request)
+		if err != nil {
+			a.logger.Error("Middleware: failed to authorize", zap.String("operation", operationID), zap.Error(err))
+			api.WriteProblem(w, r, a.logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+			return nil, nil
+		}
+
+		if !allowed {
+			a.logger.Warn("Middleware: action is not allowed",
+				zap.String("actor", auth.Actor(ctx)),
+				zap.String("operation", operationID),
+			)
+			api.WriteProblem(w, r, a.logger, http.StatusForbidden, api.CodeForbidden, api.ErrNotAllowed)
+			return nil, nil
+		}
+
+		return next(ctx, w, r, request)
+	}
+}
+
+// Authorize applies the role policy to a typed request on behalf of the caller in ctx.
+// Without an identity in ctx authentication is off and there is nobody to check.
+func (a *Authorizer) Authorize(ctx context.Context, request any) (bool, error) {
+	identity, ok := auth.IdentityFromContext(ctx)
+	if !ok {
+		return true, nil
+	}
+
+	caller, err := a.caller(ctx, identity)
+	if err != nil {
+		return false, err
+	}
+
+	return a.allowed(ctx, caller, request)
+}
+
+// caller resolves the role of a bearer token user from storage, API keys get theirs from their scopes.
+func (a *Authorizer) caller(ctx context.Context, identity auth.Identity) (Caller, error) {
+	if identity.UserID == "" {
+		return KeyCaller(identity), nil
+	}
+
+	user, err := a.store.GetUser(ctx, identity.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return Caller{UserID: identity.UserID}, nil
+		}
+
+		return Caller{}, fmt.Errorf("failed to get caller: %w", err)
+	}
+
+	return Caller{UserID: identity.UserID, TeamName: user.TeamName, Role: user.Role}, nil
+}
+
+// allowed looks up what the request targets and asks the policy. Targets that cannot be found,
+// including archived ones, are left to admins, so the handler's 404 does not leak to others.
+func (a *Authorizer) allowed(ctx context.Context, caller Caller, request any) (bool, error) {
+	switch req := request.(type) {
+	case api.AddTeamRequestObject, api.ImportTeamsRequestObject, api.CreateTeamV2RequestObject, api.ImportTeamsV2RequestObject:
+		return CanCreateTeams(caller), nil
+
+	case api.ArchiveTeamV2RequestObject:
+		return CanManageTeam(caller, req.TeamName), nil
+
+	case api.RestoreTeamV2RequestObject:
+		return CanManageTeam(caller, req.TeamName), nil
+
+	case api.ArchiveTeamRequestObject:
+		return CanManageTeam(caller, req.Body.TeamName), nil
+
+	case api.RestoreTeamRequestObject:
+		// The lead of an archived team is archived too, so in practice only admins restore teams.
+		return CanManageTeam(caller, req.Body.TeamName), nil
+
+	case api.SetIsActiveRequestObject:
+		return a.canManageUser(ctx, caller, req.Body.UserId)
+
+	case api.ArchiveUserRequestObject:
+		return a.canManageUser(ctx, caller, req.Body.UserId)
+
+	case api.RestoreUserRequestObject:
+		return a.canManageUser(ctx, caller, req.Body.UserId)
+
+	case api.ArchiveUserV2RequestObject:
+		return a.canManageUser(ctx, caller, req.UserId)
+
+	case api.RestoreUserV2RequestObject:
+		return a.canManageUser(ctx, caller, req.UserId)
+
+	case api.SetRoleRequestObject:
+		return a.canSetRole(ctx, caller, req.Body.UserId, string(req.Body.Role))
+
+	case api.UpdateUserV2RequestObject:
+		// Every field being changed must be allowed on its own.
+		if req.Body.IsActive != nil {
+			allowed, err := a.canManageUser(ctx, caller, req.UserId)
+			if err != nil || !allowed {
+				return false, err
+			}
+		}
+
+		if req.Body.Role != nil {
+			return a.canSetRole(ctx, caller, req.UserId, string(*req.Body.Role))
+		}
+
+		return true, nil
+
+	case api.GetReviewRequestObject:
+		// Without user_id the handler answers with the caller's own queue.
+		userID := caller.UserID
+		if req.Params.UserId != nil && *req.Params.UserId != "" {
+			userID = *req.Params.UserId
+		}
+
+		return a.canReadReviews(ctx, caller, userID)
+
+	case api.GetUserReviewsV2RequestObject:
+		return a.canReadReviews(ctx, caller, req.UserId)
+
+	case api.MergePullRequestRequestObject:
+		return a.canChangePR(ctx, caller, req.Body.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	case api.ReassignPullRequestRequestObject:
+		return a.canChangePR(ctx, caller, req.Body.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanReassign(caller, pr, authorTeam, req.Body.OldUserId)
+		})
+
+	case api.ArchivePullRequestRequestObject:
+		return a.canChangePR(ctx, caller, req.Body.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	case api.RestorePullRequestRequestObject:
+		return a.canChangePR(ctx, caller, req.Body.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	case api.MergePullRequestV2RequestObject:
+		return a.canChangePR(ctx, caller, req.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	case api.ReassignPullRequestV2RequestObject:
+		return a.canChangePR(ctx, caller, req.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanReassign(caller, pr, authorTeam, req.Body.OldUserId)
+		})
+
+	case api.ArchivePullRequestV2RequestObject:
+		return a.canChangePR(ctx, caller, req.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	case api.RestorePullRequestV2RequestObject:
+		return a.canChangePR(ctx, caller, req.PullRequestId, func(pr domain.PullRequest, authorTeam string) bool {
+			return CanMerge(caller, pr, authorTeam)
+		})
+
+	default:
+		return true, nil
+	}
+}
+
+func (a *Authorizer) canManageUser(ctx context.Context, caller Caller, userID string) (bool, error) {
+	if caller.IsAdmin() {
+		return true, nil
+	}
+
+	target, err := a.user(ctx, userID)
+	if err != nil || target == nil {
+		return false, err
+	}
+
+	return CanManageTeam(caller, target.TeamName), nil
+}
+
+func (a *Authorizer) canReadReviews(ctx context.Context, caller Caller, userID string) (bool, error) {
+	if caller.IsAdmin() || caller.IsService() || caller.UserID == userID {
+		return true, nil
+	}
+
+	target, err := a.user(ctx, userID)
+	if err != nil || target == nil {
+		return false, err
+	}
+
+	return CanReadReviews(caller, userID, target.TeamName), nil
+}
+
+func (a *Authorizer) canSetRole(ctx context.Context, caller Caller, userID string, role string) (bool, error) {
+	if caller.IsAdmin() {
+		return true, nil
+	}
+
+	target, err := a.user(ctx, userID)
+	if err != nil || target == nil {
+		return false, err
+	}
+
+	return CanSetRole(caller, *target, role), nil
+}
+
+func (a *Authorizer) canChangePR(ctx context.Context, caller Caller, prID string, check func(domain.PullRequest, string) bool) (bool, error) {
+	// Services act on any pull request, so a missing one is left to the handler's 404 as for admins.
+	if caller.IsAdmin() || caller.IsService() {
+		return true, nil
+	}
+
+	pr, err := a.store.GetPR(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	var authorTeam string
+
+	author, err := a.user(ctx, pr.AuthorId)
+	if err != nil {
+		return false, err
+	}
+
+	if author != nil {
+		authorTeam = author.TeamName
+	}
+
+	return check(*pr, authorTeam), nil
+}
+
+// user returns nil without an error when the user does not exist.
+func (a *Authorizer) user(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := a.store.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
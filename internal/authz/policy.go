@@ -0,0 +1,85 @@
+// Package authz decides which team actions a caller may perform based on their role.
+package authz
+
+import (
+	"slices"
+
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/domain"
+)
+
+// RoleService is held by API keys without the admin scope. It is never stored for a user.
+const RoleService = "service"
+
+// Caller is an authenticated user or API key as far as team roles are concerned.
+// Users unknown to the service get a zero TeamName and Role and may only act on their own behalf.
+type Caller struct {
+	UserID   string
+	TeamName string
+	Role     string
+}
+
+// KeyCaller maps an API key onto a role: the admin scope makes an admin, any other key is a
+// service that drives pull requests but does not administer teams or users.
+func KeyCaller(identity auth.Identity) Caller {
+	if identity.Allows(auth.ScopeAdmin) {
+		return Caller{Role: domain.RoleAdmin}
+	}
+
+	return Caller{Role: RoleService}
+}
+
+func (c Caller) IsAdmin() bool {
+	return c.Role == domain.RoleAdmin
+}
+
+func (c Caller) IsService() bool {
+	return c.Role == RoleService
+}
+
+// Leads reports whether the caller is a lead of teamName, admins lead every team.
+func (c Caller) Leads(teamName string) bool {
+	return c.IsAdmin() || (c.Role == domain.RoleLead && teamName != "" && c.TeamName == teamName)
+}
+
+// CanCreateTeams guards /team/add and /team/import, a new team has no lead who could approve it.
+func CanCreateTeams(c Caller) bool {
+	return c.IsAdmin()
+}
+
+// CanManageTeam covers membership, activation and archiving within teamName.
+func CanManageTeam(c Caller, teamName string) bool {
+	return c.Leads(teamName)
+}
+
+// CanSetRole lets leads promote and demote within their team, admin rights stay with admins.
+func CanSetRole(c Caller, target domain.User, role string) bool {
+	if c.IsAdmin() {
+		return true
+	}
+
+	if role == domain.RoleAdmin || target.Role == domain.RoleAdmin {
+		return false
+	}
+
+	return c.Leads(target.TeamName)
+}
+
+// CanMerge allows the author, the leads of the author's team and services.
+func CanMerge(c Caller, pr domain.PullRequest, authorTeam string) bool {
+	return c.UserID == pr.AuthorId || c.Leads(authorTeam) || c.IsService()
+}
+
+// CanReadReviews lets users read their own review queue, leads the queues of their team and services any queue.
+func CanReadReviews(c Caller, userID string, teamName string) bool {
+	return c.UserID == userID || c.Leads(teamName) || c.IsService()
+}
+
+// CanReassign allows an assigned reviewer to hand their own review over, and leads and services any reassignment.
+func CanReassign(c Caller, pr domain.PullRequest, authorTeam string, oldUserID string) bool {
+	if c.Leads(authorTeam) || c.IsService() {
+		return true
+	}
+
+	return c.UserID == oldUserID && slices.Contains(pr.AssignedReviewers, oldUserID)
+}
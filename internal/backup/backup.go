@@ -0,0 +1,339 @@
+// Package backup exports the dataset to a versioned JSON document and loads it back.
+package backup
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+const (
+	kindTeam        = "team"
+	kindUser        = "user"
+	kindPullRequest = "pull_request"
+)
+
+func Export(ctx context.Context, store repository.Dumper) (*Document, error) {
+	snapshot, err := store.Dump(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump data: %w", err)
+	}
+
+	doc := &Document{
+		Version:      Version,
+		ExportedAt:   time.Now().UTC(),
+		Teams:        make([]Team, 0, len(snapshot.Teams)),
+		PullRequests: make([]PullRequest, 0, len(snapshot.PullRequests)),
+	}
+
+	for _, team := range snapshot.Teams {
+		members := make([]Member, 0, len(team.Members))
+		for _, m := range team.Members {
+			members = append(members, Member{
+				UserID:     m.UserID,
+				UserName:   m.UserName,
+				Email:      m.Email,
+				IsActive:   m.IsActive,
+				Role:       m.Role,
+				ArchivedAt: m.ArchivedAt,
+			})
+		}
+
+		doc.Teams = append(doc.Teams, Team{TeamName: team.TeamName, Members: members, ArchivedAt: team.ArchivedAt})
+	}
+
+	for _, pr := range snapshot.PullRequests {
+		doc.PullRequests = append(doc.PullRequests, PullRequest{
+			PullRequestID:     pr.PullRequestId,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorId,
+			Status:            pr.Status,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			ArchivedAt:        pr.ArchivedAt,
+		})
+	}
+
+	return doc, nil
+}
+
+// Import loads doc into store. An invalid document is rejected as a whole with ErrInvalidDocument,
+// the returned report then lists the problems. With DryRun set the report is computed but nothing is written.
+func Import(ctx context.Context, store repository.Dumper, doc *Document, opts Options) (*Report, error) {
+	if opts.Mode != ModeMerge && opts.Mode != ModeReplace {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMode, opts.Mode)
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, doc.Version)
+	}
+
+	report := &Report{
+		Mode:      opts.Mode,
+		DryRun:    opts.DryRun,
+		Conflicts: validate(doc),
+	}
+
+	if len(report.Conflicts) > 0 {
+		return report, ErrInvalidDocument
+	}
+
+	current, err := store.Dump(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump current data: %w", err)
+	}
+
+	var snapshot *domain.Snapshot
+	if opts.Mode == ModeReplace {
+		snapshot = toSnapshot(doc)
+		report.Created = count(snapshot)
+		report.Deleted = count(current)
+	} else {
+		snapshot = merge(doc, current, report)
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	err = store.Restore(ctx, snapshot, opts.Mode == ModeReplace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore data: %w", err)
+	}
+
+	return report, nil
+}
+
+func Decode(r io.Reader) (*Document, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var doc Document
+
+	err := decoder.Decode(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	return &doc, nil
+}
+
+func Encode(w io.Writer, doc *Document) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(doc)
+}
+
+// validate checks that the document is consistent on its own, independent of what is stored.
+func validate(doc *Document) []Conflict {
+	conflicts := make([]Conflict, 0)
+	teams := make(map[string]struct{})
+	users := make(map[string]struct{})
+	prs := make(map[string]struct{})
+
+	for _, team := range doc.Teams {
+		if team.TeamName == "" {
+			conflicts = append(conflicts, Conflict{kindTeam, "", "team_name is empty"})
+		}
+
+		if _, ok := teams[team.TeamName]; ok {
+			conflicts = append(conflicts, Conflict{kindTeam, team.TeamName, "team is listed twice"})
+		}
+		teams[team.TeamName] = struct{}{}
+
+		for _, m := range team.Members {
+			if m.UserID == "" {
+				conflicts = append(conflicts, Conflict{kindUser, "", "user_id is empty in team " + team.TeamName})
+			}
+
+			if _, ok := users[m.UserID]; ok {
+				conflicts = append(conflicts, Conflict{kindUser, m.UserID, "user is listed in more than one team"})
+			}
+			users[m.UserID] = struct{}{}
+
+			if m.Role != "" && !slices.Contains(domain.Roles, m.Role) {
+				conflicts = append(conflicts, Conflict{kindUser, m.UserID, "unknown role " + m.Role})
+			}
+		}
+	}
+
+	for _, pr := range doc.PullRequests {
+		if pr.PullRequestID == "" {
+			conflicts = append(conflicts, Conflict{kindPullRequest, "", "pull_request_id is empty"})
+		}
+
+		if _, ok := prs[pr.PullRequestID]; ok {
+			conflicts = append(conflicts, Conflict{kindPullRequest, pr.PullRequestID, "pull request is listed twice"})
+		}
+		prs[pr.PullRequestID] = struct{}{}
+
+		if _, ok := users[pr.AuthorID]; !ok {
+			conflicts = append(conflicts, Conflict{kindPullRequest, pr.PullRequestID, "author " + pr.AuthorID + " is not in any team"})
+		}
+
+		for _, r := range pr.AssignedReviewers {
+			if _, ok := users[r]; !ok {
+				conflicts = append(conflicts, Conflict{kindPullRequest, pr.PullRequestID, "reviewer " + r + " is not in any team"})
+			}
+		}
+
+		if pr.Status != domain.PRStatusOpen && pr.Status != domain.PRStatusMerged {
+			conflicts = append(conflicts, Conflict{kindPullRequest, pr.PullRequestID, "unknown status " + pr.Status})
+		}
+	}
+
+	return conflicts
+}
+
+// merge keeps only the rows of doc that are not stored yet and records the rest in report.
+func merge(doc *Document, current *domain.Snapshot, report *Report) *domain.Snapshot {
+	teams := make(map[string]struct{}, len(current.Teams))
+	users := make(map[string]domain.User)
+	archived := make(map[string]*time.Time)
+	for _, team := range current.Teams {
+		teams[team.TeamName] = struct{}{}
+
+		for _, m := range team.Members {
+			users[m.UserID] = domain.User{UserID: m.UserID, UserName: m.UserName, Email: m.Email, TeamName: team.TeamName, IsActive: m.IsActive,
+				Role: cmp.Or(m.Role, domain.RoleMember)}
+			archived[m.UserID] = m.ArchivedAt
+		}
+	}
+
+	prs := make(map[string]domain.PullRequest, len(current.PullRequests))
+	for _, pr := range current.PullRequests {
+		prs[pr.PullRequestId] = pr
+	}
+
+	incoming := toSnapshot(doc)
+	snapshot := &domain.Snapshot{
+		Teams:        make([]domain.Team, 0, len(incoming.Teams)),
+		PullRequests: make([]domain.PullRequest, 0, len(incoming.PullRequests)),
+	}
+
+	for _, team := range incoming.Teams {
+		if _, ok := teams[team.TeamName]; ok {
+			report.Unchanged.Teams++
+		} else {
+			report.Created.Teams++
+		}
+
+		members := make([]domain.TeamMember, 0, len(team.Members))
+		for _, m := range team.Members {
+			existing, ok := users[m.UserID]
+			if !ok {
+				members = append(members, m)
+				report.Created.Users++
+				continue
+			}
+
+			want := domain.User{UserID: m.UserID, UserName: m.UserName, Email: m.Email, TeamName: team.TeamName, IsActive: m.IsActive,
+				Role: cmp.Or(m.Role, domain.RoleMember)}
+			if existing == want && sameTime(archived[m.UserID], m.ArchivedAt) {
+				report.Unchanged.Users++
+				continue
+			}
+
+			report.Conflicts = append(report.Conflicts, Conflict{kindUser, m.UserID, "user exists with different data, kept as is"})
+		}
+
+		snapshot.Teams = append(snapshot.Teams, domain.Team{TeamName: team.TeamName, Members: members, ArchivedAt: team.ArchivedAt})
+	}
+
+	for _, pr := range incoming.PullRequests {
+		existing, ok := prs[pr.PullRequestId]
+		if !ok {
+			snapshot.PullRequests = append(snapshot.PullRequests, pr)
+			report.Created.PullRequests++
+			continue
+		}
+
+		if samePR(existing, pr) {
+			report.Unchanged.PullRequests++
+			continue
+		}
+
+		report.Conflicts = append(report.Conflicts, Conflict{kindPullRequest, pr.PullRequestId, "pull request exists with different data, kept as is"})
+	}
+
+	return snapshot
+}
+
+func toSnapshot(doc *Document) *domain.Snapshot {
+	snapshot := &domain.Snapshot{
+		Teams:        make([]domain.Team, 0, len(doc.Teams)),
+		PullRequests: make([]domain.PullRequest, 0, len(doc.PullRequests)),
+	}
+
+	for _, team := range doc.Teams {
+		members := make([]domain.TeamMember, 0, len(team.Members))
+		for _, m := range team.Members {
+			members = append(members, domain.TeamMember{
+				UserID:     m.UserID,
+				UserName:   m.UserName,
+				Email:      m.Email,
+				IsActive:   m.IsActive,
+				Role:       m.Role,
+				ArchivedAt: m.ArchivedAt,
+			})
+		}
+
+		snapshot.Teams = append(snapshot.Teams, domain.Team{TeamName: team.TeamName, Members: members, ArchivedAt: team.ArchivedAt})
+	}
+
+	for _, pr := range doc.PullRequests {
+		reviewers := pr.AssignedReviewers
+		if reviewers == nil {
+			reviewers = make([]string, 0)
+		}
+
+		snapshot.PullRequests = append(snapshot.PullRequests, domain.PullRequest{
+			PullRequestId:     pr.PullRequestID,
+			PullRequestName:   pr.PullRequestName,
+			AuthorId:          pr.AuthorID,
+			Status:            pr.Status,
+			AssignedReviewers: reviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+			ArchivedAt:        pr.ArchivedAt,
+		})
+	}
+
+	return snapshot
+}
+
+func count(snapshot *domain.Snapshot) Counts {
+	counts := Counts{Teams: len(snapshot.Teams), PullRequests: len(snapshot.PullRequests)}
+	for _, team := range snapshot.Teams {
+		counts.Users += len(team.Members)
+	}
+
+	return counts
+}
+
+func samePR(a, b domain.PullRequest) bool {
+	return a.PullRequestName == b.PullRequestName &&
+		a.AuthorId == b.AuthorId &&
+		a.Status == b.Status &&
+		slices.Equal(a.AssignedReviewers, b.AssignedReviewers) &&
+		sameTime(a.CreatedAt, b.CreatedAt) &&
+		sameTime(a.MergedAt, b.MergedAt) &&
+		sameTime(a.ArchivedAt, b.ArchivedAt)
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
@@ -0,0 +1,281 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/backup"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository/memory"
+)
+
+func newStore(t *testing.T) *memory.Client {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	return repo
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+// fixture covers roles, archived rows and pull requests in both states, listed in the order Export
+// produces them.
+func fixture() *backup.Document {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return &backup.Document{
+		Version: backup.Version,
+		Teams: []backup.Team{
+			{TeamName: "backend", Members: []backup.Member{
+				{UserID: "u1", UserName: "Alice", Email: "alice@example.com", IsActive: true, Role: domain.RoleLead},
+				{UserID: "u2", UserName: "Bob", IsActive: true, Role: domain.RoleMember},
+				{UserID: "u3", UserName: "Carol", IsActive: false, Role: domain.RoleMember, ArchivedAt: ptr(base.Add(time.Hour))},
+			}},
+			{TeamName: "legacy", ArchivedAt: ptr(base.Add(2 * time.Hour)), Members: []backup.Member{
+				{UserID: "u9", UserName: "Zed", IsActive: false, Role: domain.RoleAdmin},
+			}},
+		},
+		PullRequests: []backup.PullRequest{
+			{PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen,
+				AssignedReviewers: []string{"u2"}, CreatedAt: ptr(base)},
+			{PullRequestID: "pr-2", PullRequestName: "Fix login", AuthorID: "u2", Status: domain.PRStatusMerged,
+				AssignedReviewers: []string{"u1"}, CreatedAt: ptr(base.Add(time.Minute)), MergedAt: ptr(base.Add(time.Hour))},
+			{PullRequestID: "pr-3", PullRequestName: "Drop legacy", AuthorID: "u9", Status: domain.PRStatusOpen,
+				AssignedReviewers: []string{}, CreatedAt: ptr(base.Add(2 * time.Minute)), ArchivedAt: ptr(base.Add(2 * time.Hour))},
+		},
+	}
+}
+
+// export returns the document of store with ExportedAt cleared, so documents can be compared.
+func export(t *testing.T, store *memory.Client) *backup.Document {
+	t.Helper()
+
+	doc, err := backup.Export(context.Background(), store)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	if doc.Version != backup.Version || doc.ExportedAt.IsZero() {
+		t.Errorf("Export version = %d, exported at %v, want version %d with a time", doc.Version, doc.ExportedAt, backup.Version)
+	}
+
+	doc.ExportedAt = time.Time{}
+	return doc
+}
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	want := fixture()
+
+	source := newStore(t)
+
+	_, err := backup.Import(ctx, source, want, backup.Options{Mode: backup.ModeReplace})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	err = backup.Encode(&buf, export(t, source))
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	decoded, err := backup.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("exported document = %+v, want %+v", decoded, want)
+	}
+
+	// Loading the export into another store reproduces the data.
+	target := newStore(t)
+
+	_, err = backup.Import(ctx, target, decoded, backup.Options{Mode: backup.ModeMerge})
+	if err != nil {
+		t.Fatalf("Import into another store: %v", err)
+	}
+
+	if got := export(t, target); !reflect.DeepEqual(got, want) {
+		t.Errorf("second export = %+v, want %+v", got, want)
+	}
+}
+
+func TestImportMerge(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+
+	stored := fixture()
+	stored.Teams = stored.Teams[:1]
+	stored.Teams[0].Members[0].UserName = "Alice Smith"
+	stored.PullRequests = stored.PullRequests[:2]
+
+	_, err := backup.Import(ctx, store, stored, backup.Options{Mode: backup.ModeReplace})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	report, err := backup.Import(ctx, store, fixture(), backup.Options{Mode: backup.ModeMerge})
+	if err != nil {
+		t.Fatalf("Import merge: %v", err)
+	}
+
+	wantCreated := backup.Counts{Teams: 1, Users: 1, PullRequests: 1}
+	wantUnchanged := backup.Counts{Teams: 1, Users: 2, PullRequests: 2}
+	wantConflicts := []backup.Conflict{{Kind: "user", ID: "u1", Reason: "user exists with different data, kept as is"}}
+
+	if report.Created != wantCreated || report.Unchanged != wantUnchanged || report.Deleted != (backup.Counts{}) ||
+		!reflect.DeepEqual(report.Conflicts, wantConflicts) {
+		t.Errorf("report = %+v, want created %+v, unchanged %+v, conflicts %+v", report, wantCreated, wantUnchanged, wantConflicts)
+	}
+
+	// The stored row wins, everything missing is added.
+	want := fixture()
+	want.Teams[0].Members[0].UserName = "Alice Smith"
+
+	if got := export(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("export = %+v, want %+v", got, want)
+	}
+}
+
+func TestImportReplace(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+
+	_, err := backup.Import(ctx, store, fixture(), backup.Options{Mode: backup.ModeReplace})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	doc := &backup.Document{
+		Version: backup.Version,
+		Teams: []backup.Team{{TeamName: "frontend", Members: []backup.Member{
+			{UserID: "u1", UserName: "Alice", IsActive: true, Role: domain.RoleMember},
+		}}},
+		PullRequests: []backup.PullRequest{},
+	}
+
+	report, err := backup.Import(ctx, store, doc, backup.Options{Mode: backup.ModeReplace})
+	if err != nil {
+		t.Fatalf("Import replace: %v", err)
+	}
+
+	wantDeleted := backup.Counts{Teams: 2, Users: 4, PullRequests: 3}
+	wantCreated := backup.Counts{Teams: 1, Users: 1}
+
+	if report.Deleted != wantDeleted || report.Created != wantCreated || len(report.Conflicts) != 0 {
+		t.Errorf("report = %+v, want deleted %+v and created %+v", report, wantDeleted, wantCreated)
+	}
+
+	if got := export(t, store); !reflect.DeepEqual(got, doc) {
+		t.Errorf("export = %+v, want only the new document %+v", got, doc)
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	for _, mode := range []string{backup.ModeMerge, backup.ModeReplace} {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			store := newStore(t)
+
+			stored := fixture()
+			stored.Teams = stored.Teams[1:]
+			stored.PullRequests = stored.PullRequests[2:]
+
+			_, err := backup.Import(ctx, store, stored, backup.Options{Mode: backup.ModeReplace})
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+
+			before := export(t, store)
+
+			dryRun, err := backup.Import(ctx, store, fixture(), backup.Options{Mode: mode, DryRun: true})
+			if err != nil {
+				t.Fatalf("Import dry run: %v", err)
+			}
+
+			if got := export(t, store); !reflect.DeepEqual(got, before) {
+				t.Errorf("dry run changed the store to %+v", got)
+			}
+
+			// The dry run reports what the real import then does.
+			report, err := backup.Import(ctx, store, fixture(), backup.Options{Mode: mode})
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+
+			if !dryRun.DryRun || report.DryRun {
+				t.Errorf("DryRun flags = %v, %v, want true, false", dryRun.DryRun, report.DryRun)
+			}
+
+			dryRun.DryRun = false
+			if !reflect.DeepEqual(dryRun, report) {
+				t.Errorf("dry run report = %+v, want %+v", dryRun, report)
+			}
+		})
+	}
+}
+
+func TestImportRejects(t *testing.T) {
+	newer := fixture()
+	newer.Version = backup.Version + 1
+
+	invalid := fixture()
+	invalid.Teams[0].Members = append(invalid.Teams[0].Members, backup.Member{UserID: "u9", UserName: "Zed", Role: "owner"})
+	invalid.PullRequests[0].AuthorID = "ghost"
+
+	tests := []struct {
+		name          string
+		doc           *backup.Document
+		mode          string
+		want          error
+		wantConflicts []backup.Conflict
+	}{
+		{"NewerVersion", newer, backup.ModeReplace, backup.ErrUnsupportedVersion, nil},
+		{"UnknownMode", fixture(), "upsert", backup.ErrUnknownMode, nil},
+		{"InvalidDocument", invalid, backup.ModeReplace, backup.ErrInvalidDocument, []backup.Conflict{
+			{Kind: "user", ID: "u9", Reason: "unknown role owner"},
+			{Kind: "user", ID: "u9", Reason: "user is listed in more than one team"},
+			{Kind: "pull_request", ID: "pr-1", Reason: "author ghost is not in any team"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newStore(t)
+
+			report, err := backup.Import(context.Background(), store, tt.doc, backup.Options{Mode: tt.mode})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Import error = %v, want %v", err, tt.want)
+			}
+
+			if tt.wantConflicts != nil && (report == nil || !reflect.DeepEqual(report.Conflicts, tt.wantConflicts)) {
+				t.Errorf("report = %+v, want conflicts %+v", report, tt.wantConflicts)
+			}
+
+			if got := export(t, store); len(got.Teams) != 0 || len(got.PullRequests) != 0 {
+				t.Errorf("rejected import wrote %+v", got)
+			}
+		})
+	}
+}
+
+func TestDecodeRejectsUnknownFields(t *testing.T) {
+	_, err := backup.Decode(strings.NewReader(`{"version":1,"teams":[],"pull_requests":[],"events":[]}`))
+	if err == nil {
+		t.Error("Decode: want an error for an unknown field")
+	}
+}
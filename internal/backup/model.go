@@ -0,0 +1,83 @@
+package backup
+
+import (
+	"errors"
+	"time"
+)
+
+// Version is bumped whenever the document layout changes incompatibly.
+const Version = 1
+
+const (
+	// ModeMerge adds what is missing and keeps existing rows, rows that differ are reported as conflicts.
+	ModeMerge = "merge"
+	// ModeReplace deletes teams, users and pull requests before loading the document.
+	ModeReplace = "replace"
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported document version")
+	ErrInvalidDocument    = errors.New("invalid document")
+	ErrUnknownMode        = errors.New("unknown import mode")
+)
+
+// Document is the on-disk export format. Events are not part of it.
+type Document struct {
+	Version      int           `json:"version"`
+	ExportedAt   time.Time     `json:"exported_at"`
+	Teams        []Team        `json:"teams"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+type Team struct {
+	TeamName   string     `json:"team_name"`
+	Members    []Member   `json:"members"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+type Member struct {
+	UserID     string     `json:"user_id"`
+	UserName   string     `json:"username"`
+	Email      string     `json:"email,omitempty"`
+	IsActive   bool       `json:"is_active"`
+	Role       string     `json:"role,omitempty"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+}
+
+type PullRequest struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	ArchivedAt        *time.Time `json:"archived_at,omitempty"`
+}
+
+type Options struct {
+	Mode   string
+	DryRun bool
+}
+
+type Counts struct {
+	Teams        int `json:"teams"`
+	Users        int `json:"users"`
+	PullRequests int `json:"pull_requests"`
+}
+
+// Conflict describes a row that was not imported, or a problem that makes the document invalid.
+type Conflict struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+type Report struct {
+	Mode      string     `json:"mode"`
+	DryRun    bool       `json:"dry_run"`
+	Created   Counts     `json:"created"`
+	Unchanged Counts     `json:"unchanged"`
+	Deleted   Counts     `json:"deleted"`
+	Conflicts []Conflict `json:"conflicts"`
+}
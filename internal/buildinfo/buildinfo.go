@@ -0,0 +1,43 @@
+// Package buildinfo describes the running binary. Release builds stamp it with -ldflags:
+//
+//	go build -ldflags "-X reviewer-service/internal/buildinfo.Version=1.4.0 \
+//		-X reviewer-service/internal/buildinfo.Commit=$(git rev-parse --short HEAD) \
+//		-X reviewer-service/internal/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	"reviewer-service/internal/api"
+)
+
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Get falls back to the VCS stamp of the go toolchain for a commit that was not set by -ldflags.
+func Get() api.BuildInfo {
+	info := api.BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if info.Commit != "" {
+		return info
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range bi.Settings {
+			if s.Key == "vcs.revision" {
+				info.Commit = s.Value
+			}
+		}
+	}
+
+	return info
+}
@@ -6,15 +6,42 @@ import (
 
 	"github.com/ilyakaznacheev/cleanenv"
 
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/events"
+	"reviewer-service/internal/grpcapi"
+	"reviewer-service/internal/health"
+	"reviewer-service/internal/idempotency"
 	"reviewer-service/internal/logger"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/notifier/email"
+	"reviewer-service/internal/notifier/slack"
+	"reviewer-service/internal/ratelimit"
 	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/sqlite"
+	"reviewer-service/internal/retention"
 	"reviewer-service/internal/server"
+	"reviewer-service/internal/validation"
 )
 
 type Config struct {
-	HTTP     server.Config
-	Postgres postgres.Config
-	Logger   logger.Config
+	// Storage selects the repository backend: postgres, sqlite or memory.
+	Storage     string `env:"STORAGE" env-default:"postgres"`
+	HTTP        server.Config
+	GRPC        grpcapi.Config
+	Postgres    postgres.Config
+	SQLite      sqlite.Config
+	Logger      logger.Config
+	Slack       slack.Config
+	Email       email.Config
+	Notify      notifier.QueueConfig
+	Events      events.Config
+	Reminder    notifier.ReminderConfig
+	Retention   retention.Config
+	Auth        auth.Config
+	RateLimit   ratelimit.Config
+	Idempotency idempotency.Config
+	Validation  validation.Config
+	Health      health.Config
 }
 
 func New(path string) (*Config, error) {
@@ -5,21 +5,39 @@ import "time"
 type Team struct {
 	TeamName string
 	Members  []TeamMember
+	// ArchivedAt is only filled in snapshots, regular reads skip archived teams, users and pull requests.
+	ArchivedAt *time.Time
 }
 
 type TeamMember struct {
 	UserID   string
 	UserName string
+	Email    string
 	IsActive bool
+	// Role is read back from storage, saving a team keeps the stored roles.
+	Role       string
+	ArchivedAt *time.Time
 }
 
 type User struct {
 	UserID   string
 	UserName string
+	Email    string
 	TeamName string
 	IsActive bool
+	Role     string
 }
 
+// Roles a user holds in their team, every user is a member unless promoted.
+// An admin manages the whole organisation, not only their own team.
+const (
+	RoleMember = "member"
+	RoleLead   = "lead"
+	RoleAdmin  = "admin"
+)
+
+var Roles = []string{RoleMember, RoleLead, RoleAdmin}
+
 const (
 	PRStatusOpen   = "OPEN"
 	PRStatusMerged = "MERGED"
@@ -33,6 +51,7 @@ type PullRequest struct {
 	AssignedReviewers []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	ArchivedAt        *time.Time
 }
 
 type PullRequestShort struct {
@@ -41,3 +60,68 @@ type PullRequestShort struct {
 	AuthorId        string
 	Status          string
 }
+
+type Event struct {
+	EventID        int64
+	Type           string
+	TeamName       string
+	PullRequest    PullRequest
+	ReplacedUserID string
+	// Actor names the caller that caused the event, empty when authentication is off.
+	Actor string
+	// UserIDs holds everyone the event concerns: the author and the assigned or replaced reviewers.
+	UserIDs   []string
+	CreatedAt time.Time
+}
+
+// EventFilter matches events by user and team, empty fields match everything.
+type EventFilter struct {
+	UserID   string
+	TeamName string
+}
+
+// Snapshot is the whole dataset except events, it is what export and import move around.
+type Snapshot struct {
+	Teams        []Team
+	PullRequests []PullRequest
+}
+
+type Stats struct {
+	OpenPRs   int
+	MergedPRs int
+	// Reviewers lists everyone assigned to at least one pull request, busiest first.
+	Reviewers []ReviewerStats
+}
+
+type ReviewerStats struct {
+	UserID   string
+	Assigned int
+	Open     int
+}
+
+// APIKey is a stored API key, the key itself is never kept, only its hash.
+type APIKey struct {
+	KeyID     string
+	Name      string
+	Hash      []byte
+	Scopes    []string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+	// ExpiresAt is nil for keys that never expire.
+	ExpiresAt *time.Time
+}
+
+// IdempotencyKey remembers the response to a POST request sent with an Idempotency-Key header,
+// so that a retry gets the same response instead of running the request again.
+type IdempotencyKey struct {
+	// Scope is the actor of the request, callers never see each other's keys.
+	Scope       string
+	Key         string
+	RequestHash []byte
+	// StatusCode is zero while the first request is in progress.
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
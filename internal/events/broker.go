@@ -0,0 +1,299 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+)
+
+// subscriberBuffer is how many events a slow subscriber may lag behind before it is dropped,
+// a dropped client resumes from its Last-Event-ID.
+const subscriberBuffer = 64
+
+// pollPage is how many events one read of the event log returns.
+const pollPage = 500
+
+type Config struct {
+	// PollInterval is how often the event log is read for events saved by other instances,
+	// events saved by this instance are picked up right away.
+	PollInterval time.Duration `env:"EVENTS_POLL_INTERVAL" env-default:"1s"`
+	// Lookback is how long an event id skipped in the log is waited for. Ids are taken when a transaction
+	// starts, so an event may commit after events with higher ids, or never when its transaction rolls back.
+	Lookback time.Duration `env:"EVENTS_LOOKBACK" env-default:"10s"`
+}
+
+type Store interface {
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	SaveEvent(ctx context.Context, event domain.Event) (*domain.Event, error)
+	GetEventsAfter(ctx context.Context, eventID int64, filter domain.EventFilter, limit int) ([]domain.Event, error)
+	GetLastEventID(ctx context.Context) (int64, error)
+}
+
+// Broker persists review activity and fans it out to live subscribers. Subscribers only get events
+// read back from the store, so every instance sees the events of all of them. Events come in event id
+// order, except one that commits late, which follows the events read before it.
+type Broker struct {
+	cfg    *Config
+	store  Store
+	logger *zap.Logger
+	// wake asks Run to read the log now, Notify sends to it after saving an event.
+	wake chan struct{}
+
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	filter domain.EventFilter
+	events chan domain.Event
+}
+
+func NewBroker(cfg *Config, store Store, logger *zap.Logger) *Broker {
+	return &Broker{
+		cfg:         cfg,
+		store:       store,
+		logger:      logger,
+		wake:        make(chan struct{}, 1),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Notify implements notifier.Notifier so the broker can be composed with the other notifiers.
+func (b *Broker) Notify(ctx context.Context, event notifier.Event) error {
+	switch event.Type {
+	case notifier.EventAssigned, notifier.EventReassigned, notifier.EventMerged:
+	default:
+		return nil
+	}
+
+	// An archived author is no longer returned, the event is still recorded for its users
+	// but team streams do not see it.
+	var teamName string
+
+	author, err := b.store.GetUser(ctx, event.PullRequest.AuthorId)
+	switch {
+	case err == nil:
+		teamName = author.TeamName
+	case errors.Is(err, repository.ErrUserNotFound):
+		b.logger.Warn("Broker: pull request author not found, saving event without team",
+			zap.String("user_id", event.PullRequest.AuthorId))
+	default:
+		return fmt.Errorf("failed to get pull request author: %w", err)
+	}
+
+	userIDs := append([]string{event.PullRequest.AuthorId}, event.PullRequest.AssignedReviewers...)
+	if event.ReplacedUserID != "" {
+		userIDs = append(userIDs, event.ReplacedUserID)
+	}
+
+	_, err = b.store.SaveEvent(ctx, domain.Event{
+		Type:           event.Type,
+		TeamName:       teamName,
+		PullRequest:    event.PullRequest,
+		ReplacedUserID: event.ReplacedUserID,
+		UserIDs:        userIDs,
+		Actor:          auth.Actor(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Run publishes the events saved after it started, by this instance or any other sharing the
+// store, until ctx is done.
+func (b *Broker) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var (
+		pos   *position
+		ready bool
+	)
+
+	for {
+		if !ready {
+			lastID, err := b.store.GetLastEventID(ctx)
+			if err == nil {
+				pos, ready = newPosition(lastID), true
+			} else if ctx.Err() == nil {
+				b.logger.Error("Broker.Run: failed to get last event id", zap.Error(err))
+			}
+		}
+
+		if ready {
+			b.poll(ctx, pos)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-b.wake:
+		}
+	}
+}
+
+// position is how far Run has read the log: every event up to last except the ones in gaps.
+type position struct {
+	last int64
+	// gaps holds the skipped ids below last with the time they were noticed.
+	gaps map[int64]time.Time
+}
+
+func newPosition(last int64) *position {
+	return &position{last: last, gaps: make(map[int64]time.Time)}
+}
+
+// from returns the id to read after: just below the oldest gap, so that late events are read again.
+func (p *position) from() int64 {
+	from := p.last
+	for id := range p.gaps {
+		from = min(from, id-1)
+	}
+
+	return from
+}
+
+// advance records event as read and reports whether it had not been read before.
+func (p *position) advance(event domain.Event, now time.Time) bool {
+	if event.EventID <= p.last {
+		_, ok := p.gaps[event.EventID]
+		delete(p.gaps, event.EventID)
+		return ok
+	}
+
+	for id := p.last + 1; id < event.EventID; id++ {
+		p.gaps[id] = now
+	}
+	p.last = event.EventID
+
+	return true
+}
+
+// expire gives up on the gaps noticed before deadline.
+func (p *position) expire(deadline time.Time) {
+	maps.DeleteFunc(p.gaps, func(_ int64, noticed time.Time) bool {
+		return noticed.Before(deadline)
+	})
+}
+
+// poll publishes the events that were not read yet, each of them once.
+func (b *Broker) poll(ctx context.Context, pos *position) {
+	now := time.Now()
+	defer pos.expire(now.Add(-b.cfg.Lookback))
+
+	from := pos.from()
+	for {
+		events, err := b.store.GetEventsAfter(ctx, from, domain.EventFilter{}, pollPage)
+		if err != nil {
+			if ctx.Err() == nil {
+				b.logger.Error("Broker.Run: failed to read events", zap.Int64("event_id", from), zap.Error(err))
+			}
+			return
+		}
+
+		for _, event := range events {
+			if pos.advance(event, now) {
+				b.publish(event)
+			}
+			from = event.EventID
+		}
+
+		if len(events) < pollPage {
+			return
+		}
+	}
+}
+
+func (b *Broker) Subscribe(filter domain.EventFilter) *Subscription {
+	sub := &Subscription{
+		filter: filter,
+		events: make(chan domain.Event, subscriberBuffer),
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub
+}
+
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Events is closed when the subscription is dropped.
+func (s *Subscription) Events() <-chan domain.Event {
+	return s.events
+}
+
+// Close drops every subscription so that open streams end and the server can shut down.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+func (b *Broker) publish(event domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if !matches(sub.filter, event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			b.logger.Warn("dropping slow event subscriber", zap.Int64("event_id", event.EventID))
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func matches(filter domain.EventFilter, event domain.Event) bool {
+	if filter.TeamName != "" && filter.TeamName != event.TeamName {
+		return false
+	}
+
+	if filter.UserID == "" {
+		return true
+	}
+
+	for _, id := range event.UserIDs {
+		if id == filter.UserID {
+			return true
+		}
+	}
+
+	return false
+}
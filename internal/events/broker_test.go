@@ -0,0 +1,212 @@
+package events_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/events"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/memory"
+)
+
+var testConfig = events.Config{PollInterval: 10 * time.Millisecond, Lookback: time.Minute}
+
+func newRepo(t *testing.T) *memory.Client {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	err := repo.SaveTeam(context.Background(), &domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", UserName: "Alice", IsActive: true},
+			{UserID: "u2", UserName: "Bob", IsActive: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SaveTeam: %v", err)
+	}
+
+	return repo
+}
+
+// start runs a broker until the test ends and waits for it to pick its starting point in the log.
+func start(t *testing.T, repo events.Store) *events.Broker {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := events.NewBroker(&testConfig, repo, zap.NewNop())
+
+	done := make(chan struct{})
+	go func() {
+		b.Run(ctx)
+		close(done)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		b.Close()
+	})
+
+	time.Sleep(5 * testConfig.PollInterval)
+	return b
+}
+
+func assigned(authorID string) notifier.Event {
+	return notifier.Event{
+		Type: notifier.EventAssigned,
+		PullRequest: domain.PullRequest{
+			PullRequestId:     "pr-1",
+			PullRequestName:   "Add search",
+			AuthorId:          authorID,
+			Status:            domain.PRStatusOpen,
+			AssignedReviewers: []string{"u2"},
+		},
+		Reviewers: []string{"u2"},
+	}
+}
+
+func receive(t *testing.T, sub *events.Subscription) domain.Event {
+	t.Helper()
+
+	select {
+	case event := <-sub.Events():
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event delivered")
+		return domain.Event{}
+	}
+}
+
+func TestBrokerDeliversEventsOfOtherInstances(t *testing.T) {
+	repo := newRepo(t)
+
+	// Saved before the brokers start, so it must not be published.
+	_, err := repo.SaveEvent(context.Background(), domain.Event{Type: notifier.EventAssigned, TeamName: "backend"})
+	if err != nil {
+		t.Fatalf("SaveEvent: %v", err)
+	}
+
+	a := start(t, repo)
+	b := start(t, repo)
+
+	local := a.Subscribe(domain.EventFilter{TeamName: "backend"})
+	remote := b.Subscribe(domain.EventFilter{UserID: "u2"})
+
+	err = a.Notify(context.Background(), assigned("u1"))
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	for name, sub := range map[string]*events.Subscription{"local": local, "remote": remote} {
+		event := receive(t, sub)
+		if event.EventID != 2 || event.TeamName != "backend" || event.PullRequest.PullRequestId != "pr-1" {
+			t.Errorf("%s event = %+v, want event 2 of pr-1 in backend", name, event)
+		}
+	}
+}
+
+func TestBrokerArchivedAuthor(t *testing.T) {
+	repo := newRepo(t)
+	b := start(t, repo)
+
+	_, err := repo.ArchiveUser(context.Background(), "u1", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveUser: %v", err)
+	}
+
+	sub := b.Subscribe(domain.EventFilter{UserID: "u1"})
+
+	err = b.Notify(context.Background(), assigned("u1"))
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	event := receive(t, sub)
+	if event.TeamName != "" || event.PullRequest.AuthorId != "u1" {
+		t.Errorf("event = %+v, want an event of u1 without team", event)
+	}
+}
+
+// eventLog is a store whose events are added with the ids a test picks, like transactions that
+// commit out of id order.
+type eventLog struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+func (l *eventLog) add(eventID int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, domain.Event{EventID: eventID, Type: notifier.EventAssigned, TeamName: "backend"})
+	slices.SortFunc(l.events, func(a, b domain.Event) int {
+		return int(a.EventID - b.EventID)
+	})
+}
+
+func (l *eventLog) GetUser(context.Context, string) (*domain.User, error) {
+	return nil, repository.ErrUserNotFound
+}
+
+func (l *eventLog) SaveEvent(context.Context, domain.Event) (*domain.Event, error) {
+	panic("not used")
+}
+
+func (l *eventLog) GetEventsAfter(_ context.Context, eventID int64, _ domain.EventFilter, limit int) ([]domain.Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var events []domain.Event
+	for _, event := range l.events {
+		if event.EventID > eventID && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func (l *eventLog) GetLastEventID(context.Context) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.events) == 0 {
+		return 0, nil
+	}
+
+	return l.events[len(l.events)-1].EventID, nil
+}
+
+func TestBrokerDeliversLateCommits(t *testing.T) {
+	var log eventLog
+	log.add(1)
+
+	b := start(t, &log)
+	sub := b.Subscribe(domain.EventFilter{})
+
+	// Event 2 is still in its transaction when event 3 commits.
+	log.add(3)
+	if event := receive(t, sub); event.EventID != 3 {
+		t.Fatalf("event = %d, want 3", event.EventID)
+	}
+
+	log.add(2)
+	if event := receive(t, sub); event.EventID != 2 {
+		t.Fatalf("event = %d, want 2", event.EventID)
+	}
+
+	log.add(4)
+	if event := receive(t, sub); event.EventID != 4 {
+		t.Fatalf("event = %d, want 4, every event once", event.EventID)
+	}
+}
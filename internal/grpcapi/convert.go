@@ -0,0 +1,92 @@
+package grpcapi
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/grpcapi/reviewerv1"
+)
+
+func toProtoTeam(team *domain.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, len(team.Members))
+	for i, m := range team.Members {
+		members[i] = &reviewerv1.TeamMember{
+			UserId:   m.UserID,
+			Username: m.UserName,
+			IsActive: m.IsActive,
+			Email:    m.Email,
+		}
+	}
+
+	return &reviewerv1.Team{
+		TeamName: team.TeamName,
+		Members:  members,
+	}
+}
+
+func fromProtoTeam(team *reviewerv1.Team) *domain.Team {
+	members := make([]domain.TeamMember, len(team.GetMembers()))
+	for i, m := range team.GetMembers() {
+		members[i] = domain.TeamMember{
+			UserID:   m.GetUserId(),
+			UserName: m.GetUsername(),
+			Email:    m.GetEmail(),
+			IsActive: m.GetIsActive(),
+		}
+	}
+
+	return &domain.Team{
+		TeamName: team.GetTeamName(),
+		Members:  members,
+	}
+}
+
+func toProtoUser(user *domain.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:   user.UserID,
+		Username: user.UserName,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+		Email:    user.Email,
+	}
+}
+
+func toProtoPR(pr *domain.PullRequest) *reviewerv1.PullRequest {
+	protoPR := &reviewerv1.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            toProtoStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+	}
+
+	if pr.CreatedAt != nil {
+		protoPR.CreatedAt = timestamppb.New(*pr.CreatedAt)
+	}
+
+	if pr.MergedAt != nil {
+		protoPR.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+
+	return protoPR
+}
+
+func toProtoPRShort(pr domain.PullRequestShort) *reviewerv1.PullRequestShort {
+	return &reviewerv1.PullRequestShort{
+		PullRequestId:   pr.PullRequestId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorId,
+		Status:          toProtoStatus(pr.Status),
+	}
+}
+
+func toProtoStatus(status string) reviewerv1.PullRequestStatus {
+	switch status {
+	case domain.PRStatusOpen:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,69 @@
+package grpcapi
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+const errorDomain = "reviewer-service"
+
+// toStatus maps service and repository errors onto gRPC codes, the api.Code* value travels in an ErrorInfo detail.
+func (s *Server) toStatus(method string, err error) error {
+	var (
+		code    codes.Code
+		reason  string
+		message string
+	)
+
+	switch {
+	case errors.Is(err, repository.ErrTeamAlreadyExists):
+		code, reason, message = codes.AlreadyExists, api.CodeTeamExists, api.ErrTeamExists
+	case errors.Is(err, repository.ErrPRAlreadyExists):
+		code, reason, message = codes.AlreadyExists, api.CodePRExists, api.ErrPRExists
+	case errors.Is(err, service.ErrPRMerged):
+		code, reason, message = codes.FailedPrecondition, api.CodePRMerged, api.ErrPRMerged
+	case errors.Is(err, service.ErrReviewerNotAssigned):
+		code, reason, message = codes.FailedPrecondition, api.CodeNotAssigned, api.ErrNotAssigned
+	case errors.Is(err, service.ErrNoCandidate):
+		code, reason, message = codes.FailedPrecondition, api.CodeNoCandidate, api.ErrNoCandidate
+	case errors.Is(err, service.ErrReviewersNotFound):
+		code, reason, message = codes.FailedPrecondition, api.CodeNoReviewers, api.ErrNoReviewers
+	case errors.Is(err, repository.ErrPRChanged):
+		code, reason, message = codes.Aborted, api.CodePRChanged, api.ErrPRChanged
+	case errors.Is(err, repository.ErrTeamNotFound),
+		errors.Is(err, repository.ErrUserNotFound),
+		errors.Is(err, repository.ErrPRNotFound):
+		code, reason, message = codes.NotFound, api.CodeNotFound, api.ErrNotFound
+	case errors.Is(err, repository.ErrDuplicateKey):
+		s.logger.Warn(method+": duplicate key", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "duplicate key")
+	default:
+		s.logger.Error(method+": internal error", zap.Error(err))
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	s.logger.Warn(method+": "+err.Error(), zap.String("code", reason))
+
+	return newStatus(code, reason, message)
+}
+
+// newStatus builds a status carrying reason in an ErrorInfo detail, next to any other details.
+func newStatus(code codes.Code, reason string, message string, details ...protoadapt.MessageV1) error {
+	details = append([]protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}}, details...)
+
+	st, err := status.New(code, message).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, message)
+	}
+
+	return st.Err()
+}
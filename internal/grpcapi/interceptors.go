@@ -0,0 +1,286 @@
+package grpcapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/authz"
+	"reviewer-service/internal/grpcapi/reviewerv1"
+	"reviewer-service/internal/ratelimit"
+	"reviewer-service/internal/validation"
+)
+
+// readMethods need the read scope, every other method changes data and needs write.
+var readMethods = map[string]bool{
+	reviewerv1.ReviewerService_GetTeam_FullMethodName:   true,
+	reviewerv1.ReviewerService_GetReview_FullMethodName: true,
+}
+
+// Interceptors puts gRPC calls through the same checks as the HTTP API: the per-IP limit,
+// authentication, per-caller limits, request validation and the role policy, in that order. Credentials travel in
+// the x-api-key or authorization metadata.
+func Interceptors(authenticator *auth.Authenticator, throttler *ratelimit.Throttler, schemas *validation.Schemas, authorizer *authz.Authorizer, logger *zap.Logger) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(
+		throttleIP(throttler, logger),
+		authenticate(authenticator, logger),
+		throttle(throttler, logger),
+		validate(schemas, logger),
+		authorize(authorizer, logger),
+	)
+}
+
+func authenticate(authenticator *auth.Authenticator, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !authenticator.Enabled() {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		identity, err := authenticator.Authenticate(ctx, first(md, "x-api-key"), first(md, "authorization"))
+		if err != nil {
+			if auth.IsUnauthorized(err) {
+				logger.Warn("authenticate: unauthorized", zap.String("method", info.FullMethod), zap.Error(err))
+				return nil, newStatus(codes.Unauthenticated, api.CodeUnauthorized, api.ErrUnauthorized)
+			}
+
+			logger.Error("authenticate: failed to authenticate", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, newStatus(codes.Internal, api.CodeInternal, api.ErrInternal)
+		}
+
+		scope := auth.ScopeWrite
+		if readMethods[info.FullMethod] {
+			scope = auth.ScopeRead
+		}
+
+		if !identity.Allows(scope) {
+			logger.Warn("authenticate: insufficient scope",
+				zap.String("api_key_id", identity.KeyID),
+				zap.String("user_id", identity.UserID),
+				zap.String("method", info.FullMethod),
+				zap.String("scope", scope),
+			)
+			return nil, newStatus(codes.PermissionDenied, api.CodeForbidden, api.ErrForbidden)
+		}
+
+		return handler(auth.WithIdentity(ctx, identity), req)
+	}
+}
+
+func throttleIP(throttler *ratelimit.Throttler, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		addr := peerAddr(ctx)
+
+		decision, err := throttler.AllowIP(ctx, addr)
+		if err != nil {
+			logger.Error("throttleIP: failed to check rate limit", zap.String("addr", addr), zap.Error(err))
+		}
+
+		if !decision.Allowed {
+			logger.Warn("throttleIP: rate limit exceeded",
+				zap.String("addr", addr),
+				zap.String("method", info.FullMethod),
+				zap.Stringer("limit", decision.Limit),
+			)
+			return nil, rateLimited(decision)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// throttle keys the route limits by the full method name, RATE_LIMIT_ROUTES may list them
+// next to HTTP paths.
+func throttle(throttler *ratelimit.Throttler, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		client := ratelimit.ClientKey(ctx, peerAddr(ctx))
+
+		decision, err := throttler.Allow(ctx, client, info.FullMethod)
+		if err != nil {
+			// A broken limiter must not take the API down with it.
+			logger.Error("throttle: failed to check rate limit", zap.String("client", client), zap.Error(err))
+		}
+
+		if !decision.Allowed {
+			logger.Warn("throttle: rate limit exceeded",
+				zap.String("client", client),
+				zap.String("method", info.FullMethod),
+				zap.Stringer("limit", decision.Limit),
+			)
+			return nil, rateLimited(decision)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func rateLimited(decision ratelimit.Decision) error {
+	return newStatus(codes.ResourceExhausted, api.CodeRateLimited, api.ErrRateLimited,
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(decision.RetryAfter)})
+}
+
+// validate applies the constraints openapi.yml puts on the matching HTTP requests.
+func validate(schemas *validation.Schemas, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		violations, err := validateRequest(schemas, req)
+		if err != nil {
+			logger.Error("validate: failed to validate", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, newStatus(codes.Internal, api.CodeInternal, api.ErrInternal)
+		}
+
+		if len(violations) > 0 {
+			logger.Warn("validate: invalid request", zap.String("method", info.FullMethod), zap.Int("violations", len(violations)))
+			return nil, newStatus(codes.InvalidArgument, api.CodeInvalid, api.ErrInvalidRequest,
+				&errdetails.BadRequest{FieldViolations: violations})
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func authorize(authorizer *authz.Authorizer, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		allowed, err := authorizer.Authorize(ctx, policyRequest(req))
+		if err != nil {
+			logger.Error("authorize: failed to authorize", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, newStatus(codes.Internal, api.CodeInternal, api.ErrInternal)
+		}
+
+		if !allowed {
+			logger.Warn("authorize: action is not allowed",
+				zap.String("actor", auth.Actor(ctx)),
+				zap.String("method", info.FullMethod),
+			)
+			return nil, newStatus(codes.PermissionDenied, api.CodeForbidden, api.ErrNotAllowed)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// policyRequest converts the calls the role policy restricts into the HTTP request objects it
+// knows, the rest are allowed to every authenticated caller as over HTTP.
+func policyRequest(req any) any {
+	switch req := req.(type) {
+	case *reviewerv1.AddTeamRequest:
+		return api.AddTeamRequestObject{}
+
+	case *reviewerv1.SetIsActiveRequest:
+		return api.SetIsActiveRequestObject{Body: &api.SetIsActiveJSONRequestBody{
+			UserId:   req.GetUserId(),
+			IsActive: req.GetIsActive(),
+		}}
+
+	case *reviewerv1.GetReviewRequest:
+		userID := req.GetUserId()
+		return api.GetReviewRequestObject{Params: api.GetReviewParams{UserId: &userID}}
+
+	case *reviewerv1.MergePullRequestRequest:
+		return api.MergePullRequestRequestObject{Body: &api.MergePullRequestJSONRequestBody{
+			PullRequestId: req.GetPullRequestId(),
+		}}
+
+	case *reviewerv1.ReassignPullRequestRequest:
+		return api.ReassignPullRequestRequestObject{Body: &api.ReassignPullRequestJSONRequestBody{
+			PullRequestId: req.GetPullRequestId(),
+			OldUserId:     req.GetOldUserId(),
+		}}
+
+	default:
+		return req
+	}
+}
+
+// validateRequest checks a request against the HTTP operation it mirrors, field paths follow the
+// request message.
+func validateRequest(schemas *validation.Schemas, req any) ([]*errdetails.BadRequest_FieldViolation, error) {
+	var (
+		fieldErrs []api.ProblemFieldError
+		prefix    string
+		err       error
+	)
+
+	switch req := req.(type) {
+	case *reviewerv1.AddTeamRequest:
+		team := api.Team{TeamName: req.GetTeam().GetTeamName(), Members: make([]api.TeamMember, 0)}
+		for _, member := range req.GetTeam().GetMembers() {
+			team.Members = append(team.Members, api.TeamMember{
+				UserId:   member.GetUserId(),
+				Username: member.GetUsername(),
+				Email:    member.GetEmail(),
+				IsActive: member.GetIsActive(),
+			})
+		}
+
+		prefix = "team."
+		fieldErrs, err = schemas.Body("addTeam", team)
+
+	case *reviewerv1.GetTeamRequest:
+		fieldErrs, err = schemas.Parameter("getTeam", "team_name", req.GetTeamName())
+
+	case *reviewerv1.SetIsActiveRequest:
+		fieldErrs, err = schemas.Body("setIsActive", api.SetIsActiveJSONBody{
+			UserId:   req.GetUserId(),
+			IsActive: req.GetIsActive(),
+		})
+
+	case *reviewerv1.GetReviewRequest:
+		fieldErrs, err = schemas.Parameter("getReview", "user_id", req.GetUserId())
+
+	case *reviewerv1.CreatePullRequestRequest:
+		fieldErrs, err = schemas.Body("createPullRequest", api.CreatePullRequestJSONBody{
+			PullRequestId:   req.GetPullRequestId(),
+			PullRequestName: req.GetPullRequestName(),
+			AuthorId:        req.GetAuthorId(),
+		})
+
+	case *reviewerv1.MergePullRequestRequest:
+		fieldErrs, err = schemas.Body("mergePullRequest", api.MergePullRequestJSONBody{
+			PullRequestId: req.GetPullRequestId(),
+		})
+
+	case *reviewerv1.ReassignPullRequestRequest:
+		fieldErrs, err = schemas.Body("reassignPullRequest", api.ReassignPullRequestJSONBody{
+			PullRequestId: req.GetPullRequestId(),
+			OldUserId:     req.GetOldUserId(),
+		})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: prefix + fe.Field, Description: fe.Message}
+	}
+
+	return violations, nil
+}
+
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return p.Addr.String()
+}
+
+func first(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
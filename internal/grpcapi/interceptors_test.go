@@ -0,0 +1,81 @@
+package grpcapi
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/grpcapi/reviewerv1"
+	"reviewer-service/internal/validation"
+)
+
+func TestValidateRequest(t *testing.T) {
+	schemas, err := validation.NewSchemas()
+	if err != nil {
+		t.Fatalf("NewSchemas: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  any
+		want []string
+	}{
+		{"AddTeam", &reviewerv1.AddTeamRequest{Team: &reviewerv1.Team{
+			TeamName: "backend",
+			Members: []*reviewerv1.TeamMember{
+				{UserId: "u1", Username: "Alice"},
+				{UserId: "u 2", Username: strings.Repeat("b", 101)},
+			},
+		}}, []string{"team.members[1].user_id", "team.members[1].username"}},
+		{"GetTeam", &reviewerv1.GetTeamRequest{}, []string{"team_name"}},
+		{"CreatePullRequest", &reviewerv1.CreatePullRequestRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: strings.Repeat("n", 256),
+			AuthorId:        "u1",
+		}, []string{"pull_request_name"}},
+		{"ReassignPullRequest", &reviewerv1.ReassignPullRequestRequest{PullRequestId: "pr-1", OldUserId: "u1"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validateRequest(schemas, tt.req)
+			if err != nil {
+				t.Fatalf("validateRequest: %v", err)
+			}
+
+			var got []string
+			for _, v := range violations {
+				got = append(got, v.GetField())
+			}
+			got = slices.Compact(got)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyRequest(t *testing.T) {
+	userID := "u2"
+
+	tests := []struct {
+		name string
+		req  any
+		want any
+	}{
+		{"GetReview", &reviewerv1.GetReviewRequest{UserId: userID}, api.GetReviewRequestObject{Params: api.GetReviewParams{UserId: &userID}}},
+		{"Merge", &reviewerv1.MergePullRequestRequest{PullRequestId: "pr-1"}, api.MergePullRequestRequestObject{
+			Body: &api.MergePullRequestJSONRequestBody{PullRequestId: "pr-1"},
+		}},
+		{"AddTeam", &reviewerv1.AddTeamRequest{}, api.AddTeamRequestObject{}},
+	}
+
+	for _, tt := range tests {
+		if got := policyRequest(tt.req); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: policyRequest = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
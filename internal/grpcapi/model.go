@@ -0,0 +1,23 @@
+package grpcapi
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/grpcapi/reviewerv1"
+	"reviewer-service/internal/service"
+)
+
+type Config struct {
+	Host string `env:"GRPC_HOST" env-default:"0.0.0.0"`
+	Port int    `env:"GRPC_PORT" env-default:"9090"`
+}
+
+type Server struct {
+	reviewerv1.UnimplementedReviewerServiceServer
+
+	svc            *service.Service
+	requestTimeout time.Duration
+	logger         *zap.Logger
+}
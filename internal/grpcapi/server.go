@@ -0,0 +1,166 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"reviewer-service/internal/grpcapi/reviewerv1"
+	"reviewer-service/internal/service"
+)
+
+func New(svc *service.Service, requestTimeout time.Duration, logger *zap.Logger) *Server {
+	return &Server{
+		svc:            svc,
+		requestTimeout: requestTimeout,
+		logger:         logger,
+	}
+}
+
+// NewGRPCServer returns a grpc.Server with the ReviewerService registered, ready to Serve on any listener.
+func NewGRPCServer(srv *Server, opts ...grpc.ServerOption) *grpc.Server {
+	grpcServer := grpc.NewServer(opts...)
+	reviewerv1.RegisterReviewerServiceServer(grpcServer, srv)
+
+	return grpcServer
+}
+
+// Shutdown waits for the running RPCs like GracefulStop until ctx is done, then cancels the rest with Stop
+// and returns ctx.Err().
+func Shutdown(ctx context.Context, grpcServer *grpc.Server) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		grpcServer.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
+func (s *Server) AddTeam(ctx context.Context, req *reviewerv1.AddTeamRequest) (*reviewerv1.AddTeamResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	if req.GetTeam().GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name is required")
+	}
+
+	team := fromProtoTeam(req.GetTeam())
+
+	err := s.svc.CreateTeam(ctx, team)
+	if err != nil {
+		return nil, s.toStatus("AddTeam", err)
+	}
+
+	s.logger.Info("AddTeam: successfully saved team", zap.String("team_name", team.TeamName))
+	return &reviewerv1.AddTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.GetTeamResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	if req.GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name is required")
+	}
+
+	team, err := s.svc.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, s.toStatus("GetTeam", err)
+	}
+
+	s.logger.Info("GetTeam: successfully give team", zap.String("team_name", team.TeamName))
+	return &reviewerv1.GetTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) SetIsActive(ctx context.Context, req *reviewerv1.SetIsActiveRequest) (*reviewerv1.SetIsActiveResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	user, err := s.svc.SetIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, s.toStatus("SetIsActive", err)
+	}
+
+	s.logger.Info("SetIsActive: successfully set is_active", zap.String("user_id", user.UserID))
+	return &reviewerv1.SetIsActiveResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *Server) GetReview(ctx context.Context, req *reviewerv1.GetReviewRequest) (*reviewerv1.GetReviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	prs, err := s.svc.GetReviews(ctx, req.GetUserId())
+	if err != nil {
+		return nil, s.toStatus("GetReview", err)
+	}
+
+	protoPRs := make([]*reviewerv1.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		protoPRs[i] = toProtoPRShort(pr)
+	}
+
+	s.logger.Info("GetReview: success give review", zap.String("user_id", req.GetUserId()))
+	return &reviewerv1.GetReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: protoPRs,
+	}, nil
+}
+
+func (s *Server) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.CreatePullRequestResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	pr, err := s.svc.CreatePR(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId())
+	if err != nil {
+		return nil, s.toStatus("CreatePullRequest", err)
+	}
+
+	s.logger.Info("CreatePullRequest: successfully created pull request", zap.String("pull_request_id", pr.PullRequestId))
+	return &reviewerv1.CreatePullRequestResponse{PullRequest: toProtoPR(pr)}, nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, req *reviewerv1.MergePullRequestRequest) (*reviewerv1.MergePullRequestResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	pr, err := s.svc.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, s.toStatus("MergePullRequest", err)
+	}
+
+	s.logger.Info("MergePullRequest: successfully set pull request status", zap.String("pull_request_id", pr.PullRequestId))
+	return &reviewerv1.MergePullRequestResponse{PullRequest: toProtoPR(pr)}, nil
+}
+
+func (s *Server) ReassignPullRequest(ctx context.Context, req *reviewerv1.ReassignPullRequestRequest) (*reviewerv1.ReassignPullRequestResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	pr, newReviewer, err := s.svc.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, s.toStatus("ReassignPullRequest", err)
+	}
+
+	s.logger.Info("ReassignPullRequest: successfully reassigned reviewer", zap.String("pull_request_id", pr.PullRequestId))
+	return &reviewerv1.ReassignPullRequestResponse{
+		PullRequest: toProtoPR(pr),
+		ReplacedBy:  newReviewer,
+	}, nil
+}
@@ -0,0 +1,363 @@
+package grpcapi_test
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/grpcapi"
+	"reviewer-service/internal/grpcapi/reviewerv1"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository/memory"
+	"reviewer-service/internal/service"
+)
+
+// newClient serves a Server over an in-memory listener, backed by the memory repository.
+func newClient(t *testing.T) reviewerv1.ReviewerServiceClient {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	svc := service.New(repo, notifier.Multi{}, rand.New(rand.NewPCG(1, 2)), zap.NewNop())
+	srv := grpcapi.NewGRPCServer(grpcapi.New(svc, 5*time.Second, zap.NewNop()))
+
+	listener := bufconn.Listen(1 << 20)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return reviewerv1.NewReviewerServiceClient(conn)
+}
+
+func member(id string, active bool) *reviewerv1.TeamMember {
+	return &reviewerv1.TeamMember{UserId: id, Username: "user " + id, IsActive: active}
+}
+
+func mustAddTeam(t *testing.T, client reviewerv1.ReviewerServiceClient, name string, members ...*reviewerv1.TeamMember) {
+	t.Helper()
+
+	_, err := client.AddTeam(context.Background(), &reviewerv1.AddTeamRequest{
+		Team: &reviewerv1.Team{TeamName: name, Members: members},
+	})
+	if err != nil {
+		t.Fatalf("AddTeam %s: %v", name, err)
+	}
+}
+
+func mustCreatePR(t *testing.T, client reviewerv1.ReviewerServiceClient, prID string, authorID string) *reviewerv1.PullRequest {
+	t.Helper()
+
+	resp, err := client.CreatePullRequest(context.Background(), &reviewerv1.CreatePullRequestRequest{
+		PullRequestId:   prID,
+		PullRequestName: "Add search",
+		AuthorId:        authorID,
+	})
+	if err != nil {
+		t.Fatalf("CreatePullRequest %s: %v", prID, err)
+	}
+
+	return resp.GetPullRequest()
+}
+
+// requireStatus fails unless err is a status with code and, when reason is set, an ErrorInfo with that reason.
+func requireStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != code {
+		t.Fatalf("error = %v, want code %s", err, code)
+	}
+
+	if reason == "" {
+		return
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			if info.GetReason() != reason {
+				t.Errorf("reason = %s, want %s", info.GetReason(), reason)
+			}
+			return
+		}
+	}
+
+	t.Errorf("error %v carries no ErrorInfo, want reason %s", err, reason)
+}
+
+func TestTeams(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	resp, err := client.AddTeam(ctx, &reviewerv1.AddTeamRequest{
+		Team: &reviewerv1.Team{TeamName: "backend", Members: []*reviewerv1.TeamMember{member("u1", true), member("u2", false)}},
+	})
+	if err != nil {
+		t.Fatalf("AddTeam: %v", err)
+	}
+
+	if got := len(resp.GetTeam().GetMembers()); got != 2 {
+		t.Errorf("AddTeam members = %d, want 2", got)
+	}
+
+	got, err := client.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "backend"})
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if got.GetTeam().GetTeamName() != "backend" || len(got.GetTeam().GetMembers()) != 2 {
+		t.Errorf("GetTeam = %v, want backend with 2 members", got.GetTeam())
+	}
+
+	tests := []struct {
+		name   string
+		call   func() error
+		code   codes.Code
+		reason string
+	}{
+		{"AddTeam/Exists", func() error {
+			_, err := client.AddTeam(ctx, &reviewerv1.AddTeamRequest{Team: &reviewerv1.Team{TeamName: "backend"}})
+			return err
+		}, codes.AlreadyExists, api.CodeTeamExists},
+		{"AddTeam/NoName", func() error {
+			_, err := client.AddTeam(ctx, &reviewerv1.AddTeamRequest{Team: &reviewerv1.Team{}})
+			return err
+		}, codes.InvalidArgument, ""},
+		{"GetTeam/NotFound", func() error {
+			_, err := client.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "missing"})
+			return err
+		}, codes.NotFound, api.CodeNotFound},
+		{"GetTeam/NoName", func() error {
+			_, err := client.GetTeam(ctx, &reviewerv1.GetTeamRequest{})
+			return err
+		}, codes.InvalidArgument, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireStatus(t, tt.call(), tt.code, tt.reason)
+		})
+	}
+}
+
+func TestUsers(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	mustAddTeam(t, client, "backend", member("u1", true), member("u2", true))
+	pr := mustCreatePR(t, client, "pr-1", "u1")
+
+	resp, err := client.SetIsActive(ctx, &reviewerv1.SetIsActiveRequest{UserId: "u2", IsActive: false})
+	if err != nil {
+		t.Fatalf("SetIsActive: %v", err)
+	}
+
+	if resp.GetUser().GetIsActive() || resp.GetUser().GetTeamName() != "backend" {
+		t.Errorf("SetIsActive = %v, want inactive u2 of backend", resp.GetUser())
+	}
+
+	review, err := client.GetReview(ctx, &reviewerv1.GetReviewRequest{UserId: "u2"})
+	if err != nil {
+		t.Fatalf("GetReview: %v", err)
+	}
+
+	if len(review.GetPullRequests()) != 1 || review.GetPullRequests()[0].GetPullRequestId() != pr.GetPullRequestId() {
+		t.Errorf("GetReview = %v, want [%s]", review.GetPullRequests(), pr.GetPullRequestId())
+	}
+
+	_, err = client.SetIsActive(ctx, &reviewerv1.SetIsActiveRequest{UserId: "missing"})
+	requireStatus(t, err, codes.NotFound, api.CodeNotFound)
+
+	_, err = client.GetReview(ctx, &reviewerv1.GetReviewRequest{})
+	requireStatus(t, err, codes.InvalidArgument, "")
+}
+
+func TestPullRequests(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	mustAddTeam(t, client, "backend", member("u1", true), member("u2", true), member("u3", true))
+	mustAddTeam(t, client, "solo", member("s1", true))
+
+	pr := mustCreatePR(t, client, "pr-1", "u1")
+	if pr.GetStatus() != reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN || len(pr.GetAssignedReviewers()) != 2 {
+		t.Fatalf("CreatePullRequest = %v, want an OPEN pull request with 2 reviewers", pr)
+	}
+
+	reassigned, err := client.ReassignPullRequest(ctx, &reviewerv1.ReassignPullRequestRequest{
+		PullRequestId: "pr-1",
+		OldUserId:     pr.GetAssignedReviewers()[0],
+	})
+	// u1 authored pr-1 and u2, u3 review it, so there is nobody left to take over.
+	requireStatus(t, err, codes.FailedPrecondition, api.CodeNoCandidate)
+	if reassigned != nil {
+		t.Errorf("ReassignPullRequest = %v, want nil", reassigned)
+	}
+
+	mustAddTeam(t, client, "frontend", member("f1", true), member("f2", true), member("f3", true), member("f4", true))
+	front := mustCreatePR(t, client, "pr-2", "f1")
+
+	old := front.GetAssignedReviewers()[0]
+	reassigned, err = client.ReassignPullRequest(ctx, &reviewerv1.ReassignPullRequestRequest{PullRequestId: "pr-2", OldUserId: old})
+	if err != nil {
+		t.Fatalf("ReassignPullRequest: %v", err)
+	}
+
+	if reassigned.GetReplacedBy() == old || reassigned.GetReplacedBy() == "f1" {
+		t.Errorf("ReassignPullRequest replaced_by = %s, want a teammate other than %s and the author", reassigned.GetReplacedBy(), old)
+	}
+
+	merged, err := client.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+	if err != nil {
+		t.Fatalf("MergePullRequest: %v", err)
+	}
+
+	if merged.GetPullRequest().GetStatus() != reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED || merged.GetPullRequest().GetMergedAt() == nil {
+		t.Errorf("MergePullRequest = %v, want MERGED with merged_at", merged.GetPullRequest())
+	}
+
+	again, err := client.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+	if err != nil {
+		t.Fatalf("MergePullRequest again: %v", err)
+	}
+
+	if !again.GetPullRequest().GetMergedAt().AsTime().Equal(merged.GetPullRequest().GetMergedAt().AsTime()) {
+		t.Errorf("merged_at changed on a repeated merge")
+	}
+
+	tests := []struct {
+		name   string
+		call   func() error
+		code   codes.Code
+		reason string
+	}{
+		{"CreatePullRequest/Exists", func() error {
+			_, err := client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{PullRequestId: "pr-1", PullRequestName: "x", AuthorId: "u1"})
+			return err
+		}, codes.AlreadyExists, api.CodePRExists},
+		{"CreatePullRequest/AuthorNotFound", func() error {
+			_, err := client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{PullRequestId: "pr-3", PullRequestName: "x", AuthorId: "missing"})
+			return err
+		}, codes.NotFound, api.CodeNotFound},
+		{"CreatePullRequest/ExistsBeforeAuthor", func() error {
+			_, err := client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{PullRequestId: "pr-1", PullRequestName: "x", AuthorId: "missing"})
+			return err
+		}, codes.AlreadyExists, api.CodePRExists},
+		{"CreatePullRequest/NoReviewers", func() error {
+			_, err := client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{PullRequestId: "pr-4", PullRequestName: "x", AuthorId: "s1"})
+			return err
+		}, codes.FailedPrecondition, api.CodeNoReviewers},
+		{"MergePullRequest/NotFound", func() error {
+			_, err := client.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: "missing"})
+			return err
+		}, codes.NotFound, api.CodeNotFound},
+		{"ReassignPullRequest/Merged", func() error {
+			_, err := client.ReassignPullRequest(ctx, &reviewerv1.ReassignPullRequestRequest{PullRequestId: "pr-1", OldUserId: pr.GetAssignedReviewers()[0]})
+			return err
+		}, codes.FailedPrecondition, api.CodePRMerged},
+		{"ReassignPullRequest/NotAssigned", func() error {
+			_, err := client.ReassignPullRequest(ctx, &reviewerv1.ReassignPullRequestRequest{PullRequestId: "pr-2", OldUserId: "f1"})
+			return err
+		}, codes.FailedPrecondition, api.CodeNotAssigned},
+		{"ReassignPullRequest/NotFound", func() error {
+			_, err := client.ReassignPullRequest(ctx, &reviewerv1.ReassignPullRequestRequest{PullRequestId: "missing", OldUserId: "u2"})
+			return err
+		}, codes.NotFound, api.CodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireStatus(t, tt.call(), tt.code, tt.reason)
+		})
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	started := make(chan struct{})
+
+	// Every call blocks until the server cancels it.
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		close(started)
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	}))
+
+	listener := bufconn.Listen(1 << 20)
+	go srv.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, "/test.Blocking/Call")
+	if err != nil {
+		t.Fatalf("NewStream: %v", err)
+	}
+
+	err = stream.CloseSend()
+	if err != nil {
+		t.Fatalf("CloseSend: %v", err)
+	}
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	err = grpcapi.Shutdown(ctx, srv)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Shutdown took %s, want it bounded by the context", elapsed)
+	}
+
+	err = stream.RecvMsg(new(any))
+	if status.Code(err) != codes.Unavailable && status.Code(err) != codes.Canceled {
+		t.Errorf("blocked call ended with %v, want it cancelled", err)
+	}
+}
+
+func TestShutdownIdle(t *testing.T) {
+	srv := grpc.NewServer()
+
+	listener := bufconn.Listen(1 << 20)
+	go srv.Serve(listener)
+
+	err := grpcapi.Shutdown(context.Background(), srv)
+	if err != nil {
+		t.Errorf("Shutdown = %v, want nil without calls in flight", err)
+	}
+}
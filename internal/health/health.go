@@ -0,0 +1,94 @@
+// Package health answers the probes of the orchestrator and reports the build. Its routes are served
+// ahead of the API middlewares, so probes need no credentials and do not count against rate limits.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/buildinfo"
+)
+
+type Config struct {
+	// CheckTimeout bounds one readiness check of the storage.
+	CheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	// DrainDelay is how long /readyz fails after the shutdown signal before the server stops accepting connections.
+	DrainDelay time.Duration `env:"HEALTH_DRAIN_DELAY" env-default:"5s"`
+}
+
+// Checker is implemented by the storages that can be unready, the memory storage is always ready.
+type Checker interface {
+	Ready(ctx context.Context) error
+}
+
+type Prober struct {
+	cfg      *Config
+	checker  Checker
+	draining atomic.Bool
+	logger   *zap.Logger
+}
+
+// New takes a nil checker when the storage has nothing to check.
+func New(cfg *Config, checker Checker, logger *zap.Logger) *Prober {
+	return &Prober{cfg: cfg, checker: checker, logger: logger}
+}
+
+// Drain makes /readyz fail from now on, so that load balancers stop routing to this instance.
+func (p *Prober) Drain() {
+	p.draining.Store(true)
+	p.logger.Info("readiness switched off for shutdown", zap.Duration("drain_delay", p.cfg.DrainDelay))
+}
+
+// Register adds GET /healthz, /readyz and /version to mux.
+func (p *Prober) Register(mux *http.ServeMux) {
+	mux.Handle("GET /healthz", middleware.RequestID(http.HandlerFunc(p.healthz)))
+	mux.Handle("GET /readyz", middleware.RequestID(http.HandlerFunc(p.readyz)))
+	mux.Handle("GET /version", middleware.RequestID(http.HandlerFunc(p.version)))
+}
+
+// healthz only tells that the process serves requests, a failing storage must not get it restarted.
+func (p *Prober) healthz(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, api.HealthStatus{Status: "ok"})
+}
+
+func (p *Prober) readyz(w http.ResponseWriter, r *http.Request) {
+	if p.draining.Load() {
+		api.WriteProblem(w, r, p.logger, http.StatusServiceUnavailable, api.CodeNotReady, api.ErrShuttingDown)
+		return
+	}
+
+	if p.checker != nil {
+		ctx, cancel := context.WithTimeout(r.Context(), p.cfg.CheckTimeout)
+		defer cancel()
+
+		err := p.checker.Ready(ctx)
+		if err != nil {
+			p.logger.Warn("readyz: storage is not ready", zap.Error(err))
+			api.WriteProblem(w, r, p.logger, http.StatusServiceUnavailable, api.CodeNotReady, api.ErrNotReady)
+			return
+		}
+	}
+
+	p.writeJSON(w, api.HealthStatus{Status: "ok"})
+}
+
+func (p *Prober) version(w http.ResponseWriter, _ *http.Request) {
+	p.writeJSON(w, buildinfo.Get())
+}
+
+func (p *Prober) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		p.logger.Error("writeJSON: failed to encode response", zap.Error(err))
+	}
+}
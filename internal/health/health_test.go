@@ -0,0 +1,164 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/database"
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/buildinfo"
+	"reviewer-service/internal/health"
+)
+
+type checkerFunc func(ctx context.Context) error
+
+func (f checkerFunc) Ready(ctx context.Context) error {
+	return f(ctx)
+}
+
+// schemaAt checks like the postgres storage would against a schema at version.
+func schemaAt(version uint, dirty bool) health.Checker {
+	return checkerFunc(func(context.Context) error {
+		return database.CheckVersion(version, dirty)
+	})
+}
+
+func serve(t *testing.T, prober *health.Prober, path string) (*httptest.ResponseRecorder, map[string]any) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	prober.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var body map[string]any
+
+	err := json.Unmarshal(rec.Body.Bytes(), &body)
+	if err != nil {
+		t.Fatalf("GET %s: decode %q: %v", path, rec.Body.String(), err)
+	}
+
+	return rec, body
+}
+
+func TestReadyz(t *testing.T) {
+	latest, err := database.LatestVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blocked := checkerFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	tests := []struct {
+		name    string
+		checker health.Checker
+		drain   bool
+		status  int
+		detail  string
+	}{
+		{"NoChecker", nil, false, http.StatusOK, ""},
+		{"Migrated", schemaAt(latest, false), false, http.StatusOK, ""},
+		{"NewerSchema", schemaAt(latest+1, false), false, http.StatusOK, ""},
+		{"PendingMigrations", schemaAt(latest-1, false), false, http.StatusServiceUnavailable, api.ErrNotReady},
+		{"DirtySchema", schemaAt(latest, true), false, http.StatusServiceUnavailable, api.ErrNotReady},
+		{"StorageDown", checkerFunc(func(context.Context) error { return errors.New("connection refused") }), false,
+			http.StatusServiceUnavailable, api.ErrNotReady},
+		{"CheckTimesOut", blocked, false, http.StatusServiceUnavailable, api.ErrNotReady},
+		{"Draining", schemaAt(latest, false), true, http.StatusServiceUnavailable, api.ErrShuttingDown},
+		{"DrainingWithoutChecker", nil, true, http.StatusServiceUnavailable, api.ErrShuttingDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prober := health.New(&health.Config{CheckTimeout: 10 * time.Millisecond}, tt.checker, zap.NewNop())
+			if tt.drain {
+				prober.Drain()
+			}
+
+			rec, body := serve(t, prober, "/readyz")
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.status, rec.Body)
+			}
+
+			if tt.status == http.StatusOK {
+				if body["status"] != "ok" {
+					t.Errorf("body = %v, want status ok", body)
+				}
+				return
+			}
+
+			if body["code"] != api.CodeNotReady || body["detail"] != tt.detail {
+				t.Errorf("body = %v, want %s with %q", body, api.CodeNotReady, tt.detail)
+			}
+		})
+	}
+}
+
+func TestReadyzSkipsCheckWhileDraining(t *testing.T) {
+	called := false
+	prober := health.New(&health.Config{CheckTimeout: time.Second}, checkerFunc(func(context.Context) error {
+		called = true
+		return nil
+	}), zap.NewNop())
+
+	prober.Drain()
+	serve(t, prober, "/readyz")
+
+	if called {
+		t.Error("draining prober checked the storage")
+	}
+}
+
+// healthz stays up through storage failures and draining, so the instance is not restarted mid-shutdown.
+func TestHealthz(t *testing.T) {
+	prober := health.New(&health.Config{CheckTimeout: time.Second}, schemaAt(0, true), zap.NewNop())
+	prober.Drain()
+
+	rec, body := serve(t, prober, "/healthz")
+	if rec.Code != http.StatusOK || body["status"] != "ok" {
+		t.Errorf("healthz = %d %v, want 200 ok", rec.Code, body)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	version, commit, buildTime := buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime
+	t.Cleanup(func() { buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime = version, commit, buildTime })
+
+	buildinfo.Version, buildinfo.Commit, buildinfo.BuildTime = "1.4.0", "abc1234", "2026-10-18T12:00:00Z"
+
+	rec, body := serve(t, health.New(&health.Config{}, nil, zap.NewNop()), "/version")
+
+	want := map[string]any{
+		"version":    "1.4.0",
+		"commit":     "abc1234",
+		"build_time": "2026-10-18T12:00:00Z",
+		"go_version": runtime.Version(),
+	}
+
+	if rec.Code != http.StatusOK || len(body) != len(want) {
+		t.Fatalf("version = %d %v, want 200 %v", rec.Code, body, want)
+	}
+
+	for k, v := range want {
+		if body[k] != v {
+			t.Errorf("%s = %v, want %v", k, body[k], v)
+		}
+	}
+
+	if rec.Header().Get("Cache-Control") != "no-store" || rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("headers = %v, want uncached JSON", rec.Header())
+	}
+}
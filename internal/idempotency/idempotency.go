@@ -0,0 +1,241 @@
+// Package idempotency replays the stored response when a POST request is retried with the same
+// Idempotency-Key header, so that retries do not run the request again.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+const (
+	Header = "Idempotency-Key"
+	// ReplayedHeader marks a response that was replayed from storage.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	// sweepEvery bounds how often expired keys are deleted.
+	sweepEvery = time.Hour
+)
+
+type Config struct {
+	// TTL is how long a response is kept for replays.
+	TTL time.Duration `env:"IDEMPOTENCY_TTL" env-default:"24h"`
+}
+
+type Store interface {
+	SaveIdempotencyKey(ctx context.Context, key domain.IdempotencyKey) error
+	GetIdempotencyKey(ctx context.Context, scope string, key string) (*domain.IdempotencyKey, error)
+	CompleteIdempotencyKey(ctx context.Context, scope string, key string, statusCode int, contentType string, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, scope string, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+}
+
+type Keeper struct {
+	cfg    *Config
+	store  Store
+	logger *zap.Logger
+	// now is time.Now, tests replace it.
+	now func() time.Time
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+func New(cfg *Config, store Store, logger *zap.Logger) *Keeper {
+	return &Keeper{cfg: cfg, store: store, logger: logger, now: time.Now}
+}
+
+// Middleware must run after authentication and RealIP, keys are scoped to the caller.
+// Requests without the header and other methods than POST pass through.
+func (k *Keeper) Middleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(Header)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxKeyLength {
+			api.WriteProblem(w, r, k.logger, http.StatusBadRequest, api.CodeBadRequest, api.ErrIdempotencyKey)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			k.logger.Warn("Middleware: failed to read body", zap.Error(err))
+			api.WriteProblem(w, r, k.logger, http.StatusBadRequest, api.CodeBadRequest, api.ErrReadBody)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := k.now()
+		k.sweep(now)
+
+		stored := domain.IdempotencyKey{
+			Scope:       scope(r),
+			Key:         key,
+			RequestHash: requestHash(r, body),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(k.cfg.TTL),
+		}
+
+		err = k.store.SaveIdempotencyKey(r.Context(), stored)
+		if err != nil {
+			if errors.Is(err, repository.ErrDuplicateKey) {
+				k.replay(w, r, stored)
+				return
+			}
+
+			k.logger.Error("Middleware: failed to save idempotency key", zap.Error(err))
+			api.WriteProblem(w, r, k.logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+			return
+		}
+
+		k.record(w, r, next, stored)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// record runs the request and stores its response. Server errors release the key instead,
+// they are worth retrying.
+func (k *Keeper) record(w http.ResponseWriter, r *http.Request, next http.Handler, stored domain.IdempotencyKey) {
+	// The response must be settled even if the client went away.
+	ctx := context.WithoutCancel(r.Context())
+
+	ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+	buf := &bytes.Buffer{}
+	ww.Tee(buf)
+
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+
+		// The handler panicked or failed, let a retry run it again.
+		err := k.store.DeleteIdempotencyKey(ctx, stored.Scope, stored.Key)
+		if err != nil {
+			k.logger.Error("Middleware: failed to release idempotency key", zap.String("key", stored.Key), zap.Error(err))
+		}
+	}()
+
+	next.ServeHTTP(ww, r)
+
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	if status >= http.StatusInternalServerError {
+		return
+	}
+
+	err := k.store.CompleteIdempotencyKey(ctx, stored.Scope, stored.Key, status, ww.Header().Get("Content-Type"), buf.Bytes())
+	if err != nil {
+		k.logger.Error("Middleware: failed to store response", zap.String("key", stored.Key), zap.Error(err))
+		return
+	}
+
+	completed = true
+}
+
+// replay answers a repeated key with the stored response.
+func (k *Keeper) replay(w http.ResponseWriter, r *http.Request, request domain.IdempotencyKey) {
+	stored, err := k.store.GetIdempotencyKey(r.Context(), request.Scope, request.Key)
+	if err != nil {
+		// The first request failed and released the key in the meantime.
+		if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+			api.WriteProblem(w, r, k.logger, http.StatusConflict, api.CodeIdempotencyInProgress, api.ErrIdempotencyInProgress)
+			return
+		}
+
+		k.logger.Error("Middleware: failed to get idempotency key", zap.Error(err))
+		api.WriteProblem(w, r, k.logger, http.StatusInternalServerError, api.CodeInternal, api.ErrInternal)
+		return
+	}
+
+	if !bytes.Equal(stored.RequestHash, request.RequestHash) {
+		k.logger.Warn("Middleware: idempotency key reused with another request",
+			zap.String("key", request.Key), zap.String("path", r.URL.Path))
+		api.WriteProblem(w, r, k.logger, http.StatusUnprocessableEntity, api.CodeIdempotencyKeyReused, api.ErrIdempotencyKeyReused)
+		return
+	}
+
+	if stored.StatusCode == 0 {
+		api.WriteProblem(w, r, k.logger, http.StatusConflict, api.CodeIdempotencyInProgress, api.ErrIdempotencyInProgress)
+		return
+	}
+
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	w.Header().Set(ReplayedHeader, "true")
+	w.WriteHeader(stored.StatusCode)
+
+	_, err = w.Write(stored.Body)
+	if err != nil {
+		k.logger.Warn("Middleware: failed to write replayed response", zap.Error(err))
+	}
+}
+
+// scope keeps the keys of different callers apart. Without authentication the client address
+// stands in for the caller, so anonymous clients do not replay each other's responses.
+func scope(r *http.Request) string {
+	if actor := auth.Actor(r.Context()); actor != "" {
+		return actor
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return "ip:" + host
+}
+
+// sweep deletes expired keys in the background at most once per sweepEvery.
+func (k *Keeper) sweep(now time.Time) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if now.Sub(k.sweptAt) < sweepEvery {
+		return
+	}
+
+	k.sweptAt = now
+
+	go func() {
+		deleted, err := k.store.DeleteExpiredIdempotencyKeys(context.Background(), now)
+		if err != nil {
+			k.logger.Warn("Keeper: failed to delete expired idempotency keys", zap.Error(err))
+			return
+		}
+
+		k.logger.Debug("Keeper: deleted expired idempotency keys", zap.Int64("keys", deleted))
+	}()
+}
+
+// requestHash covers the route and the body, the same key sent to another endpoint is a different request.
+func requestHash(r *http.Request, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,286 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/repository/memory"
+)
+
+var testConfig = Config{TTL: time.Hour}
+
+// counter answers 201 with the number of requests it ran, failing ones answer 500.
+type counter struct {
+	calls atomic.Int32
+	fail  atomic.Bool
+}
+
+func (c *counter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	n := c.calls.Add(1)
+
+	if c.fail.Load() {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"call":%d}`, n)
+}
+
+func newKeeper(t *testing.T) (*Keeper, *time.Time) {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keeper := New(&testConfig, repo, zap.NewNop())
+	keeper.now = func() time.Time { return now }
+
+	return keeper, &now
+}
+
+type request struct {
+	path     string
+	body     string
+	key      string
+	addr     string
+	identity *auth.Identity
+}
+
+func (r request) serve(handler http.Handler) *httptest.ResponseRecorder {
+	path := r.path
+	if path == "" {
+		path = "/pullRequest/create"
+	}
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(r.body))
+	req.RemoteAddr = r.addr
+	if req.RemoteAddr == "" {
+		req.RemoteAddr = "192.0.2.1:1234"
+	}
+
+	if r.key != "" {
+		req.Header.Set(Header, r.key)
+	}
+
+	if r.identity != nil {
+		req = req.WithContext(auth.WithIdentity(req.Context(), *r.identity))
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func problemCode(t *testing.T, rec *httptest.ResponseRecorder) api.ProblemCode {
+	t.Helper()
+
+	var problem api.Problem
+	err := json.Unmarshal(rec.Body.Bytes(), &problem)
+	if err != nil {
+		t.Fatalf("decode problem %q: %v", rec.Body, err)
+	}
+
+	return problem.Code
+}
+
+func TestReplay(t *testing.T) {
+	keeper, _ := newKeeper(t)
+	next := &counter{}
+	handler := keeper.Middleware(next)
+
+	req := request{body: `{"pull_request_id":"pr-1"}`, key: "k1"}
+
+	first := req.serve(handler)
+	second := req.serve(handler)
+
+	if next.calls.Load() != 1 {
+		t.Fatalf("handler ran %d times, want once", next.calls.Load())
+	}
+
+	if first.Header().Get(ReplayedHeader) != "" {
+		t.Errorf("first response is marked as replayed")
+	}
+
+	if second.Code != http.StatusCreated || second.Body.String() != `{"call":1}` ||
+		second.Header().Get("Content-Type") != "application/json" || second.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("replay = %d %q %v, want the stored 201 response marked as replayed", second.Code, second.Body, second.Header())
+	}
+}
+
+func TestPassThrough(t *testing.T) {
+	keeper, _ := newKeeper(t)
+	next := &counter{}
+	handler := keeper.Middleware(next)
+
+	// Without a key every request runs.
+	request{}.serve(handler)
+	request{}.serve(handler)
+
+	if next.calls.Load() != 2 {
+		t.Errorf("handler ran %d times, want twice", next.calls.Load())
+	}
+
+	rec := request{key: strings.Repeat("k", maxKeyLength+1)}.serve(handler)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("long key: status = %d, want 400", rec.Code)
+	}
+}
+
+func TestKeyReused(t *testing.T) {
+	tests := []struct {
+		name  string
+		other request
+	}{
+		{"OtherBody", request{body: `{"pull_request_id":"pr-2"}`, key: "k1"}},
+		{"OtherPath", request{path: "/v2/pull-requests", body: `{"pull_request_id":"pr-1"}`, key: "k1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper, _ := newKeeper(t)
+			next := &counter{}
+			handler := keeper.Middleware(next)
+
+			request{body: `{"pull_request_id":"pr-1"}`, key: "k1"}.serve(handler)
+			rec := tt.other.serve(handler)
+
+			if rec.Code != http.StatusUnprocessableEntity || problemCode(t, rec) != api.CodeIdempotencyKeyReused {
+				t.Errorf("status = %d, body %s, want 422 %s", rec.Code, rec.Body, api.CodeIdempotencyKeyReused)
+			}
+
+			if next.calls.Load() != 1 {
+				t.Errorf("handler ran %d times, want once", next.calls.Load())
+			}
+		})
+	}
+}
+
+func TestInFlight(t *testing.T) {
+	keeper, _ := newKeeper(t)
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	next := &counter{}
+	handler := keeper.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		next.ServeHTTP(w, r)
+	}))
+
+	req := request{body: `{"pull_request_id":"pr-1"}`, key: "k1"}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		req.serve(handler)
+	}()
+
+	<-entered
+	rec := req.serve(handler)
+	close(release)
+	wg.Wait()
+
+	if rec.Code != http.StatusConflict || problemCode(t, rec) != api.CodeIdempotencyInProgress {
+		t.Errorf("status = %d, body %s, want 409 %s", rec.Code, rec.Body, api.CodeIdempotencyInProgress)
+	}
+
+	// Once the first request is done, its response is replayed.
+	rec = req.serve(keeper.Middleware(next))
+	if rec.Code != http.StatusCreated || rec.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("after completion: status = %d, replayed = %q, want the stored response", rec.Code, rec.Header().Get(ReplayedHeader))
+	}
+}
+
+func TestServerErrorReleasesKey(t *testing.T) {
+	keeper, _ := newKeeper(t)
+	next := &counter{}
+	handler := keeper.Middleware(next)
+
+	req := request{body: `{"pull_request_id":"pr-1"}`, key: "k1"}
+
+	next.fail.Store(true)
+	req.serve(handler)
+
+	next.fail.Store(false)
+	rec := req.serve(handler)
+
+	if rec.Code != http.StatusCreated || next.calls.Load() != 2 {
+		t.Errorf("retry: status = %d after %d calls, want the request run again", rec.Code, next.calls.Load())
+	}
+}
+
+func TestScope(t *testing.T) {
+	alice := &auth.Identity{KeyID: "key-alice"}
+	bob := &auth.Identity{KeyID: "key-bob"}
+
+	tests := []struct {
+		name       string
+		first      request
+		second     request
+		wantCalls  int32
+		wantReplay bool
+	}{
+		{"SameAddressOtherPort", request{addr: "192.0.2.1:1"}, request{addr: "192.0.2.1:2"}, 1, true},
+		{"OtherAddress", request{addr: "192.0.2.1:1"}, request{addr: "192.0.2.2:1"}, 2, false},
+		{"SameActorOtherAddress", request{addr: "192.0.2.1:1", identity: alice}, request{addr: "192.0.2.2:1", identity: alice}, 1, true},
+		{"OtherActorSameAddress", request{addr: "192.0.2.1:1", identity: alice}, request{addr: "192.0.2.1:1", identity: bob}, 2, false},
+		{"AnonymousAfterActor", request{addr: "192.0.2.1:1", identity: alice}, request{addr: "192.0.2.1:1"}, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper, _ := newKeeper(t)
+			next := &counter{}
+			handler := keeper.Middleware(next)
+
+			tt.first.key, tt.second.key = "k1", "k1"
+			tt.first.serve(handler)
+			rec := tt.second.serve(handler)
+
+			if next.calls.Load() != tt.wantCalls {
+				t.Errorf("handler ran %d times, want %d", next.calls.Load(), tt.wantCalls)
+			}
+
+			if replayed := rec.Header().Get(ReplayedHeader) == "true"; replayed != tt.wantReplay {
+				t.Errorf("replayed = %v, want %v", replayed, tt.wantReplay)
+			}
+		})
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	keeper, now := newKeeper(t)
+	next := &counter{}
+	handler := keeper.Middleware(next)
+
+	req := request{body: `{"pull_request_id":"pr-1"}`, key: "k1"}
+	req.serve(handler)
+
+	*now = now.Add(testConfig.TTL - time.Second)
+	rec := req.serve(handler)
+	if rec.Header().Get(ReplayedHeader) != "true" {
+		t.Errorf("before expiry: response was not replayed")
+	}
+
+	*now = now.Add(2 * time.Second)
+	rec = req.serve(handler)
+	if rec.Header().Get(ReplayedHeader) != "" || rec.Body.String() != `{"call":2}` {
+		t.Errorf("after expiry: got %q, want the request run again", rec.Body)
+	}
+}
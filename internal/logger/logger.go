@@ -8,6 +8,8 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"reviewer-service/internal/auth"
 )
 
 type Config struct {
@@ -52,12 +54,15 @@ func MiddlewareLogger(logger *zap.Logger, cfg *Config) func(http.Handler) http.H
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			entry := logger.With()
 			start := time.Now()
+			identity, _ := auth.IdentityFromContext(r.Context())
 
 			switch cfg.Env {
 			case "dev":
 				entry = logger.With(
 					zap.String("method", r.Method),
 					zap.String("path", r.URL.Path),
+					zap.String("api_key_id", identity.KeyID),
+					zap.String("user_id", identity.UserID),
 				)
 
 				entry.Info("new request")
@@ -69,6 +74,8 @@ func MiddlewareLogger(logger *zap.Logger, cfg *Config) func(http.Handler) http.H
 					zap.String("remote_addr", r.RemoteAddr),
 					zap.String("user_agent", r.UserAgent()),
 					zap.String("request_id", middleware.GetReqID(r.Context())),
+					zap.String("api_key_id", identity.KeyID),
+					zap.String("user_id", identity.UserID),
 					zap.Time("time", time.Now()),
 				)
 
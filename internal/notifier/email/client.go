@@ -0,0 +1,230 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/notifier"
+)
+
+func New(config *Config, users UserProvider, logger *zap.Logger) (*Client, error) {
+	defaults, err := loadDefaults()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load default templates: %w", err)
+	}
+
+	overrides, err := loadOverrides(config.TemplatesDir, defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		addr:      net.JoinHostPort(config.Host, strconv.Itoa(config.Port)),
+		username:  config.Username,
+		password:  config.Password,
+		host:      config.Host,
+		from:      config.From,
+		timeout:   config.Timeout,
+		users:     users,
+		defaults:  defaults,
+		overrides: overrides,
+		logger:    logger,
+	}, nil
+}
+
+func (c *Client) Notify(ctx context.Context, event notifier.Event) error {
+	name, ok := templateNames[event.Type]
+	if !ok {
+		return nil
+	}
+
+	author, err := c.users.GetUser(ctx, event.PullRequest.AuthorId)
+	if err != nil {
+		c.logger.Error("failed to get pull request author", zap.String("user_id", event.PullRequest.AuthorId), zap.Error(err))
+		return fmt.Errorf("failed to get pull request author: %w", err)
+	}
+
+	templates := c.defaults
+	if set, ok := c.overrides[author.TeamName]; ok {
+		templates = set
+	}
+
+	var errs []error
+	for _, reviewerID := range event.Reviewers {
+		reviewer, err := c.users.GetUser(ctx, reviewerID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get reviewer: %s: %w", reviewerID, err))
+			continue
+		}
+
+		if reviewer.Email == "" {
+			c.logger.Debug("reviewer has no email", zap.String("user_id", reviewerID))
+			continue
+		}
+
+		data := templateData{
+			PullRequest:    event.PullRequest,
+			Reviewer:       *reviewer,
+			Author:         *author,
+			ReplacedUserID: event.ReplacedUserID,
+		}
+
+		msg, err := templates.render(name, c.from, reviewer.Email, data)
+		if err != nil {
+			c.logger.Error("failed to render email", zap.String("template", name), zap.Error(err))
+			errs = append(errs, fmt.Errorf("failed to render email: %w", err))
+			continue
+		}
+
+		err = c.send(ctx, reviewer.Email, msg)
+		if err != nil {
+			c.logger.Error("failed to send email", zap.String("user_id", reviewerID), zap.Error(err))
+			errs = append(errs, fmt.Errorf("failed to send email: %s: %w", reviewerID, err))
+			continue
+		}
+
+		c.logger.Info("successfully sent email",
+			zap.String("user_id", reviewerID),
+			zap.String("pull_request_id", event.PullRequest.PullRequestId),
+		)
+	}
+
+	return errors.Join(errs...)
+}
+
+// send is smtp.SendMail with a deadline: the session ends at the earlier of ctx's deadline
+// and Timeout, and as soon as ctx is canceled.
+func (c *Client) send(ctx context.Context, to string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: c.host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.username != "" {
+		err = client.Auth(smtp.PlainAuth("", c.username, c.password, c.host))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(c.from)
+	if err != nil {
+		return err
+	}
+
+	err = client.Rcpt(to)
+	if err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s *templateSet) render(name string, from string, to string, data templateData) ([]byte, error) {
+	var subject, text, html bytes.Buffer
+
+	err := s.text[name].ExecuteTemplate(&subject, "subject", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.text[name].ExecuteTemplate(&text, "body", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.html[name].Execute(&html, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	body := multipart.NewWriter(&msg)
+
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject.String()))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", body.Boundary())
+
+	parts := []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", text.Bytes()},
+		{"text/html; charset=utf-8", html.Bytes()},
+	}
+
+	for _, p := range parts {
+		part, err := body.CreatePart(textproto.MIMEHeader{"Content-Type": {p.contentType}})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = part.Write(p.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,343 @@
+package email
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+)
+
+type users map[string]domain.User
+
+func (u users) GetUser(_ context.Context, userID string) (*domain.User, error) {
+	user, ok := u[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return &user, nil
+}
+
+var testUsers = users{
+	"u1": {UserID: "u1", UserName: "Alice", TeamName: "backend"},
+	"u2": {UserID: "u2", UserName: "Bob", Email: "bob@example.com", TeamName: "backend"},
+	"u3": {UserID: "u3", UserName: "Carol", TeamName: "backend"},
+}
+
+type envelope struct {
+	from string
+	to   []string
+	data string
+}
+
+// smtpStub is a plaintext SMTP server that accepts every message, or never greets when hang is set.
+type smtpStub struct {
+	listener net.Listener
+	messages chan envelope
+	hang     bool
+}
+
+func newSMTPStub(t *testing.T, hang bool) *smtpStub {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	s := &smtpStub{listener: listener, messages: make(chan envelope, 10), hang: hang}
+	t.Cleanup(func() { listener.Close() })
+
+	go s.serve()
+
+	return s
+}
+
+func (s *smtpStub) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.session(conn)
+	}
+}
+
+func (s *smtpStub) session(conn net.Conn) {
+	defer conn.Close()
+
+	if s.hang {
+		io.Copy(io.Discard, conn)
+		return
+	}
+
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP stub")
+
+	var env envelope
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			env.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			env.to = append(env.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+			env.data = string(data)
+			s.messages <- env
+			env = envelope{}
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 unsupported")
+		}
+	}
+}
+
+func (s *smtpStub) config(timeout time.Duration) *Config {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return &Config{Host: host, Port: p, From: "reviewer@example.com", Timeout: timeout}
+}
+
+func newClient(t *testing.T, config *Config) *Client {
+	t.Helper()
+
+	c, err := New(config, testUsers, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	return c
+}
+
+func assigned(authorID string, reviewers ...string) notifier.Event {
+	return notifier.Event{
+		Type: notifier.EventAssigned,
+		PullRequest: domain.PullRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: "Add search",
+			AuthorId:        authorID,
+		},
+		Reviewers: reviewers,
+	}
+}
+
+// parsed is a delivered message split into its decoded subject and alternative parts.
+type parsed struct {
+	subject string
+	text    string
+	html    string
+}
+
+func parse(t *testing.T, data string) parsed {
+	t.Helper()
+
+	msg, err := mail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+
+	var p parsed
+	p.subject, err = new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("decode subject: %v", err)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("parse content type: %v", err)
+	}
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+
+		body, _ := io.ReadAll(part)
+		switch {
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/plain"):
+			p.text = string(body)
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/html"):
+			p.html = string(body)
+		}
+	}
+
+	return p
+}
+
+func receive(t *testing.T, s *smtpStub) envelope {
+	t.Helper()
+
+	select {
+	case env := <-s.messages:
+		return env
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message delivered")
+		return envelope{}
+	}
+}
+
+func TestNotifyDefaultTemplate(t *testing.T) {
+	stub := newSMTPStub(t, false)
+
+	err := newClient(t, stub.config(time.Second)).Notify(context.Background(), assigned("u1", "u2"))
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	env := receive(t, stub)
+	if env.from != "reviewer@example.com" || len(env.to) != 1 || env.to[0] != "bob@example.com" {
+		t.Errorf("envelope = %s -> %v, want reviewer@example.com -> [bob@example.com]", env.from, env.to)
+	}
+
+	msg := parse(t, env.data)
+	if msg.subject != "Review requested: Add search" {
+		t.Errorf("subject = %q, want %q", msg.subject, "Review requested: Add search")
+	}
+
+	if !strings.Contains(msg.text, `Alice asked you to review "Add search" (pr-1).`) {
+		t.Errorf("text = %q, want the default body", msg.text)
+	}
+
+	if !strings.Contains(msg.html, "<b>Add search</b>") {
+		t.Errorf("html = %q, want the default body", msg.html)
+	}
+}
+
+func TestNotifyTeamTemplate(t *testing.T) {
+	dir := t.TempDir()
+	err := os.Mkdir(filepath.Join(dir, "backend"), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the text template is overridden, html must fall back to the default.
+	override := `{{define "subject"}}[backend] {{.PullRequest.PullRequestId}}{{end}}{{define "body"}}Please review, {{.Reviewer.UserName}}.{{end}}`
+	err = os.WriteFile(filepath.Join(dir, "backend", "assigned.txt.tmpl"), []byte(override), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stub := newSMTPStub(t, false)
+	config := stub.config(time.Second)
+	config.TemplatesDir = dir
+
+	err = newClient(t, config).Notify(context.Background(), assigned("u1", "u2"))
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	msg := parse(t, receive(t, stub).data)
+	if msg.subject != "[backend] pr-1" {
+		t.Errorf("subject = %q, want %q", msg.subject, "[backend] pr-1")
+	}
+
+	if msg.text != "Please review, Bob." {
+		t.Errorf("text = %q, want %q", msg.text, "Please review, Bob.")
+	}
+
+	if !strings.Contains(msg.html, "<b>Add search</b>") {
+		t.Errorf("html = %q, want the default body", msg.html)
+	}
+}
+
+func TestNotifySkips(t *testing.T) {
+	stub := newSMTPStub(t, false)
+	c := newClient(t, stub.config(time.Second))
+
+	merged := assigned("u1", "u2")
+	merged.Type = notifier.EventMerged
+
+	tests := []struct {
+		name  string
+		event notifier.Event
+	}{
+		{"ReviewerWithoutEmail", assigned("u1", "u3")},
+		{"UntemplatedEvent", merged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.Notify(context.Background(), tt.event)
+			if err != nil {
+				t.Errorf("Notify: %v", err)
+			}
+		})
+	}
+
+	select {
+	case env := <-stub.messages:
+		t.Errorf("delivered %v, want nothing", env.to)
+	default:
+	}
+}
+
+func TestNotifyErrors(t *testing.T) {
+	hanging := newSMTPStub(t, true)
+	stub := newSMTPStub(t, false)
+
+	tests := []struct {
+		name   string
+		config *Config
+		event  notifier.Event
+		want   string
+	}{
+		{"Timeout", hanging.config(100 * time.Millisecond), assigned("u1", "u2"), "failed to send email: u2"},
+		{"UnknownAuthor", stub.config(time.Second), assigned("missing", "u2"), "failed to get pull request author"},
+		{"UnknownReviewer", stub.config(time.Second), assigned("u1", "missing"), "failed to get reviewer: missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+
+			err := newClient(t, tt.config).Notify(context.Background(), tt.event)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Notify error = %v, want one containing %q", err, tt.want)
+			}
+
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Notify took %v, want it bounded by the timeout", elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package email
+
+import (
+	"context"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+)
+
+type Config struct {
+	Host     string `env:"SMTP_HOST"`
+	Port     int    `env:"SMTP_PORT" env-default:"587"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM"`
+	// Timeout bounds a whole SMTP session, from dialing to QUIT.
+	Timeout time.Duration `env:"SMTP_TIMEOUT" env-default:"10s"`
+	// TemplatesDir holds per-team overrides: <dir>/<team_name>/<event>.txt.tmpl and <event>.html.tmpl.
+	TemplatesDir string `env:"SMTP_TEMPLATES_DIR"`
+}
+
+type UserProvider interface {
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+}
+
+type Client struct {
+	addr      string
+	username  string
+	password  string
+	host      string
+	from      string
+	timeout   time.Duration
+	users     UserProvider
+	defaults  *templateSet
+	overrides map[string]*templateSet
+	logger    *zap.Logger
+}
+
+// templateSet holds the templates of every event, the text templates define "subject" and "body".
+type templateSet struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+type templateData struct {
+	PullRequest    domain.PullRequest
+	Reviewer       domain.User
+	Author         domain.User
+	ReplacedUserID string
+}
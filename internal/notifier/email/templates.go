@@ -0,0 +1,121 @@
+package email
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	texttemplate "text/template"
+
+	"reviewer-service/internal/notifier"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+var templateNames = map[string]string{
+	notifier.EventAssigned:    "assigned",
+	notifier.EventReassigned:  "reassigned",
+	notifier.EventStaleReview: "stale_review",
+}
+
+func loadDefaults() (*templateSet, error) {
+	templates, err := fs.Sub(defaultTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	set := &templateSet{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	err = set.load(templates, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// loadOverrides reads one template set per team directory, templates missing there fall back to defaults.
+func loadOverrides(dir string, defaults *templateSet) (map[string]*templateSet, error) {
+	overrides := make(map[string]*templateSet)
+	if dir == "" {
+		return overrides, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates dir: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		set := defaults.clone()
+
+		err = set.load(os.DirFS(filepath.Join(dir, entry.Name())), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load templates for team %s: %w", entry.Name(), err)
+		}
+
+		overrides[entry.Name()] = set
+	}
+
+	return overrides, nil
+}
+
+func (s *templateSet) load(fsys fs.FS, optional bool) error {
+	for _, name := range templateNames {
+		textFile := name + ".txt.tmpl"
+		text, err := fs.ReadFile(fsys, textFile)
+		switch {
+		case err == nil:
+			s.text[name], err = texttemplate.New(textFile).Parse(string(text))
+			if err != nil {
+				return err
+			}
+
+		case !optional || !errors.Is(err, fs.ErrNotExist):
+			return err
+		}
+
+		htmlFile := name + ".html.tmpl"
+		html, err := fs.ReadFile(fsys, htmlFile)
+		switch {
+		case err == nil:
+			s.html[name], err = htmltemplate.New(htmlFile).Parse(string(html))
+			if err != nil {
+				return err
+			}
+
+		case !optional || !errors.Is(err, fs.ErrNotExist):
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *templateSet) clone() *templateSet {
+	set := &templateSet{
+		text: make(map[string]*texttemplate.Template, len(s.text)),
+		html: make(map[string]*htmltemplate.Template, len(s.html)),
+	}
+
+	for name, t := range s.text {
+		set.text[name] = t
+	}
+
+	for name, t := range s.html {
+		set.html[name] = t
+	}
+
+	return set
+}
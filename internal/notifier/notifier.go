@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+
+	"reviewer-service/internal/domain"
+)
+
+const (
+	EventAssigned    = "ASSIGNED"
+	EventReassigned  = "REASSIGNED"
+	EventMerged      = "MERGED"
+	EventStaleReview = "STALE_REVIEW"
+)
+
+type Event struct {
+	Type        string
+	PullRequest domain.PullRequest
+	// Reviewers holds the user ids that were newly assigned to the pull request,
+	// or the user ids that still have to review it for EventStaleReview.
+	Reviewers []string
+	// ReplacedUserID is set for EventReassigned only.
+	ReplacedUserID string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// Multi fans an event out to every notifier and joins their errors.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, event Event) error {
+	var errs []error
+
+	for _, n := range m {
+		err := n.Notify(ctx, event)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
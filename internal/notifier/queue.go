@@ -0,0 +1,100 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrQueueFull   = errors.New("notification queue is full")
+	ErrQueueClosed = errors.New("notification queue is closed")
+)
+
+type QueueConfig struct {
+	// Size is how many events may wait for delivery, events beyond it are dropped.
+	Size    int `env:"NOTIFY_QUEUE_SIZE" env-default:"1000"`
+	Workers int `env:"NOTIFY_WORKERS" env-default:"4"`
+	// Timeout bounds the delivery of one event to every wrapped notifier.
+	Timeout time.Duration `env:"NOTIFY_TIMEOUT" env-default:"30s"`
+}
+
+// Queue hands events to next from background workers, so that slow mail servers and webhooks
+// do not hold up requests. Notify never blocks, it fails with ErrQueueFull instead.
+type Queue struct {
+	next    Notifier
+	events  chan Event
+	timeout time.Duration
+	logger  *zap.Logger
+
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewQueue(cfg *QueueConfig, next Notifier, logger *zap.Logger) *Queue {
+	q := &Queue{
+		next:    next,
+		events:  make(chan Event, cfg.Size),
+		timeout: cfg.Timeout,
+		logger:  logger,
+	}
+
+	for range max(cfg.Workers, 1) {
+		q.wg.Add(1)
+		go q.work()
+	}
+
+	return q
+}
+
+// Notify queues the event, delivery does not depend on ctx, which usually ends with the request.
+func (q *Queue) Notify(_ context.Context, event Event) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.events <- event:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting events and waits until the queued ones are delivered.
+func (q *Queue) Close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.events)
+	}
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+func (q *Queue) work() {
+	defer q.wg.Done()
+
+	for event := range q.events {
+		ctx, cancel := context.WithTimeout(context.Background(), q.timeout)
+
+		err := q.next.Notify(ctx, event)
+		if err != nil {
+			q.logger.Warn("Queue: failed to deliver notification",
+				zap.String("type", event.Type),
+				zap.String("pull_request_id", event.PullRequest.PullRequestId),
+				zap.Error(err),
+			)
+		}
+
+		cancel()
+	}
+}
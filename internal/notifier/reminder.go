@@ -0,0 +1,109 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+)
+
+// reminderLockKey is the advisory lock replicas take before sending reminders, "reviewer" in ASCII.
+const reminderLockKey int64 = 0x7265766965776572
+
+type ReminderConfig struct {
+	// Interval between stale review checks, zero disables reminders.
+	Interval time.Duration `env:"REMINDER_INTERVAL" env-default:"0s"`
+	// StaleAfter is both the age of a stale pull request and how often its reviewers are reminded.
+	StaleAfter time.Duration `env:"REMINDER_STALE_AFTER" env-default:"48h"`
+}
+
+type ReminderStore interface {
+	GetStalePRs(ctx context.Context, createdBefore time.Time) ([]domain.PullRequest, error)
+	ClaimReminders(ctx context.Context, prID string, userIDs []string, remindedBefore time.Time, remindedAt time.Time) ([]string, error)
+}
+
+// Locker is implemented by storages shared between replicas, the replica holding the lock
+// sends the reminders of a round.
+type Locker interface {
+	TryLock(ctx context.Context, key int64) (unlock func(), ok bool, err error)
+}
+
+// RunReminder sends EventStaleReview once per Interval until ctx is done, to the reviewers of every
+// open pull request older than StaleAfter who were not reminded about it within StaleAfter.
+func RunReminder(ctx context.Context, cfg *ReminderConfig, store ReminderStore, notify Notifier, logger *zap.Logger) {
+	if cfg.Interval <= 0 {
+		return
+	}
+
+	// Storages private to one process leave locker nil.
+	locker, _ := store.(Locker)
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if locker == nil {
+				remind(ctx, cfg, store, notify, logger)
+				continue
+			}
+
+			unlock, ok, err := locker.TryLock(ctx, reminderLockKey)
+			if err != nil {
+				logger.Error("RunReminder: failed to take reminder lock", zap.Error(err))
+				continue
+			}
+
+			if !ok {
+				logger.Debug("RunReminder: another replica is sending reminders")
+				continue
+			}
+
+			remind(ctx, cfg, store, notify, logger)
+			unlock()
+		}
+	}
+}
+
+func remind(ctx context.Context, cfg *ReminderConfig, store ReminderStore, notify Notifier, logger *zap.Logger) {
+	now := time.Now()
+
+	stale, err := store.GetStalePRs(ctx, now.Add(-cfg.StaleAfter))
+	if err != nil {
+		logger.Error("RunReminder: failed to get stale pull requests", zap.Error(err))
+		return
+	}
+
+	var sent int
+	for _, pr := range stale {
+		reviewers, err := store.ClaimReminders(ctx, pr.PullRequestId, pr.AssignedReviewers, now.Add(-cfg.StaleAfter), now)
+		if err != nil {
+			logger.Warn("RunReminder: failed to claim reminders", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+			continue
+		}
+
+		if len(reviewers) == 0 {
+			continue
+		}
+
+		err = notify.Notify(ctx, Event{
+			Type:        EventStaleReview,
+			PullRequest: pr,
+			Reviewers:   reviewers,
+		})
+		if err != nil {
+			logger.Warn("RunReminder: failed to notify reviewers", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+			continue
+		}
+
+		sent++
+	}
+
+	logger.Info("RunReminder: sent stale review reminders", zap.Int("prs", sent))
+}
@@ -0,0 +1,77 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/notifier"
+)
+
+func New(config *Config, users UserProvider, logger *zap.Logger) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: config.Timeout},
+		users:      users,
+		webhooks:   config.Webhooks,
+		handles:    config.Handles,
+		logger:     logger,
+	}
+}
+
+func (c *Client) Notify(ctx context.Context, event notifier.Event) error {
+	msg, ok := c.format(event)
+	if !ok {
+		return nil
+	}
+
+	author, err := c.users.GetUser(ctx, event.PullRequest.AuthorId)
+	if err != nil {
+		c.logger.Error("failed to get pull request author", zap.String("user_id", event.PullRequest.AuthorId), zap.Error(err))
+		return fmt.Errorf("failed to get pull request author: %w", err)
+	}
+
+	webhook, ok := c.webhooks[author.TeamName]
+	if !ok {
+		c.logger.Debug("no slack webhook configured", zap.String("team_name", author.TeamName))
+		return nil
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("failed to post slack message", zap.String("team_name", author.TeamName), zap.Error(err))
+		return fmt.Errorf("failed to post slack message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		c.logger.Error("slack webhook rejected message",
+			zap.String("team_name", author.TeamName),
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("slack webhook rejected message: status %d", resp.StatusCode)
+	}
+
+	c.logger.Info("successfully posted slack message",
+		zap.String("team_name", author.TeamName),
+		zap.String("pull_request_id", event.PullRequest.PullRequestId),
+	)
+	return nil
+}
@@ -0,0 +1,233 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+)
+
+type users map[string]domain.User
+
+func (u users) GetUser(_ context.Context, userID string) (*domain.User, error) {
+	user, ok := u[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return &user, nil
+}
+
+var testUsers = users{
+	"u1": {UserID: "u1", TeamName: "backend"},
+	"u2": {UserID: "u2", TeamName: "backend"},
+	"u9": {UserID: "u9", TeamName: "mobile"},
+}
+
+func newClient(webhook string, timeout time.Duration) *Client {
+	return New(&Config{
+		Webhooks: map[string]string{"backend": webhook},
+		Handles:  map[string]string{"u2": "U024BE7LH"},
+		Timeout:  timeout,
+	}, testUsers, zap.NewNop())
+}
+
+func assigned(authorID string) notifier.Event {
+	return notifier.Event{
+		Type: notifier.EventAssigned,
+		PullRequest: domain.PullRequest{
+			PullRequestId:   "pr-1",
+			PullRequestName: "Add search",
+			AuthorId:        authorID,
+		},
+		Reviewers: []string{"u2"},
+	}
+}
+
+func TestNotifyPostsMessage(t *testing.T) {
+	var got message
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+
+		err := json.NewDecoder(r.Body).Decode(&got)
+		if err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	err := newClient(srv.URL, time.Second).Notify(context.Background(), assigned("u1"))
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	want := "<@U024BE7LH>, you were assigned to review *Add search* (`pr-1`) by u1"
+	if got.Text != want {
+		t.Errorf("text = %q, want %q", got.Text, want)
+	}
+
+	if len(got.Blocks) != 1 || got.Blocks[0].Type != "section" || got.Blocks[0].Text == nil ||
+		got.Blocks[0].Text.Type != "mrkdwn" || got.Blocks[0].Text.Text != want {
+		t.Errorf("blocks = %+v, want one mrkdwn section with the text", got.Blocks)
+	}
+}
+
+func TestNotifyEscapesText(t *testing.T) {
+	var got message
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	event := assigned("u1")
+	event.Type = notifier.EventReassigned
+	event.PullRequest.PullRequestName = "Fix <!channel> & <https://evil.example|login>"
+	event.ReplacedUserID = "<@U0>"
+
+	err := newClient(srv.URL, time.Second).Notify(context.Background(), event)
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	want := "<@U024BE7LH>, you were assigned to review *Fix &lt;!channel&gt; &amp; &lt;https://evil.example|login&gt;* " +
+		"(`pr-1`) instead of &lt;@U0&gt;"
+	if got.Text != want {
+		t.Errorf("text = %q, want %q", got.Text, want)
+	}
+}
+
+func TestNotifyReassigned(t *testing.T) {
+	var got message
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	event := assigned("u1")
+	event.Type = notifier.EventReassigned
+	event.ReplacedUserID = "u3"
+
+	err := newClient(srv.URL, time.Second).Notify(context.Background(), event)
+	if err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	if !strings.HasSuffix(got.Text, "instead of u3") {
+		t.Errorf("text = %q, want it to name the replaced reviewer", got.Text)
+	}
+}
+
+func TestNotifySkips(t *testing.T) {
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer srv.Close()
+
+	merged := assigned("u1")
+	merged.Type = notifier.EventMerged
+
+	// The author is not looked up for events Slack does not announce.
+	mergedOfUnknown := assigned("missing")
+	mergedOfUnknown.Type = notifier.EventMerged
+
+	tests := []struct {
+		name  string
+		event notifier.Event
+	}{
+		{"TeamWithoutWebhook", assigned("u9")},
+		{"UnformattedEvent", merged},
+		{"UnformattedEventOfUnknownAuthor", mergedOfUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newClient(srv.URL, time.Second).Notify(context.Background(), tt.event)
+			if err != nil {
+				t.Errorf("Notify: %v", err)
+			}
+		})
+	}
+
+	if n := calls.Load(); n != 0 {
+		t.Errorf("webhook called %d times, want 0", n)
+	}
+}
+
+func TestNotifyErrors(t *testing.T) {
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid_payload", http.StatusBadRequest)
+	}))
+	defer rejecting.Close()
+
+	release := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer hanging.Close()
+	defer close(release)
+
+	tests := []struct {
+		name    string
+		webhook string
+		event   notifier.Event
+		want    string
+	}{
+		{"Rejected", rejecting.URL, assigned("u1"), "status 400"},
+		{"Timeout", hanging.URL, assigned("u1"), "failed to post slack message"},
+		{"UnknownAuthor", rejecting.URL, assigned("missing"), "failed to get pull request author"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+
+			err := newClient(tt.webhook, 100*time.Millisecond).Notify(context.Background(), tt.event)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Notify error = %v, want one containing %q", err, tt.want)
+			}
+
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Notify took %v, want it bounded by the timeout", elapsed)
+			}
+		})
+	}
+}
+
+func TestNotifyHonoursContext(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := newClient(srv.URL, time.Minute).Notify(ctx, assigned("u1"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Notify error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
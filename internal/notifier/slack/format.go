@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"reviewer-service/internal/notifier"
+)
+
+func (c *Client) format(event notifier.Event) (message, bool) {
+	pr := event.PullRequest
+
+	var text string
+	switch event.Type {
+	case notifier.EventAssigned:
+		text = fmt.Sprintf("%s, you were assigned to review *%s* (`%s`) by %s",
+			c.mentions(event.Reviewers), escape(pr.PullRequestName), escape(pr.PullRequestId), c.mention(pr.AuthorId))
+
+	case notifier.EventReassigned:
+		text = fmt.Sprintf("%s, you were assigned to review *%s* (`%s`) instead of %s",
+			c.mentions(event.Reviewers), escape(pr.PullRequestName), escape(pr.PullRequestId), c.mention(event.ReplacedUserID))
+
+	default:
+		return message{}, false
+	}
+
+	return message{
+		Text: text,
+		Blocks: []block{
+			{
+				Type: "section",
+				Text: &blockText{Type: "mrkdwn", Text: text},
+			},
+		},
+	}, true
+}
+
+func (c *Client) mentions(userIDs []string) string {
+	mentions := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		mentions[i] = c.mention(id)
+	}
+
+	return strings.Join(mentions, ", ")
+}
+
+// mention renders a Slack mention when the user has a known handle and falls back to the plain user id.
+func (c *Client) mention(userID string) string {
+	if handle, ok := c.handles[userID]; ok {
+		return fmt.Sprintf("<@%s>", handle)
+	}
+
+	return escape(userID)
+}
+
+// escaper encodes the characters Slack treats as control characters in mrkdwn, so that user text cannot
+// inject mentions or links.
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escape(text string) string {
+	return escaper.Replace(text)
+}
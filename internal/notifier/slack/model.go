@@ -0,0 +1,46 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+)
+
+type Config struct {
+	// Webhooks maps a team name to its incoming-webhook URL: "backend:https://hooks.slack.com/...".
+	Webhooks map[string]string `env:"SLACK_WEBHOOKS"`
+	// Handles maps a user id to a Slack member id used for mentions: "u1:U024BE7LH".
+	Handles map[string]string `env:"SLACK_HANDLES"`
+	Timeout time.Duration     `env:"SLACK_TIMEOUT" env-default:"3s"`
+}
+
+type UserProvider interface {
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+}
+
+type Client struct {
+	httpClient *http.Client
+	users      UserProvider
+	webhooks   map[string]string
+	handles    map[string]string
+	logger     *zap.Logger
+}
+
+type message struct {
+	Text   string  `json:"text"`
+	Blocks []block `json:"blocks"`
+}
+
+type block struct {
+	Type string     `json:"type"`
+	Text *blockText `json:"text,omitempty"`
+}
+
+type blockText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepEvery bounds how often idle buckets are dropped.
+const sweepEvery = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is when the bucket refills completely, after that it can be forgotten.
+	fullAt time.Time
+}
+
+// Memory keeps the buckets of a single instance.
+type Memory struct {
+	// now is time.Now, tests replace it.
+	now func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{now: time.Now, buckets: make(map[string]*bucket)}
+}
+
+func (m *Memory) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updatedAt: now}
+		m.buckets[key] = b
+	}
+
+	tokens, result := take(b.tokens, b.updatedAt, now, limit)
+	b.tokens = tokens
+	b.updatedAt = now
+	b.fullAt = now.Add(result.Reset)
+
+	return result, nil
+}
+
+// sweep drops full buckets, a new bucket starts full anyway. It must be called with mu held.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.sweptAt) < sweepEvery {
+		return
+	}
+
+	m.sweptAt = now
+	for key, b := range m.buckets {
+		if !now.Before(b.fullAt) {
+			delete(m.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+)
+
+const (
+	// defaultRoute names the bucket shared by the routes without a limit of their own.
+	defaultRoute = "*"
+	// ipRoute names the bucket IPMiddleware takes from, it is separate from the per-route ones.
+	ipRoute = "ip"
+)
+
+// Throttler applies the configured limits per client and route.
+type Throttler struct {
+	enabled  bool
+	limiter  Limiter
+	fallback *Limit
+	ip       *Limit
+	routes   map[string]Limit
+	logger   *zap.Logger
+}
+
+// New builds the limiter chosen by cfg.Backend, store is only needed for the postgres backend.
+func New(cfg *Config, store Store, logger *zap.Logger) (*Throttler, error) {
+	t := &Throttler{enabled: cfg.Enabled, routes: make(map[string]Limit, len(cfg.Routes)), logger: logger}
+	if !cfg.Enabled {
+		return t, nil
+	}
+
+	var longest time.Duration
+
+	if cfg.Default != "" {
+		limit, err := ParseLimit(cfg.Default)
+		if err != nil {
+			return nil, fmt.Errorf("RATE_LIMIT_DEFAULT: %w", err)
+		}
+
+		t.fallback = &limit
+		longest = limit.Period
+	}
+
+	if cfg.IP != "" {
+		limit, err := ParseLimit(cfg.IP)
+		if err != nil {
+			return nil, fmt.Errorf("RATE_LIMIT_IP: %w", err)
+		}
+
+		t.ip = &limit
+		longest = max(longest, limit.Period)
+	}
+
+	for route, value := range cfg.Routes {
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("RATE_LIMIT_ROUTES %s: %w", route, err)
+		}
+
+		t.routes[route] = limit
+		longest = max(longest, limit.Period)
+	}
+
+	switch cfg.Backend {
+	case BackendMemory:
+		t.limiter = NewMemory()
+
+	case BackendPostgres:
+		if store == nil {
+			return nil, fmt.Errorf("rate limit backend postgres needs postgres storage")
+		}
+
+		t.limiter = NewShared(store, longest, logger)
+
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend: %s", cfg.Backend)
+	}
+
+	return t, nil
+}
+
+// Decision is the outcome for one request, Limit is zero when no limit applies to the route.
+type Decision struct {
+	Limit Limit
+	Result
+}
+
+// Allow takes a token from the bucket of client on route. Routes without a limit of their own
+// share the default one, without a default they are not limited.
+func (t *Throttler) Allow(ctx context.Context, client string, route string) (Decision, error) {
+	unlimited := Decision{Result: Result{Allowed: true}}
+	if !t.enabled {
+		return unlimited, nil
+	}
+
+	limit, ok := t.routes[route]
+	if !ok {
+		if t.fallback == nil {
+			return unlimited, nil
+		}
+
+		route, limit = defaultRoute, *t.fallback
+	}
+
+	return t.take(ctx, client+" "+route, limit)
+}
+
+// AllowIP takes a token from the bucket of the host of remoteAddr, whoever the caller claims to be.
+func (t *Throttler) AllowIP(ctx context.Context, remoteAddr string) (Decision, error) {
+	if !t.enabled || t.ip == nil {
+		return Decision{Result: Result{Allowed: true}}, nil
+	}
+
+	return t.take(ctx, hostKey(remoteAddr)+" "+ipRoute, *t.ip)
+}
+
+func (t *Throttler) take(ctx context.Context, key string, limit Limit) (Decision, error) {
+	result, err := t.limiter.Allow(ctx, key, limit)
+	if err != nil {
+		return Decision{Result: Result{Allowed: true}}, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+
+	return Decision{Limit: limit, Result: result}, nil
+}
+
+// IPMiddleware must run after RealIP and before authentication, it answers 429 before
+// credentials are checked.
+func (t *Throttler) IPMiddleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		decision, err := t.AllowIP(r.Context(), r.RemoteAddr)
+		if err != nil {
+			t.logger.Error("IPMiddleware: failed to check rate limit", zap.String("addr", r.RemoteAddr), zap.Error(err))
+		}
+
+		if !decision.Allowed {
+			t.logger.Warn("IPMiddleware: rate limit exceeded",
+				zap.String("addr", r.RemoteAddr),
+				zap.String("path", r.URL.Path),
+				zap.Stringer("limit", decision.Limit),
+			)
+			w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+			api.WriteProblem(w, r, t.logger, http.StatusTooManyRequests, api.CodeRateLimited, api.ErrRateLimited)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// Middleware must run after authentication: API keys and users are limited wherever they call
+// from, anonymous callers are told apart by RealIP.
+func (t *Throttler) Middleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		client := ClientKey(r.Context(), r.RemoteAddr)
+
+		decision, err := t.Allow(r.Context(), client, routePattern(r))
+		if err != nil {
+			// A broken limiter must not take the API down with it.
+			t.logger.Error("Middleware: failed to check rate limit", zap.String("client", client), zap.Error(err))
+		}
+
+		limit := decision.Limit
+		if limit.Requests == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header := w.Header()
+		header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Requests, ceilSeconds(limit.Period)))
+		header.Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.Reset)))
+
+		if !decision.Allowed {
+			t.logger.Warn("Middleware: rate limit exceeded",
+				zap.String("client", client),
+				zap.String("path", r.URL.Path),
+				zap.Stringer("limit", limit),
+			)
+			header.Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+			api.WriteProblem(w, r, t.logger, http.StatusTooManyRequests, api.CodeRateLimited, api.ErrRateLimited)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// ClientKey names the bucket owner: the actor when the caller is authenticated, otherwise the
+// host of remoteAddr.
+func ClientKey(ctx context.Context, remoteAddr string) string {
+	if actor := auth.Actor(ctx); actor != "" {
+		return actor
+	}
+
+	return hostKey(remoteAddr)
+}
+
+func hostKey(remoteAddr string) string {
+	// RemoteAddr keeps the port unless RealIP replaced it.
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	return "ip:" + host
+}
+
+// routePattern returns the chi pattern the request will be routed to, such as
+// /v2/pull-requests/{pull_request_id}/merge, so that a route shares one limit whatever its
+// parameters. Middleware runs before routing, hence the lookup. Unknown paths get the default limit.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return defaultRoute
+	}
+
+	pattern := rctx.Routes.Find(chi.NewRouteContext(), r.Method, r.URL.Path)
+	if pattern == "" {
+		return defaultRoute
+	}
+
+	return pattern
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
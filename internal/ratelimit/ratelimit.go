@@ -0,0 +1,107 @@
+// Package ratelimit limits how often a single client may call the HTTP API.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+type Config struct {
+	Enabled bool `env:"RATE_LIMIT_ENABLED" env-default:"false"`
+	// Backend keeps the buckets in memory or in postgres, postgres shares them between instances.
+	Backend string `env:"RATE_LIMIT_BACKEND" env-default:"memory"`
+	// Default applies to every route missing from Routes, "requests/period": "300/1m". Empty means no limit.
+	Default string `env:"RATE_LIMIT_DEFAULT" env-default:"300/1m"`
+	// Routes maps a route pattern to its own limit: "/pullRequest/create:30/1m,/v2/pull-requests/{pull_request_id}/merge:60/1m".
+	Routes map[string]string `env:"RATE_LIMIT_ROUTES"`
+	// IP limits every request by client address before authentication, so that requests with
+	// bad credentials are counted too. Empty means no limit.
+	IP string `env:"RATE_LIMIT_IP" env-default:"600/1m"`
+}
+
+// Limit allows Requests per Period with bursts of up to Requests.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit reads "requests/period", the period is a Go duration.
+func ParseLimit(s string) (Limit, error) {
+	requests, period, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid limit %q: want requests/period", s)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q: requests must be a positive integer", s)
+	}
+
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid limit %q: period must be a positive duration", s)
+	}
+
+	return Limit{Requests: n, Period: d}, nil
+}
+
+func (l Limit) String() string {
+	return fmt.Sprintf("%d/%s", l.Requests, l.Period)
+}
+
+// perSecond is how fast the bucket refills.
+func (l Limit) perSecond() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result describes the bucket after a request, it is what the RateLimit-* headers report.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is the wait until the next request would be allowed, zero when Allowed.
+	RetryAfter time.Duration
+	// Reset is the wait until the bucket is full again.
+	Reset time.Duration
+}
+
+// Limiter takes one token from the bucket of key.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// take refills a bucket that held tokens at updatedAt and takes one token if there is one.
+// Both limiters share it, so they behave the same.
+func take(tokens float64, updatedAt time.Time, now time.Time, limit Limit) (float64, Result) {
+	capacity := float64(limit.Requests)
+	rate := limit.perSecond()
+
+	// Clocks of different instances may disagree a little, time never runs backwards for a bucket.
+	elapsed := max(0, now.Sub(updatedAt).Seconds())
+	tokens = math.Min(capacity, tokens+elapsed*rate)
+
+	var result Result
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - tokens) / rate)
+	}
+
+	result.Remaining = int(tokens)
+	result.Reset = seconds((capacity - tokens) / rate)
+
+	return tokens, result
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,345 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/auth"
+)
+
+// clock is a time source the tests move by hand.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newClock() *clock {
+	return &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// newThrottler builds a memory throttler whose buckets run on c.
+func newThrottler(t *testing.T, cfg Config, c *clock) *Throttler {
+	t.Helper()
+
+	cfg.Enabled = true
+	cfg.Backend = BackendMemory
+
+	throttler, err := New(&cfg, nil, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	throttler.limiter.(*Memory).now = c.Now
+	return throttler
+}
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Limit
+		wantErr bool
+	}{
+		{"300/1m", Limit{Requests: 300, Period: time.Minute}, false},
+		{" 5/10s ", Limit{Requests: 5, Period: 10 * time.Second}, false},
+		{"300", Limit{}, true},
+		{"0/1m", Limit{}, true},
+		{"x/1m", Limit{}, true},
+		{"5/0s", Limit{}, true},
+		{"5/soon", Limit{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseLimit(%q) = %v, %v, want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestMemoryBucket(t *testing.T) {
+	limit := Limit{Requests: 2, Period: time.Second}
+
+	// Steps run in order against one bucket, wait moves the clock before the request.
+	steps := []struct {
+		name       string
+		wait       time.Duration
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+		reset      time.Duration
+	}{
+		{"FirstOfBurst", 0, true, 1, 0, 500 * time.Millisecond},
+		{"LastOfBurst", 0, true, 0, 0, time.Second},
+		{"Empty", 0, false, 0, 500 * time.Millisecond, time.Second},
+		{"StillEmpty", 250 * time.Millisecond, false, 0, 250 * time.Millisecond, 750 * time.Millisecond},
+		{"RefilledOne", 250 * time.Millisecond, true, 0, 0, time.Second},
+		{"RefilledFully", time.Hour, true, 1, 0, 500 * time.Millisecond},
+	}
+
+	c := newClock()
+	m := NewMemory()
+	m.now = c.Now
+
+	for _, s := range steps {
+		c.advance(s.wait)
+
+		got, err := m.Allow(context.Background(), "client", limit)
+		if err != nil {
+			t.Fatalf("%s: Allow: %v", s.name, err)
+		}
+
+		want := Result{Allowed: s.allowed, Remaining: s.remaining, RetryAfter: s.retryAfter, Reset: s.reset}
+		if got != want {
+			t.Errorf("%s: Allow = %+v, want %+v", s.name, got, want)
+		}
+	}
+}
+
+func TestMemoryKeysAreSeparate(t *testing.T) {
+	limit := Limit{Requests: 1, Period: time.Minute}
+
+	c := newClock()
+	m := NewMemory()
+	m.now = c.Now
+
+	for _, key := range []string{"a", "b"} {
+		result, _ := m.Allow(context.Background(), key, limit)
+		if !result.Allowed {
+			t.Errorf("first request of %s was denied", key)
+		}
+	}
+
+	result, _ := m.Allow(context.Background(), "a", limit)
+	if result.Allowed {
+		t.Error("second request of a was allowed")
+	}
+}
+
+func TestIPMiddlewareRunsBeforeAuthentication(t *testing.T) {
+	c := newClock()
+	throttler := newThrottler(t, Config{IP: "2/1m"}, c)
+
+	var authenticated int
+	unauthorized := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		authenticated++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	handler := throttler.IPMiddleware(unauthorized)
+
+	// Requests with bad credentials count towards the limit of their address.
+	wantStatus := []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests}
+	for i, want := range wantStatus {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != want {
+			t.Errorf("request %d: status = %d, want %d", i, rec.Code, want)
+		}
+	}
+
+	if authenticated != 2 {
+		t.Errorf("authentication ran %d times, want 2", authenticated)
+	}
+
+	// Another port of the same host shares the bucket, another host does not.
+	for addr, want := range map[string]int{"192.0.2.1:9999": http.StatusTooManyRequests, "192.0.2.2:1234": http.StatusUnauthorized} {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil)
+		req.RemoteAddr = addr
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != want {
+			t.Errorf("%s: status = %d, want %d", addr, rec.Code, want)
+		}
+	}
+}
+
+// newRouter routes through Middleware the way the server does, with the identity set by authentication.
+func newRouter(throttler *Throttler, identity *auth.Identity) http.Handler {
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if identity != nil {
+				r = r.WithContext(auth.WithIdentity(r.Context(), *identity))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	router.Use(throttler.Middleware)
+
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+	router.Post("/v2/pull-requests/{pull_request_id}/merge", ok)
+	router.Get("/v2/teams/{team_name}", ok)
+	router.Get("/stats", ok)
+
+	return router
+}
+
+func serve(handler http.Handler, method string, target string, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddlewareKeysByRoutePattern(t *testing.T) {
+	c := newClock()
+	throttler := newThrottler(t, Config{
+		Default: "3/1m",
+		Routes:  map[string]string{"/v2/pull-requests/{pull_request_id}/merge": "1/1m"},
+	}, c)
+	router := newRouter(throttler, nil)
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		want   int
+	}{
+		{"RouteLimit", http.MethodPost, "/v2/pull-requests/pr-1/merge", http.StatusOK},
+		{"SamePatternOtherID", http.MethodPost, "/v2/pull-requests/pr-2/merge", http.StatusTooManyRequests},
+		{"DefaultLimit", http.MethodGet, "/v2/teams/backend", http.StatusOK},
+		{"DefaultSharedByRoutes", http.MethodGet, "/stats", http.StatusOK},
+		{"DefaultSharedByUnknownPaths", http.MethodGet, "/unknown", http.StatusNotFound},
+		{"DefaultExhausted", http.MethodGet, "/v2/teams/frontend", http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		rec := serve(router, tt.method, tt.target, "192.0.2.1:1234")
+		if rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, rec.Code, tt.want)
+		}
+	}
+}
+
+func TestMiddlewareKeysByActor(t *testing.T) {
+	c := newClock()
+	throttler := newThrottler(t, Config{Default: "1/1m"}, c)
+
+	key := newRouter(throttler, &auth.Identity{KeyID: "key-1"})
+	anonymous := newRouter(throttler, nil)
+
+	// The key is limited wherever it calls from, anonymous callers by address.
+	steps := []struct {
+		name    string
+		handler http.Handler
+		addr    string
+		want    int
+	}{
+		{"KeyFirstAddress", key, "192.0.2.1:1", http.StatusOK},
+		{"KeySecondAddress", key, "192.0.2.2:1", http.StatusTooManyRequests},
+		{"AnonymousSameAddress", anonymous, "192.0.2.1:1", http.StatusOK},
+		{"AnonymousAgain", anonymous, "192.0.2.1:2", http.StatusTooManyRequests},
+	}
+
+	for _, s := range steps {
+		rec := serve(s.handler, http.MethodGet, "/stats", s.addr)
+		if rec.Code != s.want {
+			t.Errorf("%s: status = %d, want %d", s.name, rec.Code, s.want)
+		}
+	}
+}
+
+func TestMiddlewareHeaders(t *testing.T) {
+	c := newClock()
+	throttler := newThrottler(t, Config{Default: "2/1m"}, c)
+	router := newRouter(throttler, nil)
+
+	tests := []struct {
+		name       string
+		wait       time.Duration
+		status     int
+		remaining  string
+		reset      string
+		retryAfter string
+	}{
+		{"First", 0, http.StatusOK, "1", "30", ""},
+		{"Second", 0, http.StatusOK, "0", "60", ""},
+		{"Limited", 10 * time.Second, http.StatusTooManyRequests, "0", "50", "20"},
+	}
+
+	for _, tt := range tests {
+		c.advance(tt.wait)
+		rec := serve(router, http.MethodGet, "/stats", "192.0.2.1:1234")
+
+		if rec.Code != tt.status {
+			t.Fatalf("%s: status = %d, want %d", tt.name, rec.Code, tt.status)
+		}
+
+		header := rec.Header()
+		want := map[string]string{
+			"RateLimit-Policy":    "2;w=60",
+			"RateLimit-Limit":     "2",
+			"RateLimit-Remaining": tt.remaining,
+			"RateLimit-Reset":     tt.reset,
+			"Retry-After":         tt.retryAfter,
+		}
+
+		for name, value := range want {
+			if got := header.Get(name); got != value {
+				t.Errorf("%s: %s = %q, want %q", tt.name, name, got, value)
+			}
+		}
+	}
+}
+
+func TestMiddlewareProblem(t *testing.T) {
+	c := newClock()
+	throttler := newThrottler(t, Config{Default: "1/1m"}, c)
+	router := newRouter(throttler, nil)
+
+	serve(router, http.MethodGet, "/stats", "192.0.2.1:1234")
+	rec := serve(router, http.MethodGet, "/stats", "192.0.2.1:1234")
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q, want application/problem+json", ct)
+	}
+
+	var problem api.Problem
+	err := json.Unmarshal(rec.Body.Bytes(), &problem)
+	if err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+
+	if problem.Status != http.StatusTooManyRequests || problem.Code != api.CodeRateLimited ||
+		problem.Detail != api.ErrRateLimited || problem.Instance != "/stats" {
+		t.Errorf("problem = %+v, want %s for /stats", problem, api.CodeRateLimited)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	throttler, err := New(&Config{Enabled: false, Default: "1/1m", IP: "1/1m"}, nil, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	router := throttler.IPMiddleware(newRouter(throttler, nil))
+
+	for range 3 {
+		rec := serve(router, http.MethodGet, "/stats", "192.0.2.1:1234")
+		if rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Limit") != "" {
+			t.Fatalf("status = %d, RateLimit-Limit = %q, want 200 without headers", rec.Code, rec.Header().Get("RateLimit-Limit"))
+		}
+	}
+}
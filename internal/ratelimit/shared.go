@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Store keeps buckets shared between instances.
+type Store interface {
+	// UpdateRateLimit locks the bucket of key, creating it with tokens when it is new, and saves
+	// the tokens update returns. now comes from the store clock, so instances agree on time.
+	UpdateRateLimit(ctx context.Context, key string, tokens float64, update func(tokens float64, updatedAt time.Time, now time.Time) float64) error
+	DeleteRateLimits(ctx context.Context, idleFor time.Duration) (int64, error)
+}
+
+// Shared keeps the buckets in a Store, so every instance sees the same limits.
+type Shared struct {
+	store Store
+	// idleFor is the longest configured period, a bucket idle for that long is full and can be dropped.
+	idleFor time.Duration
+	logger  *zap.Logger
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+func NewShared(store Store, idleFor time.Duration, logger *zap.Logger) *Shared {
+	return &Shared{store: store, idleFor: idleFor, logger: logger}
+}
+
+func (s *Shared) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.sweep()
+
+	var result Result
+
+	err := s.store.UpdateRateLimit(ctx, key, float64(limit.Requests), func(tokens float64, updatedAt time.Time, now time.Time) float64 {
+		tokens, result = take(tokens, updatedAt, now, limit)
+		return tokens
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+// sweep drops idle buckets in the background at most once per sweepEvery.
+func (s *Shared) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.sweptAt) < sweepEvery {
+		return
+	}
+
+	s.sweptAt = time.Now()
+
+	go func() {
+		deleted, err := s.store.DeleteRateLimits(context.Background(), s.idleFor)
+		if err != nil {
+			s.logger.Warn("Shared: failed to delete idle rate limits", zap.Error(err))
+			return
+		}
+
+		s.logger.Debug("Shared: deleted idle rate limits", zap.Int64("buckets", deleted))
+	}()
+}
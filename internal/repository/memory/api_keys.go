@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) SaveAPIKey(_ context.Context, key domain.APIKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, stored := range c.apiKeys {
+		if stored.KeyID == key.KeyID || bytes.Equal(stored.Hash, key.Hash) {
+			c.logger.Error("failed to save api key: duplicate key", zap.String("key_id", key.KeyID))
+			return repository.ErrDuplicateKey
+		}
+	}
+
+	c.apiKeys[key.KeyID] = cloneAPIKey(key)
+
+	c.logger.Info("successfully saved api key", zap.String("key_id", key.KeyID))
+	return nil
+}
+
+func (c *Client) GetAPIKeyByHash(_ context.Context, hash []byte) (*domain.APIKey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, stored := range c.apiKeys {
+		if bytes.Equal(stored.Hash, hash) {
+			key := cloneAPIKey(stored)
+			return &key, nil
+		}
+	}
+
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+func (c *Client) ListAPIKeys(_ context.Context) ([]domain.APIKey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]domain.APIKey, 0, len(c.apiKeys))
+	for _, stored := range c.apiKeys {
+		keys = append(keys, cloneAPIKey(stored))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+		}
+		return keys[i].KeyID < keys[j].KeyID
+	})
+
+	return keys, nil
+}
+
+func (c *Client) RevokeAPIKey(_ context.Context, keyID string, revokedAt time.Time) (*domain.APIKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stored, ok := c.apiKeys[keyID]
+	if !ok {
+		c.logger.Warn(repository.ErrAPIKeyNotFound.Error(), zap.String("key_id", keyID))
+		return nil, repository.ErrAPIKeyNotFound
+	}
+
+	if stored.RevokedAt == nil {
+		stored.RevokedAt = &revokedAt
+		c.apiKeys[keyID] = stored
+	}
+
+	c.logger.Info("successfully revoked api key", zap.String("key_id", keyID))
+	key := cloneAPIKey(stored)
+	return &key, nil
+}
+
+func cloneAPIKey(key domain.APIKey) domain.APIKey {
+	key.Hash = slices.Clone(key.Hash)
+	key.Scopes = slices.Clone(key.Scopes)
+	return key
+}
@@ -0,0 +1,153 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) ArchiveTeam(_ context.Context, teamName string, archivedAt time.Time) (time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids, ok := c.teams[teamName]
+	if !ok {
+		c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+		return time.Time{}, repository.ErrTeamNotFound
+	}
+
+	if stored, archived := c.archivedTeams[teamName]; archived {
+		archivedAt = stored
+	}
+	c.archivedTeams[teamName] = archivedAt
+
+	for _, id := range ids {
+		if _, archived := c.archivedUsers[id]; !archived {
+			c.archivedUsers[id] = archivedAt
+		}
+	}
+
+	c.logger.Info("successfully archived team", zap.String("team_name", teamName))
+	return archivedAt, nil
+}
+
+func (c *Client) RestoreTeam(_ context.Context, teamName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids, ok := c.teams[teamName]
+	if !ok {
+		c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+		return repository.ErrTeamNotFound
+	}
+
+	archivedAt, archived := c.archivedTeams[teamName]
+	if !archived {
+		return nil
+	}
+
+	for _, id := range ids {
+		if stored, ok := c.archivedUsers[id]; ok && stored.Equal(archivedAt) {
+			delete(c.archivedUsers, id)
+		}
+	}
+	delete(c.archivedTeams, teamName)
+
+	c.logger.Info("successfully restored team", zap.String("team_name", teamName))
+	return nil
+}
+
+func (c *Client) ArchiveUser(_ context.Context, userID string, archivedAt time.Time) (time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.users[userID]; !ok {
+		c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+		return time.Time{}, repository.ErrUserNotFound
+	}
+
+	if stored, archived := c.archivedUsers[userID]; archived {
+		return stored, nil
+	}
+	c.archivedUsers[userID] = archivedAt
+
+	c.logger.Info("successfully archived user", zap.String("user_id", userID))
+	return archivedAt, nil
+}
+
+func (c *Client) RestoreUser(_ context.Context, userID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	user, ok := c.users[userID]
+	if !ok {
+		c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+		return repository.ErrUserNotFound
+	}
+
+	if _, archived := c.archivedTeams[user.TeamName]; archived {
+		c.logger.Warn(repository.ErrTeamArchived.Error(), zap.String("user_id", userID))
+		return repository.ErrTeamArchived
+	}
+	delete(c.archivedUsers, userID)
+
+	c.logger.Info("successfully restored user", zap.String("user_id", userID))
+	return nil
+}
+
+func (c *Client) ArchivePR(_ context.Context, prID string, archivedAt time.Time) (time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pr, ok := c.prs[prID]
+	if !ok {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return time.Time{}, repository.ErrPRNotFound
+	}
+
+	if pr.ArchivedAt == nil {
+		pr.ArchivedAt = &archivedAt
+		c.prs[prID] = pr
+	}
+
+	c.logger.Info("successfully archived pull request", zap.String("pull_request_id", prID))
+	return *pr.ArchivedAt, nil
+}
+
+func (c *Client) RestorePR(_ context.Context, prID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pr, ok := c.prs[prID]
+	if !ok {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return repository.ErrPRNotFound
+	}
+
+	pr.ArchivedAt = nil
+	c.prs[prID] = pr
+
+	c.logger.Info("successfully restored pull request", zap.String("pull_request_id", prID))
+	return nil
+}
+
+func (c *Client) DeleteMergedPRs(_ context.Context, mergedBefore time.Time) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var deleted int64
+	for id, pr := range c.prs {
+		if pr.Status == domain.PRStatusMerged && pr.MergedAt != nil && pr.MergedAt.Before(mergedBefore) {
+			delete(c.prs, id)
+			deleted++
+		}
+	}
+	c.dropReminders()
+
+	c.logger.Info("successfully deleted merged pull requests", zap.Int64("prs", deleted))
+	return deleted, nil
+}
@@ -0,0 +1,453 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func New(logger *zap.Logger) *Client {
+	return &Client{
+		teams: make(map[string][]string),
+		users: make(map[string]domain.User),
+		prs:   make(map[string]domain.PullRequest),
+
+		archivedTeams: make(map[string]time.Time),
+		archivedUsers: make(map[string]time.Time),
+
+		apiKeys: make(map[string]domain.APIKey),
+
+		idempotencyKeys: make(map[idempotencyKeyID]domain.IdempotencyKey),
+
+		reminders: make(map[reminderID]time.Time),
+
+		logger: logger,
+	}
+}
+
+func (c *Client) SaveTeam(_ context.Context, team *domain.Team) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.teams[team.TeamName]; ok {
+		c.logger.Warn(repository.ErrTeamAlreadyExists.Error(), zap.String("team_name", team.TeamName))
+		return fmt.Errorf("%w: %s", repository.ErrTeamAlreadyExists, team.TeamName)
+	}
+
+	seen := make(map[string]struct{}, len(team.Members))
+	for _, member := range team.Members {
+		_, exists := c.users[member.UserID]
+		_, repeated := seen[member.UserID]
+		if exists || repeated {
+			c.logger.Error("failed to save team member: duplicate key", zap.String("user_id", member.UserID))
+			return repository.ErrDuplicateKey
+		}
+
+		seen[member.UserID] = struct{}{}
+	}
+
+	ids := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		c.users[member.UserID] = domain.User{
+			UserID:   member.UserID,
+			UserName: member.UserName,
+			Email:    member.Email,
+			TeamName: team.TeamName,
+			IsActive: member.IsActive,
+			Role:     domain.RoleMember,
+		}
+
+		ids = append(ids, member.UserID)
+	}
+	c.teams[team.TeamName] = ids
+
+	c.logger.Info("successfully stored team", zap.String("team_name", team.TeamName))
+	return nil
+}
+
+func (c *Client) ImportTeams(_ context.Context, teams []domain.Team) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, team := range teams {
+		if _, ok := c.teams[team.TeamName]; !ok {
+			c.teams[team.TeamName] = make([]string, 0, len(team.Members))
+		}
+		delete(c.archivedTeams, team.TeamName)
+
+		for _, member := range team.Members {
+			old, exists := c.users[member.UserID]
+			if exists && old.TeamName != team.TeamName {
+				c.teams[old.TeamName] = slices.DeleteFunc(c.teams[old.TeamName], func(id string) bool { return id == member.UserID })
+			}
+
+			if !exists || old.TeamName != team.TeamName {
+				c.teams[team.TeamName] = append(c.teams[team.TeamName], member.UserID)
+			}
+
+			role := old.Role
+			if !exists || (role == domain.RoleLead && old.TeamName != team.TeamName) {
+				role = domain.RoleMember
+			}
+
+			c.users[member.UserID] = domain.User{
+				UserID:   member.UserID,
+				UserName: member.UserName,
+				Email:    member.Email,
+				TeamName: team.TeamName,
+				IsActive: member.IsActive,
+				Role:     role,
+			}
+			delete(c.archivedUsers, member.UserID)
+		}
+	}
+
+	c.logger.Info("successfully imported teams", zap.Int("teams", len(teams)))
+	return nil
+}
+
+func (c *Client) GetTeam(_ context.Context, teamName string) (*domain.Team, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids, ok := c.teams[teamName]
+	if _, archived := c.archivedTeams[teamName]; !ok || archived {
+		c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+		return nil, repository.ErrTeamNotFound
+	}
+
+	members := make([]domain.TeamMember, 0, len(ids))
+	for _, id := range ids {
+		if _, archived := c.archivedUsers[id]; archived {
+			continue
+		}
+
+		user := c.users[id]
+		members = append(members, domain.TeamMember{
+			UserID:   user.UserID,
+			UserName: user.UserName,
+			Email:    user.Email,
+			IsActive: user.IsActive,
+			Role:     user.Role,
+		})
+	}
+
+	c.logger.Info("successfully retrieved team members", zap.String("team_name", teamName))
+	return &domain.Team{
+		TeamName: teamName,
+		Members:  members,
+	}, nil
+}
+
+func (c *Client) SetIsActive(_ context.Context, userID string, isActive bool) (*domain.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	user, ok := c.activeUser(userID)
+	if !ok {
+		c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+		return nil, repository.ErrUserNotFound
+	}
+
+	user.IsActive = isActive
+	c.users[userID] = user
+
+	c.logger.Info("successfully set is_active", zap.String("user_id", userID))
+	return &user, nil
+}
+
+func (c *Client) GetUser(_ context.Context, userID string) (*domain.User, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	user, ok := c.activeUser(userID)
+	if !ok {
+		c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+		return nil, repository.ErrUserNotFound
+	}
+
+	return &user, nil
+}
+
+func (c *Client) SetUserRole(_ context.Context, userID string, role string) (*domain.User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	user, ok := c.activeUser(userID)
+	if !ok {
+		c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+		return nil, repository.ErrUserNotFound
+	}
+
+	user.Role = role
+	c.users[userID] = user
+
+	c.logger.Info("successfully set role", zap.String("user_id", userID), zap.String("role", role))
+	return &user, nil
+}
+
+func (c *Client) GetActiveMembers(_ context.Context, teamName string) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	members := make([]string, 0, len(c.teams[teamName]))
+	for _, id := range c.teams[teamName] {
+		if user, ok := c.activeUser(id); ok && user.IsActive {
+			members = append(members, id)
+		}
+	}
+	slices.Sort(members)
+
+	return members, nil
+}
+
+func (c *Client) SavePR(_ context.Context, pr domain.PullRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.prs[pr.PullRequestId]; ok {
+		c.logger.Warn(repository.ErrPRAlreadyExists.Error(), zap.String("pull_request_id", pr.PullRequestId))
+		return repository.ErrPRAlreadyExists
+	}
+
+	c.prs[pr.PullRequestId] = clonePR(pr)
+
+	c.logger.Info("successfully saved pull request", zap.String("pull_request_id", pr.PullRequestId))
+	return nil
+}
+
+func (c *Client) GetPR(_ context.Context, prID string) (*domain.PullRequest, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pr, ok := c.prs[prID]
+	if !ok || pr.ArchivedAt != nil {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return nil, repository.ErrPRNotFound
+	}
+
+	pr = clonePR(pr)
+	return &pr, nil
+}
+
+func (c *Client) SetPRStatus(_ context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pr, ok := c.prs[prID]
+	if !ok || pr.ArchivedAt != nil {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return nil, false, repository.ErrPRNotFound
+	}
+
+	changed := pr.MergedAt == nil
+
+	pr.Status = status
+	if changed {
+		pr.MergedAt = &mergedAt
+	}
+	c.prs[prID] = pr
+
+	pr = clonePR(pr)
+
+	c.logger.Info("successfully set status", zap.String("pull_request_id", prID))
+	return &pr, changed, nil
+}
+
+func (c *Client) UpdateReviewers(_ context.Context, prID string, expected []string, reviewers []string) (*domain.PullRequest, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pr, ok := c.prs[prID]
+	if !ok || pr.ArchivedAt != nil || pr.Status != domain.PRStatusOpen || !slices.Equal(pr.AssignedReviewers, expected) {
+		c.logger.Warn(repository.ErrPRChanged.Error(), zap.String("pull_request_id", prID))
+		return nil, repository.ErrPRChanged
+	}
+
+	pr.AssignedReviewers = slices.Clone(reviewers)
+	c.prs[prID] = pr
+
+	pr = clonePR(pr)
+
+	c.logger.Info("successfully updated assigned reviewers", zap.String("pull_request_id", prID))
+	return &pr, nil
+}
+
+func (c *Client) GetReviewers(_ context.Context, userID string) ([]domain.PullRequestShort, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	matched := make([]domain.PullRequest, 0)
+	for _, pr := range c.prs {
+		if pr.ArchivedAt == nil && slices.Contains(pr.AssignedReviewers, userID) {
+			matched = append(matched, pr)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return createdAt(matched[i]).After(createdAt(matched[j]))
+	})
+
+	prs := make([]domain.PullRequestShort, 0, len(matched))
+	for _, pr := range matched {
+		prs = append(prs, domain.PullRequestShort{
+			PullRequestId:   pr.PullRequestId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorId,
+			Status:          pr.Status,
+		})
+	}
+
+	c.logger.Info("successfully got reviewers", zap.Int("prs", len(prs)))
+	return prs, nil
+}
+
+func (c *Client) GetStalePRs(_ context.Context, createdBefore time.Time) ([]domain.PullRequest, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	prs := make([]domain.PullRequest, 0)
+	for _, pr := range c.prs {
+		if pr.Status == domain.PRStatusOpen && pr.ArchivedAt == nil && pr.CreatedAt != nil && pr.CreatedAt.Before(createdBefore) {
+			prs = append(prs, clonePR(pr))
+		}
+	}
+
+	sort.Slice(prs, func(i, j int) bool {
+		return createdAt(prs[i]).Before(createdAt(prs[j]))
+	})
+
+	c.logger.Info("successfully got stale pull requests", zap.Int("prs", len(prs)))
+	return prs, nil
+}
+
+func (c *Client) GetStats(_ context.Context) (*domain.Stats, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var stats domain.Stats
+	byUser := make(map[string]*domain.ReviewerStats)
+
+	for _, pr := range c.prs {
+		if pr.ArchivedAt != nil {
+			continue
+		}
+
+		open := pr.Status == domain.PRStatusOpen
+		if open {
+			stats.OpenPRs++
+		} else if pr.Status == domain.PRStatusMerged {
+			stats.MergedPRs++
+		}
+
+		for _, id := range pr.AssignedReviewers {
+			reviewer, ok := byUser[id]
+			if !ok {
+				reviewer = &domain.ReviewerStats{UserID: id}
+				byUser[id] = reviewer
+			}
+
+			reviewer.Assigned++
+			if open {
+				reviewer.Open++
+			}
+		}
+	}
+
+	stats.Reviewers = make([]domain.ReviewerStats, 0, len(byUser))
+	for _, reviewer := range byUser {
+		stats.Reviewers = append(stats.Reviewers, *reviewer)
+	}
+
+	sort.Slice(stats.Reviewers, func(i, j int) bool {
+		if stats.Reviewers[i].Assigned != stats.Reviewers[j].Assigned {
+			return stats.Reviewers[i].Assigned > stats.Reviewers[j].Assigned
+		}
+
+		return stats.Reviewers[i].UserID < stats.Reviewers[j].UserID
+	})
+
+	return &stats, nil
+}
+
+func (c *Client) SaveEvent(_ context.Context, event domain.Event) (*domain.Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastEventID++
+	event.EventID = c.lastEventID
+	event.CreatedAt = time.Now()
+	event.PullRequest = clonePR(event.PullRequest)
+	event.UserIDs = slices.Clone(event.UserIDs)
+
+	c.events = append(c.events, event)
+
+	c.logger.Info("successfully saved event", zap.Int64("event_id", event.EventID))
+	return &event, nil
+}
+
+func (c *Client) GetEventsAfter(_ context.Context, eventID int64, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Event ids are assigned sequentially, so the first event after eventID sits at index eventID.
+	start := min(max(eventID, 0), int64(len(c.events)))
+
+	events := make([]domain.Event, 0)
+	for _, event := range c.events[start:] {
+		if len(events) == limit {
+			break
+		}
+
+		if filter.TeamName != "" && filter.TeamName != event.TeamName {
+			continue
+		}
+
+		if filter.UserID != "" && !slices.Contains(event.UserIDs, filter.UserID) {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetLastEventID(_ context.Context) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.lastEventID, nil
+}
+
+func (c *Client) Close() {}
+
+// activeUser returns the user unless it is unknown or archived.
+func (c *Client) activeUser(userID string) (domain.User, bool) {
+	user, ok := c.users[userID]
+	if _, archived := c.archivedUsers[userID]; !ok || archived {
+		return domain.User{}, false
+	}
+
+	return user, true
+}
+
+func clonePR(pr domain.PullRequest) domain.PullRequest {
+	pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	return pr
+}
+
+func createdAt(pr domain.PullRequest) time.Time {
+	if pr.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *pr.CreatedAt
+}
@@ -0,0 +1,19 @@
+package memory
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.Repository {
+		c := New(zap.NewNop())
+		t.Cleanup(c.Close)
+
+		return c
+	})
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) Dump(_ context.Context) (*domain.Snapshot, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	teams := make([]domain.Team, 0, len(c.teams))
+	for _, name := range slices.Sorted(maps.Keys(c.teams)) {
+		ids := slices.Sorted(slices.Values(c.teams[name]))
+
+		members := make([]domain.TeamMember, 0, len(ids))
+		for _, id := range ids {
+			user := c.users[id]
+			members = append(members, domain.TeamMember{
+				UserID:     user.UserID,
+				UserName:   user.UserName,
+				Email:      user.Email,
+				IsActive:   user.IsActive,
+				Role:       user.Role,
+				ArchivedAt: archivedAt(c.archivedUsers, id),
+			})
+		}
+
+		teams = append(teams, domain.Team{TeamName: name, Members: members, ArchivedAt: archivedAt(c.archivedTeams, name)})
+	}
+
+	prs := make([]domain.PullRequest, 0, len(c.prs))
+	for _, pr := range c.prs {
+		prs = append(prs, clonePR(pr))
+	}
+
+	sort.Slice(prs, func(i, j int) bool {
+		if !createdAt(prs[i]).Equal(createdAt(prs[j])) {
+			return createdAt(prs[i]).Before(createdAt(prs[j]))
+		}
+
+		return prs[i].PullRequestId < prs[j].PullRequestId
+	})
+
+	c.logger.Info("successfully dumped data", zap.Int("teams", len(teams)), zap.Int("prs", len(prs)))
+	return &domain.Snapshot{
+		Teams:        teams,
+		PullRequests: prs,
+	}, nil
+}
+
+func (c *Client) Restore(_ context.Context, snapshot *domain.Snapshot, replace bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Changes are made on copies and swapped in at the end, so a failed restore leaves nothing behind.
+	teams := make(map[string][]string)
+	users := make(map[string]domain.User)
+	prs := make(map[string]domain.PullRequest)
+	archivedTeams := make(map[string]time.Time)
+	archivedUsers := make(map[string]time.Time)
+
+	if !replace {
+		for name, ids := range c.teams {
+			teams[name] = slices.Clone(ids)
+		}
+		maps.Copy(users, c.users)
+		maps.Copy(prs, c.prs)
+		maps.Copy(archivedTeams, c.archivedTeams)
+		maps.Copy(archivedUsers, c.archivedUsers)
+	}
+
+	for _, team := range snapshot.Teams {
+		ids, ok := teams[team.TeamName]
+		if !ok {
+			ids = make([]string, 0, len(team.Members))
+
+			if team.ArchivedAt != nil {
+				archivedTeams[team.TeamName] = *team.ArchivedAt
+			}
+		}
+
+		for _, member := range team.Members {
+			if _, exists := users[member.UserID]; exists {
+				c.logger.Error("failed to restore user: duplicate key", zap.String("id", member.UserID))
+				return fmt.Errorf("%w: user %s", repository.ErrDuplicateKey, member.UserID)
+			}
+
+			users[member.UserID] = domain.User{
+				UserID:   member.UserID,
+				UserName: member.UserName,
+				Email:    member.Email,
+				TeamName: team.TeamName,
+				IsActive: member.IsActive,
+				Role:     cmp.Or(member.Role, domain.RoleMember),
+			}
+			ids = append(ids, member.UserID)
+
+			if member.ArchivedAt != nil {
+				archivedUsers[member.UserID] = *member.ArchivedAt
+			}
+		}
+
+		teams[team.TeamName] = ids
+	}
+
+	for _, pr := range snapshot.PullRequests {
+		if _, exists := prs[pr.PullRequestId]; exists {
+			c.logger.Error("failed to restore pull request: duplicate key", zap.String("id", pr.PullRequestId))
+			return fmt.Errorf("%w: pull request %s", repository.ErrDuplicateKey, pr.PullRequestId)
+		}
+
+		if _, ok := users[pr.AuthorId]; !ok {
+			c.logger.Error("failed to restore pull request: unknown author", zap.String("id", pr.PullRequestId))
+			return fmt.Errorf("%w: author of pull request %s", repository.ErrUserNotFound, pr.PullRequestId)
+		}
+
+		prs[pr.PullRequestId] = clonePR(pr)
+	}
+
+	c.teams, c.users, c.prs = teams, users, prs
+	c.archivedTeams, c.archivedUsers = archivedTeams, archivedUsers
+	c.dropReminders()
+
+	c.logger.Info("successfully restored data", zap.Int("teams", len(snapshot.Teams)), zap.Bool("replace", replace))
+	return nil
+}
+
+func archivedAt(archived map[string]time.Time, key string) *time.Time {
+	t, ok := archived[key]
+	if !ok {
+		return nil
+	}
+
+	return &t
+}
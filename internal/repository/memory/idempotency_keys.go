@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) SaveIdempotencyKey(_ context.Context, key domain.IdempotencyKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := idempotencyKeyID{scope: key.Scope, key: key.Key}
+	if stored, ok := c.idempotencyKeys[id]; ok && stored.ExpiresAt.After(key.CreatedAt) {
+		c.logger.Warn("failed to save idempotency key: duplicate key", zap.String("key", key.Key))
+		return repository.ErrDuplicateKey
+	}
+
+	key.StatusCode, key.ContentType, key.Body = 0, "", nil
+	key.RequestHash = slices.Clone(key.RequestHash)
+	c.idempotencyKeys[id] = key
+
+	return nil
+}
+
+func (c *Client) GetIdempotencyKey(_ context.Context, scope string, key string) (*domain.IdempotencyKey, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	stored, ok := c.idempotencyKeys[idempotencyKeyID{scope: scope, key: key}]
+	if !ok {
+		return nil, repository.ErrIdempotencyKeyNotFound
+	}
+
+	stored.RequestHash = slices.Clone(stored.RequestHash)
+	stored.Body = slices.Clone(stored.Body)
+
+	return &stored, nil
+}
+
+func (c *Client) CompleteIdempotencyKey(_ context.Context, scope string, key string, statusCode int, contentType string, body []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := idempotencyKeyID{scope: scope, key: key}
+
+	stored, ok := c.idempotencyKeys[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", repository.ErrIdempotencyKeyNotFound, key)
+	}
+
+	stored.StatusCode, stored.ContentType, stored.Body = statusCode, contentType, slices.Clone(body)
+	c.idempotencyKeys[id] = stored
+
+	return nil
+}
+
+func (c *Client) DeleteIdempotencyKey(_ context.Context, scope string, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.idempotencyKeys, idempotencyKeyID{scope: scope, key: key})
+	return nil
+}
+
+func (c *Client) DeleteExpiredIdempotencyKeys(_ context.Context, now time.Time) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var deleted int64
+
+	for id, stored := range c.idempotencyKeys {
+		if !stored.ExpiresAt.After(now) {
+			delete(c.idempotencyKeys, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+)
+
+// Client keeps everything in maps guarded by a single mutex, it is meant for tests and local development.
+type Client struct {
+	mu sync.RWMutex
+
+	// teams maps a team name to the ids of its members in insertion order.
+	teams map[string][]string
+	users map[string]domain.User
+	prs   map[string]domain.PullRequest
+
+	// archivedTeams and archivedUsers hold archive timestamps, pull requests keep theirs in ArchivedAt.
+	archivedTeams map[string]time.Time
+	archivedUsers map[string]time.Time
+
+	// apiKeys maps a key id to the key.
+	apiKeys map[string]domain.APIKey
+
+	idempotencyKeys map[idempotencyKeyID]domain.IdempotencyKey
+
+	// reminders holds the last stale review reminder per pull request and reviewer.
+	reminders map[reminderID]time.Time
+
+	events      []domain.Event
+	lastEventID int64
+
+	logger *zap.Logger
+}
+
+type idempotencyKeyID struct {
+	scope string
+	key   string
+}
+
+type reminderID struct {
+	prID   string
+	userID string
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) ClaimReminders(_ context.Context, prID string, userIDs []string, remindedBefore time.Time, remindedAt time.Time) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.prs[prID]; !ok {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return nil, fmt.Errorf("%w: %s", repository.ErrPRNotFound, prID)
+	}
+
+	claimed := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		id := reminderID{prID: prID, userID: userID}
+
+		if last, ok := c.reminders[id]; ok && !last.Before(remindedBefore) {
+			continue
+		}
+
+		c.reminders[id] = remindedAt
+		claimed = append(claimed, userID)
+	}
+
+	return claimed, nil
+}
+
+// dropReminders forgets the reminders of deleted pull requests, as the foreign key does in the SQL storages.
+func (c *Client) dropReminders() {
+	for id := range c.reminders {
+		if _, ok := c.prs[id.prID]; !ok {
+			delete(c.reminders, id)
+		}
+	}
+}
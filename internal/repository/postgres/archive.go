@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) ArchiveTeam(ctx context.Context, teamName string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var stored time.Time
+
+	err = tx.QueryRow(ctx, queryArchiveTeam, teamName, archivedAt).Scan(&stored)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+			return time.Time{}, repository.ErrTeamNotFound
+		}
+
+		c.logger.Error("failed to archive team", zap.String("team_name", teamName), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive team: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, queryArchiveTeamMembers, teamName, stored)
+	if err != nil {
+		c.logger.Error("failed to archive team members", zap.String("team_name", teamName), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive team members: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully archived team", zap.String("team_name", teamName))
+	return stored, nil
+}
+
+func (c *Client) RestoreTeam(ctx context.Context, teamName string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var archivedAt *time.Time
+
+	err = tx.QueryRow(ctx, queryGetTeamArchivedAt, teamName).Scan(&archivedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+			return repository.ErrTeamNotFound
+		}
+
+		c.logger.Error("failed to get team", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if archivedAt == nil {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx, queryUnarchiveTeamMembers, teamName, *archivedAt)
+	if err != nil {
+		c.logger.Error("failed to restore team members", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to restore team members: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, queryUnarchiveTeam, teamName)
+	if err != nil {
+		c.logger.Error("failed to restore team", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored team", zap.String("team_name", teamName))
+	return nil
+}
+
+func (c *Client) ArchiveUser(ctx context.Context, userID string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stored time.Time
+
+	err := c.pool.QueryRow(ctx, queryArchiveUser, userID, archivedAt).Scan(&stored)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return time.Time{}, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to archive user", zap.String("user_id", userID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive user: %w", err)
+	}
+
+	c.logger.Info("successfully archived user", zap.String("user_id", userID))
+	return stored, nil
+}
+
+func (c *Client) RestoreUser(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var teamArchived bool
+
+	err = tx.QueryRow(ctx, queryUnarchiveUser, userID).Scan(&teamArchived)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to restore user", zap.String("user_id", userID), zap.Error(err))
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	if teamArchived {
+		c.logger.Warn(repository.ErrTeamArchived.Error(), zap.String("user_id", userID))
+		return repository.ErrTeamArchived
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored user", zap.String("user_id", userID))
+	return nil
+}
+
+func (c *Client) ArchivePR(ctx context.Context, prID string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stored time.Time
+
+	err := c.pool.QueryRow(ctx, queryArchivePR, prID, archivedAt).Scan(&stored)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+			return time.Time{}, repository.ErrPRNotFound
+		}
+
+		c.logger.Error("failed to archive pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive pull request: %w", err)
+	}
+
+	c.logger.Info("successfully archived pull request", zap.String("pull_request_id", prID))
+	return stored, nil
+}
+
+func (c *Client) RestorePR(ctx context.Context, prID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tag, err := c.pool.Exec(ctx, queryUnarchivePR, prID)
+	if err != nil {
+		c.logger.Error("failed to restore pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return fmt.Errorf("failed to restore pull request: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return repository.ErrPRNotFound
+	}
+
+	c.logger.Info("successfully restored pull request", zap.String("pull_request_id", prID))
+	return nil
+}
+
+func (c *Client) DeleteMergedPRs(ctx context.Context, mergedBefore time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tag, err := c.pool.Exec(ctx, queryDeleteMergedPRs, mergedBefore)
+	if err != nil {
+		c.logger.Error("failed to delete merged pull requests", zap.Error(err))
+		return 0, fmt.Errorf("failed to delete merged pull requests: %w", err)
+	}
+
+	c.logger.Info("successfully deleted merged pull requests", zap.Int64("prs", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 
@@ -72,7 +71,7 @@ func (c *Client) SaveTeam(ctx context.Context, team *domain.Team) error {
 	}
 
 	for _, member := range team.Members {
-		tag, err = tx.Exec(ctx, querySaveTeamMember, member.UserID, member.UserName, team.TeamName, member.IsActive)
+		tag, err = tx.Exec(ctx, querySaveTeamMember, member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email)
 		if err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -100,11 +99,48 @@ func (c *Client) SaveTeam(ctx context.Context, team *domain.Team) error {
 	return nil
 }
 
+func (c *Client) ImportTeams(ctx context.Context, teams []domain.Team) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, team := range teams {
+		_, err = tx.Exec(ctx, queryEnsureTeam, team.TeamName)
+		if err != nil {
+			c.logger.Error("failed to create team", zap.String("team_name", team.TeamName), zap.Error(err))
+			return fmt.Errorf("failed to create team: %s: %w", team.TeamName, err)
+		}
+
+		for _, member := range team.Members {
+			_, err = tx.Exec(ctx, queryUpsertTeamMember, member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email)
+			if err != nil {
+				c.logger.Error("failed to upsert team member", zap.String("user_id", member.UserID), zap.Error(err))
+				return fmt.Errorf("failed to upsert team member: %s: %w", member.UserID, err)
+			}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully imported teams", zap.Int("teams", len(teams)))
+	return nil
+}
+
 func (c *Client) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	exists, err := c.teamExists(ctx, teamName)
+	exists, err := c.activeTeamExists(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +161,7 @@ func (c *Client) GetTeam(ctx context.Context, teamName string) (*domain.Team, er
 	for rows.Next() {
 		var member domain.TeamMember
 
-		err = rows.Scan(&member.UserID, &member.UserName, &member.IsActive)
+		err = rows.Scan(&member.UserID, &member.UserName, &member.Email, &member.IsActive, &member.Role)
 		if err != nil {
 			c.logger.Error("failed to scan member", zap.String("team_name", teamName), zap.Error(err))
 			return nil, fmt.Errorf("failed to scan member: %w", err)
@@ -152,7 +188,7 @@ func (c *Client) SetIsActive(ctx context.Context, userID string, isActive bool)
 
 	var user domain.User
 	err := c.pool.QueryRow(ctx, querySetIsActive, userID, isActive).
-		Scan(&user.UserID, &user.UserName, &user.TeamName, &user.IsActive)
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
@@ -167,33 +203,92 @@ func (c *Client) SetIsActive(ctx context.Context, userID string, isActive bool)
 	return &user, nil
 }
 
-func (c *Client) SavePR(ctx context.Context, pr domain.PullRequest) (*domain.PullRequest, error) {
+func (c *Client) GetUser(ctx context.Context, userID string) (*domain.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	exists, err := c.prExists(ctx, pr.PullRequestId)
+	var user domain.User
+	err := c.pool.QueryRow(ctx, queryGetUser, userID).
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return nil, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to get user", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	if exists {
-		c.logger.Warn(repository.ErrPRAlreadyExists.Error(), zap.String("pull_request_id", pr.PullRequestId))
-		return nil, repository.ErrPRAlreadyExists
+	c.logger.Info("successfully got user", zap.String("user_id", userID))
+	return &user, nil
+}
+
+func (c *Client) SetUserRole(ctx context.Context, userID string, role string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var user domain.User
+	err := c.pool.QueryRow(ctx, querySetUserRole, userID, role).
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return nil, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to set role", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to set role: %w", err)
 	}
 
-	teamName, err := c.getTeamName(ctx, pr.AuthorId)
+	c.logger.Info("successfully set role", zap.String("user_id", userID), zap.String("role", role))
+	return &user, nil
+}
+
+func (c *Client) GetActiveMembers(ctx context.Context, teamName string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.pool.Query(ctx, queryGetActiveMembers, teamName)
 	if err != nil {
-		return nil, err
+		c.logger.Error("failed to get active members", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("failed to get active members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]string, 0)
+	for rows.Next() {
+		var userID string
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			c.logger.Error("failed to scan active members", zap.String("team_name", teamName), zap.Error(err))
+			return nil, fmt.Errorf("failed to scan active members: %w", err)
+		}
+
+		members = append(members, userID)
+	}
+	err = rows.Err()
+	if err != nil {
+		c.logger.Error("rows error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	reviewers, err := c.getActiveReviewers(ctx, teamName, pr.AuthorId)
+	return members, nil
+}
+
+func (c *Client) SavePR(ctx context.Context, pr domain.PullRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	exists, err := c.prExists(ctx, pr.PullRequestId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if len(reviewers) == 0 {
-		c.logger.Warn(repository.ErrReviewersNotFound.Error(), zap.String("pull_request_id", pr.PullRequestId))
-		return nil, repository.ErrReviewersNotFound
+	if exists {
+		c.logger.Warn(repository.ErrPRAlreadyExists.Error(), zap.String("pull_request_id", pr.PullRequestId))
+		return repository.ErrPRAlreadyExists
 	}
 
 	tag, err := c.pool.Exec(ctx, querySavePR,
@@ -201,33 +296,30 @@ func (c *Client) SavePR(ctx context.Context, pr domain.PullRequest) (*domain.Pul
 		pr.PullRequestName,
 		pr.AuthorId,
 		&pr.Status,
-		reviewers,
+		pr.AssignedReviewers,
 		pr.CreatedAt,
 	)
 	if err != nil {
 		c.logger.Error("failed to save pull request", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
-		return nil, fmt.Errorf("failed to save pull request: %w", err)
+		return fmt.Errorf("failed to save pull request: %w", err)
 	}
 
 	if tag.RowsAffected() == 0 {
 		c.logger.Error("failed to save pull request: no rows affected", zap.String("pull request_id", pr.PullRequestId))
-		return nil, fmt.Errorf("failed to save pull request: no rows affected: %s", pr.PullRequestId)
+		return fmt.Errorf("failed to save pull request: no rows affected: %s", pr.PullRequestId)
 	}
 
-	pr.AssignedReviewers = reviewers
-
 	c.logger.Info("successfully saved pull request", zap.String("pull_request_id", pr.PullRequestId))
-	return &pr, nil
+	return nil
 }
 
-func (c *Client) SetPRStatus(ctx context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, error) {
+func (c *Client) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	var pr domain.PullRequest
+	pr := domain.PullRequest{PullRequestId: prID}
 
-	err := c.pool.QueryRow(ctx, querySetPRStatus, prID, status, mergedAt).Scan(
-		&pr.PullRequestId,
+	err := c.pool.QueryRow(ctx, queryGetPR, prID).Scan(
 		&pr.PullRequestName,
 		&pr.AuthorId,
 		&pr.Status,
@@ -241,113 +333,72 @@ func (c *Client) SetPRStatus(ctx context.Context, prID string, status string, me
 			return nil, repository.ErrPRNotFound
 		}
 
-		c.logger.Error("failed to set status", zap.String("pull_request_id", prID), zap.Error(err))
-		return nil, fmt.Errorf("failed to set status: %w", err)
+		c.logger.Error("failed to get pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
 	}
 
-	c.logger.Info("successfully set status", zap.String("pull_request_id", prID))
 	return &pr, nil
 }
 
-func (c *Client) ReassignReviewer(ctx context.Context, oldUserID string, prID string) (*domain.PullRequest, error) {
+func (c *Client) SetPRStatus(ctx context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	var pr domain.PullRequest
-	pr.PullRequestId = prID
+	var (
+		pr      domain.PullRequest
+		changed bool
+	)
 
-	var reviewers pgtype.Array[string]
-	err := c.pool.QueryRow(ctx, queryGetPR, prID).Scan(
+	err := c.pool.QueryRow(ctx, querySetPRStatus, prID, status, mergedAt).Scan(
+		&pr.PullRequestId,
 		&pr.PullRequestName,
 		&pr.AuthorId,
 		&pr.Status,
-		&reviewers,
+		&pr.AssignedReviewers,
 		&pr.CreatedAt,
 		&pr.MergedAt,
+		&changed,
 	)
-	fmt.Println(pr)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
-			return nil, repository.ErrPRNotFound
+			return nil, false, repository.ErrPRNotFound
 		}
 
-		c.logger.Error("failed to get pull request", zap.String("pull_request_id", prID), zap.Error(err))
-		return nil, fmt.Errorf("failed to get pull request: %s: %w", prID, err)
-	}
-
-	pr.AssignedReviewers = reviewers.Elements
-
-	if pr.Status == domain.PRStatusMerged {
-		c.logger.Warn(repository.ErrPRMerged.Error(), zap.String("pull_request_id", prID))
-		return nil, repository.ErrPRMerged
+		c.logger.Error("failed to set status", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, false, fmt.Errorf("failed to set status: %w", err)
 	}
 
-	var found bool
-	for _, r := range pr.AssignedReviewers {
-		if r == oldUserID {
-			found = true
-			break
-		}
-	}
+	c.logger.Info("successfully set status", zap.String("pull_request_id", prID))
+	return &pr, changed, nil
+}
 
-	if !found {
-		c.logger.Warn(repository.ErrReviewerNotAssigned.Error(), zap.String("pull_request_id", prID))
-		return nil, repository.ErrReviewerNotAssigned
-	}
+func (c *Client) UpdateReviewers(ctx context.Context, prID string, expected []string, reviewers []string) (*domain.PullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
 
-	teamName, err := c.getTeamName(ctx, pr.AuthorId)
-	if err != nil {
-		c.logger.Error("failed to get team name", zap.String("user_id", pr.AuthorId), zap.Error(err))
-		return nil, fmt.Errorf("failed to get team name: %s: %w", pr.AuthorId, err)
-	}
+	var pr domain.PullRequest
 
-	activeReviewers, err := c.getActiveReviewers(ctx, teamName, pr.AuthorId)
+	err := c.pool.QueryRow(ctx, queryUpdateReviewers, prID, expected, reviewers).Scan(
+		&pr.PullRequestId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.Status,
+		&pr.AssignedReviewers,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+	)
 	if err != nil {
-		return nil, err
-	}
-
-	var newReviewer string
-	for _, candidate := range activeReviewers {
-		if candidate == oldUserID {
-			continue
-		}
-		alreadyAssigned := false
-		for _, assigned := range pr.AssignedReviewers {
-			if assigned == candidate {
-				alreadyAssigned = true
-				break
-			}
-		}
-		if !alreadyAssigned {
-			newReviewer = candidate
-			break
-		}
-	}
-
-	if newReviewer == "" {
-		c.logger.Warn(repository.ErrNoCandidate.Error())
-		return nil, repository.ErrNoCandidate
-	}
-
-	for i, uid := range pr.AssignedReviewers {
-		if uid == oldUserID {
-			pr.AssignedReviewers[i] = newReviewer
-			break
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRChanged.Error(), zap.String("pull_request_id", prID))
+			return nil, repository.ErrPRChanged
 		}
-	}
 
-	tag, err := c.pool.Exec(ctx, queryUpdateAssignedReviewers, pr.AssignedReviewers, pr.PullRequestId)
-	if err != nil {
+		c.logger.Error("failed to update assigned reviewers", zap.String("pull_request_id", prID), zap.Error(err))
 		return nil, fmt.Errorf("failed to update assigned reviewers: %w", err)
 	}
 
-	if tag.RowsAffected() == 0 {
-		c.logger.Error("failed to update assigned reviewers", zap.String("pull_request_id", pr.PullRequestId))
-		return nil, fmt.Errorf("failed to update assigned reviewers: %s: %w", pr.PullRequestId, err)
-	}
-
-	c.logger.Info("successfully updated assigned reviewers", zap.String("pull_request_id", pr.PullRequestId))
+	c.logger.Info("successfully updated assigned reviewers", zap.String("pull_request_id", prID))
 	return &pr, nil
 }
 
@@ -388,80 +439,209 @@ func (c *Client) GetReviewers(ctx context.Context, userID string) ([]domain.Pull
 	return prs, nil
 }
 
-func (c *Client) Close() {
-	c.pool.Close()
-}
-
-func (c *Client) teamExists(ctx context.Context, teamName string) (bool, error) {
-	var exists bool
+func (c *Client) GetStalePRs(ctx context.Context, createdBefore time.Time) ([]domain.PullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
 
-	err := c.pool.QueryRow(ctx, queryTeamExists, teamName).Scan(&exists)
+	rows, err := c.pool.Query(ctx, queryGetStalePRs, createdBefore)
 	if err != nil {
-		c.logger.Error("failed to check if team exists", zap.Error(err))
-		return false, fmt.Errorf("failed to check if team exists: %w", err)
+		c.logger.Error("failed to get stale pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to get stale pull requests: %w", err)
 	}
+	defer rows.Close()
 
-	return exists, nil
-}
+	prs := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		var pr domain.PullRequest
+		err = rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&pr.Status,
+			&pr.AssignedReviewers,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+		)
+		if err != nil {
+			c.logger.Error("failed to scan pull request", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
 
-func (c *Client) prExists(ctx context.Context, prID string) (bool, error) {
-	var exists bool
+		prs = append(prs, pr)
+	}
 
-	err := c.pool.QueryRow(ctx, queryPRExists, prID).Scan(&exists)
+	err = rows.Err()
 	if err != nil {
-		c.logger.Error("failed to check if pull request exists", zap.Error(err))
-		return false, fmt.Errorf("failed to check if pull request exists: %w", err)
+		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	return exists, nil
+	c.logger.Info("successfully got stale pull requests", zap.Int("prs", len(prs)))
+	return prs, nil
 }
 
-func (c *Client) getTeamName(ctx context.Context, userID string) (string, error) {
-	var teamName string
+func (c *Client) GetStats(ctx context.Context) (*domain.Stats, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stats domain.Stats
 
-	err := c.pool.QueryRow(ctx, queryGetTeamName, userID).Scan(&teamName)
+	err := c.pool.QueryRow(ctx, queryGetPRCounts).Scan(&stats.OpenPRs, &stats.MergedPRs)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("user_id", userID))
-			return "", repository.ErrTeamNotFound
+		c.logger.Error("failed to count pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to count pull requests: %w", err)
+	}
+
+	rows, err := c.pool.Query(ctx, queryGetReviewerStats)
+	if err != nil {
+		c.logger.Error("failed to get reviewer stats", zap.Error(err))
+		return nil, fmt.Errorf("failed to get reviewer stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats.Reviewers = make([]domain.ReviewerStats, 0)
+	for rows.Next() {
+		var reviewer domain.ReviewerStats
+
+		err = rows.Scan(&reviewer.UserID, &reviewer.Assigned, &reviewer.Open)
+		if err != nil {
+			c.logger.Error("failed to scan reviewer stats", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan reviewer stats: %w", err)
 		}
 
-		c.logger.Error("failed to get team name", zap.Error(err))
-		return "", fmt.Errorf("failed to get team name: %w", err)
+		stats.Reviewers = append(stats.Reviewers, reviewer)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	return teamName, nil
+	c.logger.Info("successfully got stats", zap.Int("reviewers", len(stats.Reviewers)))
+	return &stats, nil
 }
 
-func (c *Client) getActiveReviewers(ctx context.Context, teamName string, authorId string) ([]string, error) {
-	reviewers := make([]string, 0, 1)
+func (c *Client) SaveEvent(ctx context.Context, event domain.Event) (*domain.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.pool.QueryRow(ctx, querySaveEvent,
+		event.Type,
+		event.TeamName,
+		event.PullRequest.PullRequestId,
+		event.PullRequest.PullRequestName,
+		event.PullRequest.AuthorId,
+		event.PullRequest.Status,
+		event.PullRequest.AssignedReviewers,
+		event.ReplacedUserID,
+		event.UserIDs,
+		event.Actor,
+	).Scan(&event.EventID, &event.CreatedAt)
+	if err != nil {
+		c.logger.Error("failed to save event", zap.String("pull_request_id", event.PullRequest.PullRequestId), zap.Error(err))
+		return nil, fmt.Errorf("failed to save event: %w", err)
+	}
+
+	c.logger.Info("successfully saved event", zap.Int64("event_id", event.EventID))
+	return &event, nil
+}
+
+func (c *Client) GetEventsAfter(ctx context.Context, eventID int64, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
 
-	rows, err := c.pool.Query(ctx, queryGetActiveReviewers, teamName, authorId)
+	rows, err := c.pool.Query(ctx, queryGetEventsAfter, eventID, filter.UserID, filter.TeamName, limit)
 	if err != nil {
-		c.logger.Error("failed to get active reviewers", zap.String("team_name", teamName), zap.Error(err))
-		return nil, fmt.Errorf("failed to get active reviewers: %w", err)
+		c.logger.Error("failed to get events", zap.Int64("event_id", eventID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get events: %w", err)
 	}
 	defer rows.Close()
 
+	events := make([]domain.Event, 0)
 	for rows.Next() {
-		var reviewer string
-
-		err = rows.Scan(&reviewer)
+		var event domain.Event
+		err = rows.Scan(
+			&event.EventID,
+			&event.Type,
+			&event.TeamName,
+			&event.PullRequest.PullRequestId,
+			&event.PullRequest.PullRequestName,
+			&event.PullRequest.AuthorId,
+			&event.PullRequest.Status,
+			&event.PullRequest.AssignedReviewers,
+			&event.ReplacedUserID,
+			&event.UserIDs,
+			&event.Actor,
+			&event.CreatedAt,
+		)
 		if err != nil {
-			c.logger.Error("failed to scan reviewers", zap.String("team_name", teamName), zap.Error(err))
-			return nil, fmt.Errorf("failed to scan reviewers: %w", err)
+			c.logger.Error("failed to scan event", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan event: %w", err)
 		}
 
-		reviewers = append(reviewers, reviewer)
+		events = append(events, event)
 	}
+
 	err = rows.Err()
 	if err != nil {
-		c.logger.Error("rows error", zap.String("team_name", teamName), zap.Error(err))
 		return nil, fmt.Errorf("rows error: %w", err)
 	}
 
-	c.logger.Info("successfully retrieved active reviewers", zap.String("team_name", teamName))
-	return reviewers, nil
+	return events, nil
+}
+
+func (c *Client) GetLastEventID(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var eventID int64
+
+	err := c.pool.QueryRow(ctx, queryGetLastEventID).Scan(&eventID)
+	if err != nil {
+		c.logger.Error("failed to get last event id", zap.Error(err))
+		return 0, fmt.Errorf("failed to get last event id: %w", err)
+	}
+
+	return eventID, nil
+}
+
+func (c *Client) Close() {
+	c.pool.Close()
+}
+
+func (c *Client) teamExists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+
+	err := c.pool.QueryRow(ctx, queryTeamExists, teamName).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if team exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if team exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c *Client) activeTeamExists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+
+	err := c.pool.QueryRow(ctx, queryActiveTeamExists, teamName).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if team exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if team exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c *Client) prExists(ctx context.Context, prID string) (bool, error) {
+	var exists bool
+
+	err := c.pool.QueryRow(ctx, queryPRExists, prID).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if pull request exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if pull request exists: %w", err)
+	}
+
+	return exists, nil
 }
 
 func buildDSN(config *Config) string {
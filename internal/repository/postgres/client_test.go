@@ -0,0 +1,191 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/postgres/postgrestest"
+	"reviewer-service/internal/repository/repositorytest"
+)
+
+const port = 54329
+
+// db is nil when embedded Postgres could not start, every test then skips.
+var (
+	db       *postgrestest.Database
+	startErr error
+)
+
+func TestMain(m *testing.M) {
+	db, startErr = postgrestest.Start(port)
+
+	code := m.Run()
+
+	if db != nil {
+		err := db.Stop()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to stop postgres:", err)
+		}
+	}
+
+	os.Exit(code)
+}
+
+func requireDB(t *testing.T) *postgrestest.Database {
+	t.Helper()
+
+	if db == nil {
+		t.Skipf("postgres is unavailable: %v", startErr)
+	}
+
+	return db
+}
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, requireDB(t).Factory())
+}
+
+func TestRateLimits(t *testing.T) {
+	db := requireDB(t)
+	client := db.NewClient(t)
+	ctx := context.Background()
+
+	var seen []float64
+	update := func(tokens float64, updatedAt time.Time, now time.Time) float64 {
+		seen = append(seen, tokens)
+
+		if now.Before(updatedAt) {
+			t.Errorf("now %v is before updatedAt %v", now, updatedAt)
+		}
+
+		return tokens - 1
+	}
+
+	for range 2 {
+		err := client.UpdateRateLimit(ctx, "ip:10.0.0.1", 5, update)
+		if err != nil {
+			t.Fatalf("UpdateRateLimit: %v", err)
+		}
+	}
+
+	if len(seen) != 2 || seen[0] != 5 || seen[1] != 4 {
+		t.Fatalf("tokens seen = %v, want [5 4]", seen)
+	}
+
+	err := client.UpdateRateLimit(ctx, "ip:10.0.0.2", 5, update)
+	if err != nil {
+		t.Fatalf("UpdateRateLimit: %v", err)
+	}
+
+	err = db.Exec(ctx, `update reviewer_service.rate_limits set updated_at = now() - interval '2 hours' where key = $1`, "ip:10.0.0.1")
+	if err != nil {
+		t.Fatalf("backdate bucket: %v", err)
+	}
+
+	deleted, err := client.DeleteRateLimits(ctx, time.Hour)
+	if err != nil {
+		t.Fatalf("DeleteRateLimits: %v", err)
+	}
+
+	if deleted != 1 {
+		t.Errorf("deleted = %d, want 1", deleted)
+	}
+
+	seen = nil
+
+	err = client.UpdateRateLimit(ctx, "ip:10.0.0.1", 5, update)
+	if err != nil {
+		t.Fatalf("UpdateRateLimit: %v", err)
+	}
+
+	if len(seen) != 1 || seen[0] != 5 {
+		t.Errorf("tokens after delete = %v, want a fresh bucket of 5", seen)
+	}
+}
+
+func TestReady(t *testing.T) {
+	db := requireDB(t)
+	client := db.NewClient(t)
+	ctx := context.Background()
+
+	err := client.Ready(ctx)
+	if err != nil {
+		t.Fatalf("Ready: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		arrange string
+		restore string
+	}{
+		{
+			name:    "Dirty",
+			arrange: `update schema_migrations set dirty = true`,
+			restore: `update schema_migrations set dirty = false`,
+		},
+		{
+			name:    "Pending",
+			arrange: `update schema_migrations set version = version - 1`,
+			restore: `update schema_migrations set version = version + 1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := db.Exec(ctx, tt.arrange)
+			if err != nil {
+				t.Fatalf("arrange: %v", err)
+			}
+
+			t.Cleanup(func() {
+				err := db.Exec(ctx, tt.restore)
+				if err != nil {
+					t.Errorf("restore: %v", err)
+				}
+			})
+
+			err = client.Ready(ctx)
+			if err == nil {
+				t.Error("Ready succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestTryLock(t *testing.T) {
+	db := requireDB(t)
+	first := db.NewClient(t)
+	ctx := context.Background()
+
+	second, err := postgres.New(ctx, db.Config(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(second.Close)
+
+	const key = 42
+
+	unlock, ok, err := first.TryLock(ctx, key)
+	if err != nil || !ok {
+		t.Fatalf("TryLock = %v, %v, want the lock", ok, err)
+	}
+
+	_, ok, err = second.TryLock(ctx, key)
+	if err != nil || ok {
+		t.Fatalf("TryLock while held = %v, %v, want false", ok, err)
+	}
+
+	unlock()
+
+	unlock, ok, err = second.TryLock(ctx, key)
+	if err != nil || !ok {
+		t.Fatalf("TryLock after unlock = %v, %v, want the lock", ok, err)
+	}
+	unlock()
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) Dump(ctx context.Context) (*domain.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// A repeatable read transaction makes the three selects see the same point in time.
+	tx, err := c.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, queryDumpTeams)
+	if err != nil {
+		c.logger.Error("failed to dump teams", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump teams: %w", err)
+	}
+
+	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Team, error) {
+		team := domain.Team{Members: make([]domain.TeamMember, 0)}
+		err := row.Scan(&team.TeamName, &team.ArchivedAt)
+		return team, err
+	})
+	if err != nil {
+		c.logger.Error("failed to dump teams", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump teams: %w", err)
+	}
+
+	index := make(map[string]int, len(teams))
+	for i, team := range teams {
+		index[team.TeamName] = i
+	}
+
+	rows, err = tx.Query(ctx, queryDumpUsers)
+	if err != nil {
+		c.logger.Error("failed to dump users", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump users: %w", err)
+	}
+
+	type member struct {
+		domain.TeamMember
+		teamName string
+	}
+
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (member, error) {
+		var m member
+		err := row.Scan(&m.UserID, &m.UserName, &m.Email, &m.teamName, &m.IsActive, &m.Role, &m.ArchivedAt)
+		return m, err
+	})
+	if err != nil {
+		c.logger.Error("failed to scan users", zap.Error(err))
+		return nil, fmt.Errorf("failed to scan users: %w", err)
+	}
+
+	for _, user := range users {
+		i := index[user.teamName]
+		teams[i].Members = append(teams[i].Members, user.TeamMember)
+	}
+
+	rows, err = tx.Query(ctx, queryDumpPRs)
+	if err != nil {
+		c.logger.Error("failed to dump pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump pull requests: %w", err)
+	}
+
+	prs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.PullRequest, error) {
+		var pr domain.PullRequest
+		err := row.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&pr.Status,
+			&pr.AssignedReviewers,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+			&pr.ArchivedAt,
+		)
+		return pr, err
+	})
+	if err != nil {
+		c.logger.Error("failed to scan pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to scan pull requests: %w", err)
+	}
+
+	c.logger.Info("successfully dumped data", zap.Int("teams", len(teams)), zap.Int("prs", len(prs)))
+	return &domain.Snapshot{
+		Teams:        teams,
+		PullRequests: prs,
+	}, nil
+}
+
+func (c *Client) Restore(ctx context.Context, snapshot *domain.Snapshot, replace bool) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if replace {
+		for _, query := range []string{queryDeletePRs, queryDeleteUsers, queryDeleteTeams} {
+			_, err = tx.Exec(ctx, query)
+			if err != nil {
+				c.logger.Error("failed to delete existing data", zap.Error(err))
+				return fmt.Errorf("failed to delete existing data: %w", err)
+			}
+		}
+	}
+
+	for _, team := range snapshot.Teams {
+		_, err = tx.Exec(ctx, queryLoadTeam, team.TeamName, team.ArchivedAt)
+		if err != nil {
+			c.logger.Error("failed to restore team", zap.String("team_name", team.TeamName), zap.Error(err))
+			return fmt.Errorf("failed to restore team: %s: %w", team.TeamName, err)
+		}
+
+		for _, member := range team.Members {
+			_, err = tx.Exec(ctx, queryLoadTeamMember,
+				member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email, member.Role, member.ArchivedAt)
+			if err != nil {
+				return c.restoreError("user", member.UserID, err)
+			}
+		}
+	}
+
+	for _, pr := range snapshot.PullRequests {
+		_, err = tx.Exec(ctx, queryRestorePR,
+			pr.PullRequestId,
+			pr.PullRequestName,
+			pr.AuthorId,
+			pr.Status,
+			pr.AssignedReviewers,
+			pr.CreatedAt,
+			pr.MergedAt,
+			pr.ArchivedAt,
+		)
+		if err != nil {
+			return c.restoreError("pull request", pr.PullRequestId, err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored data", zap.Int("teams", len(snapshot.Teams)), zap.Bool("replace", replace))
+	return nil
+}
+
+func (c *Client) restoreError(kind, id string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		c.logger.Error("failed to restore "+kind+": duplicate key", zap.String("id", id))
+		return fmt.Errorf("%w: %s %s", repository.ErrDuplicateKey, kind, id)
+	}
+
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		c.logger.Error("failed to restore "+kind+": unknown author", zap.String("id", id))
+		return fmt.Errorf("%w: author of %s %s", repository.ErrUserNotFound, kind, id)
+	}
+
+	c.logger.Error("failed to restore "+kind, zap.String("id", id), zap.Error(err))
+	return fmt.Errorf("failed to restore %s: %s: %w", kind, id, err)
+}
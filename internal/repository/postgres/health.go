@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"reviewer-service/database"
+)
+
+// Ready pings the database and checks that cmd/migrate has applied every migration embedded in this build.
+func (c *Client) Ready(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.pool.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ping postgres: %w", err)
+	}
+
+	var (
+		version uint
+		dirty   bool
+	)
+
+	err = c.pool.QueryRow(ctx, querySchemaVersion).Scan(&version, &dirty)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	return database.CheckVersion(version, dirty)
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// TryLock takes the session advisory lock key on a connection of its own, ok is false while
+// another session holds it. unlock releases the lock and returns the connection to the pool.
+func (c *Client) TryLock(ctx context.Context, key int64) (func(), bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := c.pool.Acquire(ctx)
+	if err != nil {
+		c.logger.Error("failed to acquire connection", zap.Error(err))
+		return nil, false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var ok bool
+
+	err = conn.QueryRow(ctx, queryTryAdvisoryLock, key).Scan(&ok)
+	if err != nil {
+		conn.Release()
+		c.logger.Error("failed to take advisory lock", zap.Int64("key", key), zap.Error(err))
+		return nil, false, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+
+	if !ok {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		defer cancel()
+
+		_, err := conn.Exec(ctx, queryAdvisoryUnlock, key)
+		if err != nil {
+			// The lock belongs to the session, closing the connection releases it as well.
+			c.logger.Error("failed to release advisory lock", zap.Int64("key", key), zap.Error(err))
+			conn.Hijack().Close(ctx)
+			return
+		}
+
+		conn.Release()
+	}
+
+	return unlock, true, nil
+}
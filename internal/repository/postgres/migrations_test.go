@@ -0,0 +1,39 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+)
+
+// TestMigrationsRoundTrip rolls every migration back and applies them again, so each down file
+// has to undo its up file completely.
+func TestMigrationsRoundTrip(t *testing.T) {
+	db := requireDB(t)
+	ctx := context.Background()
+
+	m, err := db.Migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	err = m.Down()
+	if err != nil {
+		t.Fatalf("down: %v", err)
+	}
+
+	err = db.Exec(ctx, `select 1 from reviewer_service.teams`)
+	if err == nil {
+		t.Fatal("reviewer_service.teams exists after rolling back every migration")
+	}
+
+	err = m.Up()
+	if err != nil {
+		t.Fatalf("up after down: %v", err)
+	}
+
+	err = db.NewClient(t).Ready(ctx)
+	if err != nil {
+		t.Fatalf("Ready after round trip: %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+// Package postgrestest starts a disposable Postgres for integration tests of postgres.Client.
+//
+// The server is an embedded-postgres binary, so no Docker is needed. The binary is downloaded
+// on first use, point POSTGRES_TEST_CACHE at a directory holding the archive to run offline.
+package postgrestest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"reviewer-service/database"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/postgres"
+	"reviewer-service/internal/repository/repositorytest"
+)
+
+const (
+	user     = "reviewer"
+	password = "reviewer"
+	dbName   = "reviewer"
+)
+
+// queryTruncate empties every table of the schema, so tables added by later migrations are
+// covered without touching this file.
+const queryTruncate = `do $$
+begin
+	execute (
+		select 'truncate ' || string_agg(format('%I.%I', schemaname, tablename), ', ') || ' restart identity cascade'
+		from pg_tables
+		where schemaname = 'reviewer_service'
+	);
+end $$`
+
+type Database struct {
+	server *embeddedpostgres.EmbeddedPostgres
+	pool   *pgxpool.Pool
+	config postgres.Config
+	port   uint32
+	dir    string
+}
+
+// Start launches a fresh server on port and applies every up migration the way cmd/migrate does.
+// It is meant to be called once from TestMain, followed by Stop.
+func Start(port uint32) (*Database, error) {
+	dir, err := os.MkdirTemp("", "reviewer-postgres-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data dir: %w", err)
+	}
+
+	cfg := embeddedpostgres.DefaultConfig().
+		Username(user).
+		Password(password).
+		Database(dbName).
+		Port(port).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		DataPath(filepath.Join(dir, "data")).
+		StartTimeout(time.Minute).
+		Logger(nil)
+
+	if cache := os.Getenv("POSTGRES_TEST_CACHE"); cache != "" {
+		cfg = cfg.CachePath(cache)
+	}
+
+	server := embeddedpostgres.NewDatabase(cfg)
+	err = server.Start()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to start postgres: %w", err)
+	}
+
+	db := &Database{
+		server: server,
+		dir:    dir,
+		port:   port,
+		config: postgres.Config{
+			Host:     "localhost",
+			Port:     fmt.Sprint(port),
+			User:     user,
+			Password: password,
+			Database: dbName,
+			Timeout:  5 * time.Second,
+			MaxConns: 4,
+			MinConns: 1,
+		},
+	}
+
+	ctx := context.Background()
+
+	db.pool, err = pgxpool.New(ctx, db.url("postgres"))
+	if err != nil {
+		db.Stop()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+
+	err = db.migrate()
+	if err != nil {
+		db.Stop()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func (d *Database) Stop() error {
+	if d.pool != nil {
+		d.pool.Close()
+	}
+
+	err := d.server.Stop()
+	os.RemoveAll(d.dir)
+
+	return err
+}
+
+// Config points at the running server, it can be passed to postgres.New.
+func (d *Database) Config() *postgres.Config {
+	cfg := d.config
+	return &cfg
+}
+
+// Reset empties every table so that each test starts from a clean schema.
+func (d *Database) Reset(ctx context.Context) error {
+	_, err := d.pool.Exec(ctx, queryTruncate)
+	if err != nil {
+		return fmt.Errorf("failed to truncate tables: %w", err)
+	}
+
+	return nil
+}
+
+// Exec runs raw SQL, tests use it to arrange rows the Client cannot produce.
+func (d *Database) Exec(ctx context.Context, sql string, args ...any) error {
+	_, err := d.pool.Exec(ctx, sql, args...)
+	return err
+}
+
+// NewClient resets the database and returns a Client that is closed when the test ends.
+func (d *Database) NewClient(t *testing.T) *postgres.Client {
+	t.Helper()
+
+	ctx := context.Background()
+
+	err := d.Reset(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := postgres.New(ctx, d.Config(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// Factory adapts NewClient for repositorytest.Run:
+//
+//	repositorytest.Run(t, db.Factory())
+func (d *Database) Factory() repositorytest.Factory {
+	return func(t *testing.T) repository.Repository {
+		return d.NewClient(t)
+	}
+}
+
+// Migrate returns a golang-migrate instance over the embedded migrations, tests use it to
+// step the schema up and down. The caller closes it.
+func (d *Database) Migrate() (*migrate.Migrate, error) {
+	src, err := iofs.New(database.Migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", src, d.url("pgx5"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration: %w", err)
+	}
+
+	return m, nil
+}
+
+// url builds the connection string, golang-migrate selects its driver by the scheme.
+func (d *Database) url(scheme string) string {
+	return fmt.Sprintf("%s://%s:%s@localhost:%d/%s?sslmode=disable", scheme, user, password, d.port, dbName)
+}
+
+func (d *Database) migrate() error {
+	m, err := d.Migrate()
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	err = m.Up()
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return nil
+}
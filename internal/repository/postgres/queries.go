@@ -4,38 +4,199 @@ const (
 	querySetTeamName = `insert into reviewer_service.teams (team_name) values ($1)`
 
 	querySaveTeamMember = `insert into reviewer_service.users 
-    		(user_id, username, team_name, is_active) values ($1, $2, $3, $4)`
+    		(user_id, username, team_name, is_active, email) values ($1, $2, $3, $4, nullif($5, ''))`
 
-	queryGetTeam = `select user_id, username, is_active from reviewer_service.users where team_name = $1`
+	queryEnsureTeam = `insert into reviewer_service.teams (team_name) values ($1)
+			on conflict (team_name) do update set archived_at = null`
 
-	querySetIsActive = `update reviewer_service.users set is_active = $2 
-    		where user_id = $1 returning user_id, username, team_name, is_active`
+	queryUpsertTeamMember = `insert into reviewer_service.users as u
+    		(user_id, username, team_name, is_active, email) values ($1, $2, $3, $4, nullif($5, ''))
+			on conflict (user_id) do update set username = excluded.username, team_name = excluded.team_name,
+			is_active = excluded.is_active, email = excluded.email, archived_at = null,
+			role = case when u.role = 'lead' and u.team_name <> excluded.team_name then 'member' else u.role end`
+
+	queryGetTeam = `select user_id, username, coalesce(email, ''), is_active, role from reviewer_service.users
+			where team_name = $1 and archived_at is null`
+
+	querySetIsActive = `update reviewer_service.users set is_active = $2
+    		where user_id = $1 and archived_at is null returning user_id, username, coalesce(email, ''), team_name, is_active, role`
+
+	querySetUserRole = `update reviewer_service.users set role = $2
+    		where user_id = $1 and archived_at is null returning user_id, username, coalesce(email, ''), team_name, is_active, role`
+
+	queryGetUser = `select user_id, username, coalesce(email, ''), team_name, is_active, role
+			from reviewer_service.users where user_id = $1 and archived_at is null`
 
 	querySavePR = `insert into reviewer_service.pull_requests
     		(pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at)
 			values ($1, $2, $3, $4, $5, $6)`
 
 	querySetPRStatus = `update reviewer_service.pull_requests
-			set status = $2, merged_at = coalesce(merged_at, $3) where pull_request_id = $1
-    		returning pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`
+			set status = $2, merged_at = coalesce(merged_at, $3) where pull_request_id = $1 and archived_at is null
+    		returning pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at,
+    			merged_at = $3`
+
+	queryGetStalePRs = `select pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
+			from reviewer_service.pull_requests
+			where status = 'OPEN' and created_at < $1 and archived_at is null
+			order by created_at`
+
+	queryGetPRCounts = `select count(*) filter (where status = 'OPEN'), count(*) filter (where status = 'MERGED')
+			from reviewer_service.pull_requests where archived_at is null`
+
+	queryGetReviewerStats = `select r.user_id, count(*), count(*) filter (where pr.status = 'OPEN')
+			from reviewer_service.pull_requests pr, unnest(pr.assigned_reviewers) as r(user_id)
+			where pr.archived_at is null
+			group by r.user_id
+			order by count(*) desc, r.user_id`
+
+	queryArchiveTeam = `update reviewer_service.teams set archived_at = coalesce(archived_at, $2)
+			where team_name = $1 returning archived_at`
+
+	queryArchiveTeamMembers = `update reviewer_service.users set archived_at = $2 where team_name = $1 and archived_at is null`
+
+	queryGetTeamArchivedAt = `select archived_at from reviewer_service.teams where team_name = $1 for update`
+
+	queryUnarchiveTeam = `update reviewer_service.teams set archived_at = null where team_name = $1`
+
+	queryUnarchiveTeamMembers = `update reviewer_service.users set archived_at = null where team_name = $1 and archived_at = $2`
+
+	queryArchiveUser = `update reviewer_service.users set archived_at = coalesce(archived_at, $2)
+			where user_id = $1 returning archived_at`
 
-	queryUpdateAssignedReviewers = `update reviewer_service.pull_requests set assigned_reviewers = $1 where pull_request_id = $2`
+	queryUnarchiveUser = `update reviewer_service.users u set archived_at = null
+			where u.user_id = $1
+			returning (select t.archived_at is not null from reviewer_service.teams t where t.team_name = u.team_name)`
+
+	queryArchivePR = `update reviewer_service.pull_requests set archived_at = coalesce(archived_at, $2)
+			where pull_request_id = $1 returning archived_at`
+
+	queryUnarchivePR = `update reviewer_service.pull_requests set archived_at = null where pull_request_id = $1`
+
+	queryDeleteMergedPRs = `delete from reviewer_service.pull_requests where status = 'MERGED' and merged_at < $1`
+
+	queryUpdateReviewers = `update reviewer_service.pull_requests set assigned_reviewers = $3
+			where pull_request_id = $1 and assigned_reviewers = $2 and status = 'OPEN' and archived_at is null
+    		returning pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`
 
 	queryTeamExists = `select exists (select 1 from reviewer_service.teams where team_name = $1)`
 
+	queryActiveTeamExists = `select exists (select 1 from reviewer_service.teams where team_name = $1 and archived_at is null)`
+
 	queryPRExists = `select exists (select 1 from reviewer_service.pull_requests where pull_request_id = $1)`
 
 	queryGetPR = `select pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
 			from reviewer_service.pull_requests
-			where pull_request_id = $1`
+			where pull_request_id = $1 and archived_at is null`
 
 	queryGetReviewers = `select pull_request_id, pull_request_name, author_id, status
 			from reviewer_service.pull_requests
-			where $1 = any(assigned_reviewers)
+			where $1 = any(assigned_reviewers) and archived_at is null
 			order by created_at desc`
 
-	queryGetTeamName = `select team_name from reviewer_service.users where user_id = $1`
+	queryGetActiveMembers = `select user_id from reviewer_service.users
+    		where team_name = $1 and is_active = true and archived_at is null order by user_id`
+
+	querySaveEvent = `insert into reviewer_service.events
+    		(event_type, team_name, pull_request_id, pull_request_name, author_id, status, assigned_reviewers, replaced_user_id, user_ids,
+    		actor)
+			values ($1, $2, $3, $4, $5, $6, $7, nullif($8, ''), $9, nullif($10, ''))
+			returning event_id, created_at`
+
+	queryGetEventsAfter = `select event_id, event_type, team_name, pull_request_id, pull_request_name, author_id, status,
+			assigned_reviewers, coalesce(replaced_user_id, ''), user_ids, coalesce(actor, ''), created_at
+			from reviewer_service.events
+			where event_id > $1 and ($2 = '' or $2 = any(user_ids)) and ($3 = '' or team_name = $3)
+			order by event_id
+			limit $4`
+
+	queryGetLastEventID = `select coalesce(max(event_id), 0) from reviewer_service.events`
+)
+
+const (
+	queryDumpTeams = `select team_name, archived_at from reviewer_service.teams order by team_name`
+
+	queryDumpUsers = `select user_id, username, coalesce(email, ''), team_name, is_active, role, archived_at
+			from reviewer_service.users order by team_name, user_id`
+
+	queryDumpPRs = `select pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at,
+			archived_at from reviewer_service.pull_requests order by created_at, pull_request_id`
+
+	queryDeletePRs = `delete from reviewer_service.pull_requests`
+
+	queryDeleteUsers = `delete from reviewer_service.users`
+
+	queryDeleteTeams = `delete from reviewer_service.teams`
+
+	queryRestorePR = `insert into reviewer_service.pull_requests
+    		(pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at, archived_at)
+			values ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	// queryLoadTeam keeps the archive state of a team that already exists, unlike queryEnsureTeam.
+	queryLoadTeam = `insert into reviewer_service.teams (team_name, archived_at) values ($1, $2) on conflict do nothing`
+
+	queryLoadTeamMember = `insert into reviewer_service.users
+    		(user_id, username, team_name, is_active, email, role, archived_at) values ($1, $2, $3, $4, nullif($5, ''), coalesce(nullif($6, ''), 'member'), $7)`
+)
+
+const (
+	querySaveAPIKey = `insert into reviewer_service.api_keys (key_id, name, key_hash, scopes, created_at, expires_at)
+			values ($1, $2, $3, $4, $5, $6)`
+
+	queryGetAPIKeyByHash = `select key_id, name, key_hash, scopes, created_at, revoked_at, expires_at
+			from reviewer_service.api_keys where key_hash = $1`
+
+	queryListAPIKeys = `select key_id, name, key_hash, scopes, created_at, revoked_at, expires_at
+			from reviewer_service.api_keys order by created_at, key_id`
+
+	queryRevokeAPIKey = `update reviewer_service.api_keys set revoked_at = coalesce(revoked_at, $2)
+			where key_id = $1
+			returning key_id, name, key_hash, scopes, created_at, revoked_at, expires_at`
+)
+
+const (
+	// querySaveIdempotencyKey only overwrites an expired key, a live one leaves no rows affected.
+	querySaveIdempotencyKey = `insert into reviewer_service.idempotency_keys as k
+    		(scope, key, request_hash, created_at, expires_at) values ($1, $2, $3, $4, $5)
+			on conflict (scope, key) do update set request_hash = excluded.request_hash, status_code = 0,
+				content_type = '', body = null, created_at = excluded.created_at, expires_at = excluded.expires_at
+			where k.expires_at <= excluded.created_at`
+
+	queryGetIdempotencyKey = `select scope, key, request_hash, status_code, content_type, body, created_at, expires_at
+			from reviewer_service.idempotency_keys where scope = $1 and key = $2`
+
+	queryCompleteIdempotencyKey = `update reviewer_service.idempotency_keys set status_code = $3, content_type = $4, body = $5
+			where scope = $1 and key = $2`
+
+	queryDeleteIdempotencyKey = `delete from reviewer_service.idempotency_keys where scope = $1 and key = $2`
+
+	queryDeleteExpiredIdempotencyKeys = `delete from reviewer_service.idempotency_keys where expires_at <= $1`
+)
+
+const (
+	// queryLockRateLimit creates the bucket or locks the existing one, clock_timestamp is read
+	// after the lock is taken so that concurrent requests see time moving forward.
+	queryLockRateLimit = `insert into reviewer_service.rate_limits as r (key, tokens, updated_at)
+			values ($1, $2, clock_timestamp())
+			on conflict (key) do update set key = r.key
+			returning tokens, updated_at, clock_timestamp()`
+
+	queryUpdateRateLimit = `update reviewer_service.rate_limits set tokens = $2, updated_at = $3 where key = $1`
+
+	queryDeleteRateLimits = `delete from reviewer_service.rate_limits where updated_at < clock_timestamp() - make_interval(secs => $1)`
+
+	// schema_migrations is kept by golang-migrate in the default schema.
+	querySchemaVersion = `select version, dirty from schema_migrations`
+)
+
+const (
+	// queryClaimReminders only touches the rows it returns, so two replicas never claim the same reviewer.
+	queryClaimReminders = `insert into reviewer_service.reminders as r (pull_request_id, user_id, reminded_at)
+			select $1, unnest($2::text[]), $4
+			on conflict (pull_request_id, user_id) do update set reminded_at = excluded.reminded_at
+			where r.reminded_at < $3
+			returning user_id`
 
-	queryGetActiveReviewers = `select user_id from reviewer_service.users
-    		where team_name = $1 and is_active = true and user_id <> $2 order by random() limit 2`
+	queryTryAdvisoryLock = `select pg_try_advisory_lock($1)`
+	queryAdvisoryUnlock  = `select pg_advisory_unlock($1)`
 )
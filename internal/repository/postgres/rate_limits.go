@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func (c *Client) UpdateRateLimit(ctx context.Context, key string, tokens float64, update func(tokens float64, updatedAt time.Time, now time.Time) float64) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var updatedAt, now time.Time
+
+	err = tx.QueryRow(ctx, queryLockRateLimit, key, tokens).Scan(&tokens, &updatedAt, &now)
+	if err != nil {
+		c.logger.Error("failed to lock rate limit", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to lock rate limit: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, queryUpdateRateLimit, key, update(tokens, updatedAt, now), now)
+	if err != nil {
+		c.logger.Error("failed to update rate limit", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to update rate limit: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteRateLimits(ctx context.Context, idleFor time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tag, err := c.pool.Exec(ctx, queryDeleteRateLimits, idleFor.Seconds())
+	if err != nil {
+		c.logger.Error("failed to delete rate limits", zap.Error(err))
+		return 0, fmt.Errorf("failed to delete rate limits: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
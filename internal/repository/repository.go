@@ -12,25 +12,89 @@ var (
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	ErrPRAlreadyExists   = errors.New("pull request already exists")
 
-	ErrPRMerged            = errors.New("pull request already merged")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
-	ErrNoCandidate         = errors.New("no candidate")
+	ErrPRChanged = errors.New("pull request changed")
 
 	ErrDuplicateKey = errors.New("duplicate key")
 
-	ErrTeamNotFound      = errors.New("team not found")
-	ErrUserNotFound      = errors.New("user not found")
-	ErrReviewersNotFound = errors.New("reviewers not found")
-	ErrPRNotFound        = errors.New("pull request not found")
+	ErrTeamArchived = errors.New("team archived")
+
+	ErrTeamNotFound   = errors.New("team not found")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrPRNotFound     = errors.New("pull request not found")
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
 )
 
 type Repository interface {
 	SaveTeam(ctx context.Context, team *domain.Team) error
+	// ImportTeams creates missing teams and creates or updates their members in one transaction.
+	// A user listed under another team than the stored one is moved to it, archived teams and users listed
+	// in the import are restored.
+	ImportTeams(ctx context.Context, teams []domain.Team) error
 	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
-	SavePR(ctx context.Context, pr domain.PullRequest) (*domain.PullRequest, error)
-	SetPRStatus(ctx context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, oldUserID string, prID string) (*domain.PullRequest, error)
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	// SetUserRole changes the role of a user, moving a lead to another team demotes them to member.
+	SetUserRole(ctx context.Context, userID string, role string) (*domain.User, error)
+	// GetActiveMembers returns the ids of the active, not archived members of the team ordered by id.
+	GetActiveMembers(ctx context.Context, teamName string) ([]string, error)
+	// SavePR stores the pull request with the reviewers it carries. An id taken by any pull request,
+	// archived ones included, fails with ErrPRAlreadyExists.
+	SavePR(ctx context.Context, pr domain.PullRequest) error
+	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
+	// SetPRStatus keeps the first merge time of a pull request merged again. changed reports whether this
+	// call set it, concurrent calls never both report a change.
+	SetPRStatus(ctx context.Context, prID string, status string, mergedAt time.Time) (pr *domain.PullRequest, changed bool, err error)
+	// UpdateReviewers replaces the reviewers of an open pull request while they still equal expected.
+	// Otherwise, and when the pull request was merged or archived meanwhile, it fails with ErrPRChanged.
+	UpdateReviewers(ctx context.Context, prID string, expected []string, reviewers []string) (*domain.PullRequest, error)
 	GetReviewers(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+	GetStalePRs(ctx context.Context, createdBefore time.Time) ([]domain.PullRequest, error)
+	// ClaimReminders records remindedAt for the users in userIDs who were not reminded about the pull
+	// request since remindedBefore and returns them in the order given. Concurrent claims never return
+	// the same user twice. A missing pull request fails with ErrPRNotFound.
+	ClaimReminders(ctx context.Context, prID string, userIDs []string, remindedBefore time.Time, remindedAt time.Time) ([]string, error)
+	GetStats(ctx context.Context) (*domain.Stats, error)
+	// ArchiveTeam archives the team together with its members that are not archived yet, they all get the
+	// same timestamp. Archiving an archived team keeps the original timestamp, which is returned.
+	ArchiveTeam(ctx context.Context, teamName string, archivedAt time.Time) (time.Time, error)
+	// RestoreTeam brings back the team and the members archived together with it.
+	RestoreTeam(ctx context.Context, teamName string) error
+	ArchiveUser(ctx context.Context, userID string, archivedAt time.Time) (time.Time, error)
+	// RestoreUser fails with ErrTeamArchived while the team of the user is archived.
+	RestoreUser(ctx context.Context, userID string) error
+	ArchivePR(ctx context.Context, prID string, archivedAt time.Time) (time.Time, error)
+	RestorePR(ctx context.Context, prID string) error
+	// DeleteMergedPRs hard-deletes pull requests merged before mergedBefore, archived or not.
+	DeleteMergedPRs(ctx context.Context, mergedBefore time.Time) (int64, error)
+	SaveAPIKey(ctx context.Context, key domain.APIKey) error
+	// GetAPIKeyByHash returns revoked keys too, callers check RevokedAt.
+	GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	// RevokeAPIKey keeps the first revocation time when called again.
+	RevokeAPIKey(ctx context.Context, keyID string, revokedAt time.Time) (*domain.APIKey, error)
+	// SaveIdempotencyKey reserves a key before its request runs. A key that has not expired by
+	// key.CreatedAt fails with ErrDuplicateKey, an expired one is replaced.
+	SaveIdempotencyKey(ctx context.Context, key domain.IdempotencyKey) error
+	GetIdempotencyKey(ctx context.Context, scope string, key string) (*domain.IdempotencyKey, error)
+	// CompleteIdempotencyKey stores the response of the request that reserved the key.
+	CompleteIdempotencyKey(ctx context.Context, scope string, key string, statusCode int, contentType string, body []byte) error
+	// DeleteIdempotencyKey releases a key whose request failed, so that a retry runs it again.
+	DeleteIdempotencyKey(ctx context.Context, scope string, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+	SaveEvent(ctx context.Context, event domain.Event) (*domain.Event, error)
+	GetEventsAfter(ctx context.Context, eventID int64, filter domain.EventFilter, limit int) ([]domain.Event, error)
+	// GetLastEventID returns the id of the newest event, 0 when there are none.
+	GetLastEventID(ctx context.Context) (int64, error)
 	Close()
 }
+
+// Dumper reads and loads the whole dataset at once, it backs export and import.
+type Dumper interface {
+	Dump(ctx context.Context) (*domain.Snapshot, error)
+	// Restore writes the snapshot in a single transaction. Teams that already exist are reused,
+	// users and pull requests that already exist fail with ErrDuplicateKey.
+	// With replace set, teams, users and pull requests are deleted first, events are kept.
+	Restore(ctx context.Context, snapshot *domain.Snapshot, replace bool) error
+}
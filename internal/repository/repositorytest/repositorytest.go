@@ -0,0 +1,1686 @@
+// Package repositorytest checks that a repository.Repository implementation honours
+// the contract the service and the handlers rely on, the same way postgres.Client does.
+// The reviewer assignment rules run through service.Service on top of the implementation.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+// Factory returns an empty repository, it is called once per subtest.
+// Implementations are expected to register their own cleanup with t.Cleanup.
+type Factory func(t *testing.T) repository.Repository
+
+// Run executes the conformance suite against the repositories built by factory.
+func Run(t *testing.T, factory Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.Repository)
+	}{
+		{"SaveTeam/RoundTrip", testSaveTeamRoundTrip},
+		{"SaveTeam/EmptyTeam", testSaveTeamEmpty},
+		{"SaveTeam/DuplicateTeam", testSaveTeamDuplicate},
+		{"SaveTeam/DuplicateMember", testSaveTeamDuplicateMember},
+		{"ImportTeams/CreatesAndUpdates", testImportTeams},
+		{"GetTeam/NotFound", testGetTeamNotFound},
+		{"SetIsActive", testSetIsActive},
+		{"SetIsActive/NotFound", testSetIsActiveNotFound},
+		{"GetUser", testGetUser},
+		{"SetUserRole", testSetUserRole},
+		{"SetUserRole/MoveDemotesLead", testMoveDemotesLead},
+		{"GetActiveMembers", testGetActiveMembers},
+		{"SavePR/StoresReviewers", testSavePRStoresReviewers},
+		{"SavePR/Duplicate", testSavePRDuplicate},
+		{"GetPR", testGetPR},
+		{"SetPRStatus/Merge", testMerge},
+		{"SetPRStatus/MergeIsIdempotent", testMergeIdempotent},
+		{"SetPRStatus/NotFound", testMergeNotFound},
+		{"UpdateReviewers", testUpdateReviewers},
+		{"UpdateReviewers/Changed", testUpdateReviewersChanged},
+		{"Service/CreatePR/AssignsActiveTeammates", testSavePRAssigns},
+		{"Service/CreatePR/AtMostTwoReviewers", testSavePRLimit},
+		{"Service/CreatePR/SkipsInactiveUsers", testSavePRSkipsInactive},
+		{"Service/CreatePR/NoReviewers", testSavePRNoReviewers},
+		{"Service/CreatePR/UnknownAuthor", testSavePRUnknownAuthor},
+		{"Service/CreatePR/DuplicateBeforeAuthor", testSavePRDuplicateBeforeAuthor},
+		{"Service/MergePR/NotifiesOnce", testMergeNotifiesOnce},
+		{"Service/ReassignReviewer/ReplacesInPlace", testReassign},
+		{"Service/ReassignReviewer/FindsFreeCandidate", testReassignFindsCandidate},
+		{"Service/ReassignReviewer/NotFound", testReassignNotFound},
+		{"Service/ReassignReviewer/Merged", testReassignMerged},
+		{"Service/ReassignReviewer/NotAssigned", testReassignNotAssigned},
+		{"Service/ReassignReviewer/NoCandidate", testReassignNoCandidate},
+		{"Service/ReassignReviewer/SkipsInactiveUsers", testReassignSkipsInactive},
+		{"GetReviewers/NewestFirst", testGetReviewersOrder},
+		{"GetReviewers/Empty", testGetReviewersEmpty},
+		{"GetStalePRs", testGetStalePRs},
+		{"ClaimReminders", testClaimReminders},
+		{"ClaimReminders/NotFound", testClaimRemindersNotFound},
+		{"ClaimReminders/DeletedWithPR", testClaimRemindersDeletedWithPR},
+		{"GetStats", testGetStats},
+		{"Archive/Team", testArchiveTeam},
+		{"Archive/User", testArchiveUser},
+		{"Archive/RestoreUserOfArchivedTeam", testRestoreUserOfArchivedTeam},
+		{"Archive/PullRequest", testArchivePR},
+		{"Archive/NotFound", testArchiveNotFound},
+		{"Archive/ImportRestores", testImportRestoresArchived},
+		{"DeleteMergedPRs", testDeleteMergedPRs},
+		{"Events", testEvents},
+		{"APIKeys", testAPIKeys},
+		{"APIKeys/Duplicate", testAPIKeyDuplicate},
+		{"IdempotencyKeys", testIdempotencyKeys},
+		{"IdempotencyKeys/Expired", testIdempotencyKeysExpired},
+		{"Dumper/RoundTrip", testDumpRestore},
+		{"Dumper/Merge", testRestoreMerge},
+		{"Dumper/Replace", testRestoreReplace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := factory(t)
+			tt.fn(t, repo)
+		})
+	}
+}
+
+func testSaveTeamRoundTrip(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	team := &domain.Team{
+		TeamName: "backend",
+		Members: []domain.TeamMember{
+			{UserID: "u1", UserName: "Alice", Email: "alice@example.com", IsActive: true, Role: domain.RoleMember},
+			{UserID: "u2", UserName: "Bob", IsActive: false, Role: domain.RoleMember},
+		},
+	}
+	mustSaveTeam(t, repo, team)
+
+	got, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if got.TeamName != team.TeamName {
+		t.Errorf("TeamName = %q, want %q", got.TeamName, team.TeamName)
+	}
+
+	if !sameMembers(got.Members, team.Members) {
+		t.Errorf("Members = %+v, want %+v", got.Members, team.Members)
+	}
+}
+
+func testSaveTeamEmpty(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, &domain.Team{TeamName: "empty"})
+
+	got, err := repo.GetTeam(context.Background(), "empty")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if len(got.Members) != 0 {
+		t.Errorf("Members = %+v, want none", got.Members)
+	}
+}
+
+func testSaveTeamDuplicate(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1"))
+
+	err := repo.SaveTeam(context.Background(), newTeam("backend", "u2"))
+	if !errors.Is(err, repository.ErrTeamAlreadyExists) {
+		t.Fatalf("SaveTeam error = %v, want %v", err, repository.ErrTeamAlreadyExists)
+	}
+
+	_, err = repo.GetUser(context.Background(), "u2")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("member of rejected team was stored: GetUser error = %v", err)
+	}
+}
+
+func testSaveTeamDuplicateMember(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1"))
+
+	err := repo.SaveTeam(ctx, newTeam("frontend", "u2", "u1"))
+	if !errors.Is(err, repository.ErrDuplicateKey) {
+		t.Fatalf("SaveTeam error = %v, want %v", err, repository.ErrDuplicateKey)
+	}
+
+	_, err = repo.GetTeam(ctx, "frontend")
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Errorf("team was partially stored: GetTeam error = %v", err)
+	}
+
+	_, err = repo.GetUser(ctx, "u2")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("team was partially stored: GetUser error = %v", err)
+	}
+}
+
+func testImportTeams(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	err := repo.ImportTeams(ctx, []domain.Team{
+		{TeamName: "backend", Members: []domain.TeamMember{{UserID: "u1", UserName: "Alice", Email: "alice@example.com", IsActive: false}}},
+		{TeamName: "frontend", Members: []domain.TeamMember{{UserID: "u2", UserName: "u2", IsActive: true}, {UserID: "u3", UserName: "u3", IsActive: true}}},
+		{TeamName: "empty", Members: []domain.TeamMember{}},
+	})
+	if err != nil {
+		t.Fatalf("ImportTeams: %v", err)
+	}
+
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+
+	want := domain.User{UserID: "u1", UserName: "Alice", Email: "alice@example.com", TeamName: "backend", IsActive: false, Role: domain.RoleMember}
+	if *user != want {
+		t.Errorf("updated user = %+v, want %+v", *user, want)
+	}
+
+	backend, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam(backend): %v", err)
+	}
+
+	if len(backend.Members) != 1 {
+		t.Errorf("backend members = %+v, want only u1 after u2 moved", backend.Members)
+	}
+
+	frontend, err := repo.GetTeam(ctx, "frontend")
+	if err != nil {
+		t.Fatalf("GetTeam(frontend): %v", err)
+	}
+
+	if len(frontend.Members) != 2 {
+		t.Errorf("frontend members = %+v, want u2 and u3", frontend.Members)
+	}
+
+	_, err = repo.GetTeam(ctx, "empty")
+	if err != nil {
+		t.Errorf("GetTeam(empty): %v", err)
+	}
+}
+
+func testGetTeamNotFound(t *testing.T, repo repository.Repository) {
+	_, err := repo.GetTeam(context.Background(), "missing")
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Fatalf("GetTeam error = %v, want %v", err, repository.ErrTeamNotFound)
+	}
+}
+
+func testSetIsActive(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1"))
+
+	user, err := repo.SetIsActive(ctx, "u1", false)
+	if err != nil {
+		t.Fatalf("SetIsActive: %v", err)
+	}
+
+	want := domain.User{UserID: "u1", UserName: "u1", TeamName: "backend", IsActive: false, Role: domain.RoleMember}
+	if *user != want {
+		t.Errorf("SetIsActive = %+v, want %+v", *user, want)
+	}
+
+	got, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+
+	if got.IsActive {
+		t.Errorf("GetUser IsActive = true after deactivation")
+	}
+}
+
+func testSetIsActiveNotFound(t *testing.T, repo repository.Repository) {
+	_, err := repo.SetIsActive(context.Background(), "missing", true)
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("SetIsActive error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+}
+
+func testGetUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, &domain.Team{
+		TeamName: "backend",
+		Members:  []domain.TeamMember{{UserID: "u1", UserName: "Alice", Email: "alice@example.com", IsActive: true}},
+	})
+
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+
+	want := domain.User{UserID: "u1", UserName: "Alice", Email: "alice@example.com", TeamName: "backend", IsActive: true, Role: domain.RoleMember}
+	if *user != want {
+		t.Errorf("GetUser = %+v, want %+v", *user, want)
+	}
+
+	_, err = repo.GetUser(ctx, "missing")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("GetUser error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+}
+
+func testSetUserRole(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	user, err := repo.SetUserRole(ctx, "u1", domain.RoleLead)
+	if err != nil {
+		t.Fatalf("SetUserRole: %v", err)
+	}
+
+	if user.Role != domain.RoleLead || user.TeamName != "backend" {
+		t.Errorf("SetUserRole = %+v, want lead of backend", *user)
+	}
+
+	// Saving the team again keeps the stored roles.
+	err = repo.ImportTeams(ctx, []domain.Team{*newTeam("backend", "u1", "u2")})
+	if err != nil {
+		t.Fatalf("ImportTeams: %v", err)
+	}
+
+	got, err := repo.GetUser(ctx, "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+
+	if got.Role != domain.RoleLead {
+		t.Errorf("Role after import = %q, want %q", got.Role, domain.RoleLead)
+	}
+
+	_, err = repo.SetUserRole(ctx, "missing", domain.RoleLead)
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("SetUserRole error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+}
+
+func testMoveDemotesLead(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	for _, userID := range []string{"u1", "u2"} {
+		_, err := repo.SetUserRole(ctx, userID, domain.RoleLead)
+		if err != nil {
+			t.Fatalf("SetUserRole(%s): %v", userID, err)
+		}
+	}
+
+	_, err := repo.SetUserRole(ctx, "u2", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("SetUserRole(u2): %v", err)
+	}
+
+	err = repo.ImportTeams(ctx, []domain.Team{*newTeam("frontend", "u1", "u2")})
+	if err != nil {
+		t.Fatalf("ImportTeams: %v", err)
+	}
+
+	for userID, want := range map[string]string{"u1": domain.RoleMember, "u2": domain.RoleAdmin} {
+		user, err := repo.GetUser(ctx, userID)
+		if err != nil {
+			t.Fatalf("GetUser(%s): %v", userID, err)
+		}
+
+		if user.Role != want {
+			t.Errorf("%s role after moving team = %q, want %q", userID, user.Role, want)
+		}
+	}
+}
+
+func testSavePRAssigns(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSaveTeam(t, repo, newTeam("frontend", "u3"))
+
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	if !slices.Equal(pr.AssignedReviewers, []string{"u2"}) {
+		t.Errorf("AssignedReviewers = %v, want [u2]", pr.AssignedReviewers)
+	}
+
+	if pr.Status != domain.PRStatusOpen {
+		t.Errorf("Status = %q, want %q", pr.Status, domain.PRStatusOpen)
+	}
+}
+
+func testSavePRLimit(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3", "u4", "u5"))
+
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	if len(pr.AssignedReviewers) != 2 {
+		t.Fatalf("AssignedReviewers = %v, want 2 reviewers", pr.AssignedReviewers)
+	}
+
+	if pr.AssignedReviewers[0] == pr.AssignedReviewers[1] {
+		t.Errorf("AssignedReviewers = %v, want distinct reviewers", pr.AssignedReviewers)
+	}
+
+	if slices.Contains(pr.AssignedReviewers, "u1") {
+		t.Errorf("AssignedReviewers = %v, author must not review own pull request", pr.AssignedReviewers)
+	}
+}
+
+func testSavePRSkipsInactive(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSetIsActive(t, repo, "u2", false)
+
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	if !slices.Equal(pr.AssignedReviewers, []string{"u3"}) {
+		t.Errorf("AssignedReviewers = %v, want [u3]", pr.AssignedReviewers)
+	}
+}
+
+func testSavePRNoReviewers(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSetIsActive(t, repo, "u2", false)
+
+	_, err := newService(repo).SavePR(context.Background(), newPR("pr-1", "u1", time.Now()))
+	if !errors.Is(err, service.ErrReviewersNotFound) {
+		t.Fatalf("SavePR error = %v, want %v", err, service.ErrReviewersNotFound)
+	}
+}
+
+func testSavePRUnknownAuthor(t *testing.T, repo repository.Repository) {
+	_, err := newService(repo).SavePR(context.Background(), newPR("pr-1", "missing", time.Now()))
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("SavePR error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+}
+
+func testSavePRDuplicateBeforeAuthor(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, err := newService(repo).SavePR(context.Background(), newPR("pr-1", "missing", time.Now()))
+	if !errors.Is(err, repository.ErrPRAlreadyExists) {
+		t.Fatalf("SavePR error = %v, want %v", err, repository.ErrPRAlreadyExists)
+	}
+}
+
+func testGetActiveMembers(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u4", "u1", "u3", "u2"))
+	mustSaveTeam(t, repo, newTeam("frontend", "u5"))
+	mustSetIsActive(t, repo, "u3", false)
+
+	_, err := repo.ArchiveUser(ctx, "u4", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveUser: %v", err)
+	}
+
+	members, err := repo.GetActiveMembers(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetActiveMembers: %v", err)
+	}
+
+	if !slices.Equal(members, []string{"u1", "u2"}) {
+		t.Errorf("GetActiveMembers = %v, want [u1 u2]", members)
+	}
+
+	members, err = repo.GetActiveMembers(ctx, "missing")
+	if err != nil || len(members) != 0 {
+		t.Errorf("GetActiveMembers(missing) = %v, %v, want none", members, err)
+	}
+}
+
+func testSavePRStoresReviewers(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+
+	// The repository keeps the reviewers it is given, inactive ones included.
+	mustSetIsActive(t, repo, "u3", false)
+
+	pr := newPR("pr-1", "u1", time.Now())
+	pr.AssignedReviewers = []string{"u3", "u2"}
+
+	err := repo.SavePR(ctx, pr)
+	if err != nil {
+		t.Fatalf("SavePR: %v", err)
+	}
+
+	got, err := repo.GetPR(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPR: %v", err)
+	}
+
+	if !slices.Equal(got.AssignedReviewers, pr.AssignedReviewers) || got.Status != domain.PRStatusOpen {
+		t.Errorf("GetPR = %+v, want %+v", *got, pr)
+	}
+}
+
+func testSavePRDuplicate(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	err := repo.SavePR(context.Background(), newPR("pr-1", "u1", time.Now()))
+	if !errors.Is(err, repository.ErrPRAlreadyExists) {
+		t.Fatalf("SavePR error = %v, want %v", err, repository.ErrPRAlreadyExists)
+	}
+}
+
+func testGetPR(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	saved := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	pr, err := repo.GetPR(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("GetPR: %v", err)
+	}
+
+	if pr.AuthorId != "u1" || !slices.Equal(pr.AssignedReviewers, saved.AssignedReviewers) {
+		t.Errorf("GetPR = %+v, want %+v", *pr, *saved)
+	}
+
+	_, err = repo.ArchivePR(ctx, "pr-1", time.Now())
+	if err != nil {
+		t.Fatalf("ArchivePR: %v", err)
+	}
+
+	for _, prID := range []string{"pr-1", "missing"} {
+		_, err = repo.GetPR(ctx, prID)
+		if !errors.Is(err, repository.ErrPRNotFound) {
+			t.Errorf("GetPR(%s) error = %v, want %v", prID, err, repository.ErrPRNotFound)
+		}
+	}
+}
+
+func testMerge(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	created := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	mergedAt := time.Now()
+	pr, _, err := repo.SetPRStatus(context.Background(), "pr-1", domain.PRStatusMerged, mergedAt)
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	if pr.Status != domain.PRStatusMerged {
+		t.Errorf("Status = %q, want %q", pr.Status, domain.PRStatusMerged)
+	}
+
+	if pr.MergedAt == nil || !sameInstant(*pr.MergedAt, mergedAt) {
+		t.Errorf("MergedAt = %v, want %v", pr.MergedAt, mergedAt)
+	}
+
+	if !slices.Equal(pr.AssignedReviewers, created.AssignedReviewers) {
+		t.Errorf("AssignedReviewers = %v, want %v", pr.AssignedReviewers, created.AssignedReviewers)
+	}
+}
+
+func testMergeIdempotent(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	first := time.Now()
+	_, changed, err := repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, first)
+	if err != nil {
+		t.Fatalf("first SetPRStatus: %v", err)
+	}
+
+	if !changed {
+		t.Error("first SetPRStatus changed = false, want true")
+	}
+
+	pr, changed, err := repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, first.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("second SetPRStatus: %v", err)
+	}
+
+	if changed {
+		t.Error("second SetPRStatus changed = true, want false")
+	}
+
+	if pr.MergedAt == nil || !sameInstant(*pr.MergedAt, first) {
+		t.Errorf("MergedAt = %v, want the first merge time %v", pr.MergedAt, first)
+	}
+}
+
+func testMergeNotFound(t *testing.T, repo repository.Repository) {
+	_, _, err := repo.SetPRStatus(context.Background(), "missing", domain.PRStatusMerged, time.Now())
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Fatalf("SetPRStatus error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+}
+
+func testMergeNotifiesOnce(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	var notify recorder
+	svc := service.New(repo, &notify, rand.New(rand.NewPCG(1, 2)), zap.NewNop())
+
+	for range 2 {
+		_, err := svc.MergePR(ctx, "pr-1")
+		if err != nil {
+			t.Fatalf("MergePR: %v", err)
+		}
+	}
+
+	if len(notify.events) != 1 || notify.events[0].Type != notifier.EventMerged {
+		t.Errorf("events = %v, want a single %s", notify.events, notifier.EventMerged)
+	}
+}
+
+func testUpdateReviewers(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSetIsActive(t, repo, "u3", false)
+	created := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	pr, err := repo.UpdateReviewers(ctx, "pr-1", []string{"u2"}, []string{"u3"})
+	if err != nil {
+		t.Fatalf("UpdateReviewers: %v", err)
+	}
+
+	if !slices.Equal(pr.AssignedReviewers, []string{"u3"}) {
+		t.Errorf("AssignedReviewers = %v, want [u3]", pr.AssignedReviewers)
+	}
+
+	if pr.PullRequestName != created.PullRequestName || pr.AuthorId != created.AuthorId || pr.Status != domain.PRStatusOpen {
+		t.Errorf("UpdateReviewers = %+v, want the rest of %+v unchanged", pr, created)
+	}
+
+	got, err := repo.GetPR(ctx, "pr-1")
+	if err != nil || !slices.Equal(got.AssignedReviewers, []string{"u3"}) {
+		t.Errorf("GetPR = %+v, %v, want reviewers [u3]", got, err)
+	}
+}
+
+func testUpdateReviewersChanged(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSetIsActive(t, repo, "u3", false)
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, err := repo.UpdateReviewers(ctx, "pr-1", []string{"u3"}, []string{"u2"})
+	if !errors.Is(err, repository.ErrPRChanged) {
+		t.Errorf("UpdateReviewers with stale reviewers error = %v, want %v", err, repository.ErrPRChanged)
+	}
+
+	_, _, err = repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, time.Now())
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	for _, prID := range []string{"pr-1", "missing"} {
+		_, err = repo.UpdateReviewers(ctx, prID, []string{"u2"}, []string{"u3"})
+		if !errors.Is(err, repository.ErrPRChanged) {
+			t.Errorf("UpdateReviewers(%s) error = %v, want %v", prID, err, repository.ErrPRChanged)
+		}
+	}
+
+	pr, err := repo.GetPR(ctx, "pr-1")
+	if err != nil || !slices.Equal(pr.AssignedReviewers, []string{"u2"}) {
+		t.Errorf("GetPR = %+v, %v, want reviewers [u2] unchanged", pr, err)
+	}
+}
+
+func testReassign(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSetIsActive(t, repo, "u3", false)
+	created := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+	mustSetIsActive(t, repo, "u3", true)
+
+	pr, newReviewer, err := newService(repo).ReassignReviewer(ctx, "pr-1", "u2")
+	if err != nil {
+		t.Fatalf("ReassignReviewer: %v", err)
+	}
+
+	if newReviewer != "u3" {
+		t.Errorf("new reviewer = %q, want u3", newReviewer)
+	}
+
+	if !slices.Equal(pr.AssignedReviewers, []string{"u3"}) {
+		t.Errorf("AssignedReviewers = %v, want [u3]", pr.AssignedReviewers)
+	}
+
+	if pr.PullRequestName != created.PullRequestName || pr.AuthorId != created.AuthorId || pr.Status != domain.PRStatusOpen {
+		t.Errorf("ReassignReviewer = %+v, want the rest of %+v unchanged", pr, created)
+	}
+
+	reviews, err := repo.GetReviewers(ctx, "u2")
+	if err != nil {
+		t.Fatalf("GetReviewers: %v", err)
+	}
+
+	if len(reviews) != 0 {
+		t.Errorf("replaced reviewer still has reviews: %+v", reviews)
+	}
+}
+
+func testReassignFindsCandidate(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3", "u4"))
+
+	// Reviewer selection is random, repeat to cover every draw of the candidates.
+	for i := range 10 {
+		prID := fmt.Sprintf("pr-%d", i)
+		created := mustSavePR(t, repo, prID, "u1", time.Now())
+		old := created.AssignedReviewers[0]
+
+		pr, newReviewer, err := newService(repo).ReassignReviewer(ctx, prID, old)
+		if err != nil {
+			t.Fatalf("ReassignReviewer(%s): %v", prID, err)
+		}
+
+		if newReviewer == old || newReviewer == "u1" || newReviewer == created.AssignedReviewers[1] {
+			t.Fatalf("new reviewer = %q for %v, want the free teammate", newReviewer, created.AssignedReviewers)
+		}
+
+		want := []string{newReviewer, created.AssignedReviewers[1]}
+		if !slices.Equal(pr.AssignedReviewers, want) {
+			t.Fatalf("AssignedReviewers = %v, want %v", pr.AssignedReviewers, want)
+		}
+	}
+}
+
+func testReassignNotFound(t *testing.T, repo repository.Repository) {
+	_, _, err := newService(repo).ReassignReviewer(context.Background(), "missing", "u2")
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Fatalf("ReassignReviewer error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+}
+
+func testReassignMerged(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3", "u4"))
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, _, err := repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, time.Now())
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	_, _, err = newService(repo).ReassignReviewer(ctx, "pr-1", pr.AssignedReviewers[0])
+	if !errors.Is(err, service.ErrPRMerged) {
+		t.Fatalf("ReassignReviewer error = %v, want %v", err, service.ErrPRMerged)
+	}
+}
+
+func testReassignNotAssigned(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, _, err := newService(repo).ReassignReviewer(context.Background(), "pr-1", "u1")
+	if !errors.Is(err, service.ErrReviewerNotAssigned) {
+		t.Fatalf("ReassignReviewer error = %v, want %v", err, service.ErrReviewerNotAssigned)
+	}
+}
+
+func testReassignNoCandidate(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, _, err := newService(repo).ReassignReviewer(context.Background(), "pr-1", "u2")
+	if !errors.Is(err, service.ErrNoCandidate) {
+		t.Fatalf("ReassignReviewer error = %v, want %v", err, service.ErrNoCandidate)
+	}
+}
+
+func testReassignSkipsInactive(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+	mustSetIsActive(t, repo, "u3", false)
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, _, err := newService(repo).ReassignReviewer(context.Background(), "pr-1", "u2")
+	if !errors.Is(err, service.ErrNoCandidate) {
+		t.Fatalf("ReassignReviewer error = %v, want %v", err, service.ErrNoCandidate)
+	}
+}
+
+func testGetReviewersOrder(t *testing.T, repo repository.Repository) {
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSaveTeam(t, repo, newTeam("frontend", "u3", "u4"))
+
+	start := time.Now().Add(-time.Hour)
+	mustSavePR(t, repo, "pr-old", "u1", start)
+	mustSavePR(t, repo, "pr-new", "u1", start.Add(2*time.Minute))
+	mustSavePR(t, repo, "pr-mid", "u1", start.Add(time.Minute))
+	mustSavePR(t, repo, "pr-other", "u3", start)
+
+	prs, err := repo.GetReviewers(context.Background(), "u2")
+	if err != nil {
+		t.Fatalf("GetReviewers: %v", err)
+	}
+
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.PullRequestId
+
+		if pr.AuthorId != "u1" || pr.Status != domain.PRStatusOpen {
+			t.Errorf("GetReviewers[%d] = %+v, want author u1 and status OPEN", i, pr)
+		}
+	}
+
+	want := []string{"pr-new", "pr-mid", "pr-old"}
+	if !slices.Equal(ids, want) {
+		t.Errorf("GetReviewers = %v, want %v", ids, want)
+	}
+}
+
+func testGetReviewersEmpty(t *testing.T, repo repository.Repository) {
+	prs, err := repo.GetReviewers(context.Background(), "missing")
+	if err != nil {
+		t.Fatalf("GetReviewers: %v", err)
+	}
+
+	if prs == nil || len(prs) != 0 {
+		t.Errorf("GetReviewers = %#v, want an empty non-nil slice", prs)
+	}
+}
+
+func testGetStalePRs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	now := time.Now()
+	mustSavePR(t, repo, "pr-stale", "u1", now.Add(-48*time.Hour))
+	mustSavePR(t, repo, "pr-older", "u1", now.Add(-72*time.Hour))
+	mustSavePR(t, repo, "pr-merged", "u1", now.Add(-72*time.Hour))
+	mustSavePR(t, repo, "pr-fresh", "u1", now)
+
+	_, _, err := repo.SetPRStatus(ctx, "pr-merged", domain.PRStatusMerged, now)
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	prs, err := repo.GetStalePRs(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("GetStalePRs: %v", err)
+	}
+
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.PullRequestId
+	}
+
+	want := []string{"pr-older", "pr-stale"}
+	if !slices.Equal(ids, want) {
+		t.Errorf("GetStalePRs = %v, want %v", ids, want)
+	}
+}
+
+func testClaimReminders(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+
+	pr := newPR("pr-1", "u1", time.Now().Add(-72*time.Hour))
+	pr.AssignedReviewers = []string{"u3", "u2"}
+
+	err := repo.SavePR(ctx, pr)
+	if err != nil {
+		t.Fatalf("SavePR: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	window := 48 * time.Hour
+
+	claim := func(userIDs []string, at time.Time) []string {
+		t.Helper()
+
+		claimed, err := repo.ClaimReminders(ctx, "pr-1", userIDs, at.Add(-window), at)
+		if err != nil {
+			t.Fatalf("ClaimReminders: %v", err)
+		}
+
+		return claimed
+	}
+
+	if got := claim([]string{"u3"}, now); !slices.Equal(got, []string{"u3"}) {
+		t.Errorf("first claim = %v, want [u3]", got)
+	}
+
+	if got := claim([]string{"u3", "u2"}, now.Add(time.Hour)); !slices.Equal(got, []string{"u2"}) {
+		t.Errorf("claim within the window = %v, want only the reviewer not reminded yet [u2]", got)
+	}
+
+	if got := claim([]string{"u3", "u2"}, now.Add(2*time.Hour)); len(got) != 0 {
+		t.Errorf("repeated claim = %v, want none", got)
+	}
+
+	if got := claim([]string{"u3", "u2"}, now.Add(window+2*time.Hour)); !slices.Equal(got, []string{"u3", "u2"}) {
+		t.Errorf("claim after the window = %v, want [u3 u2]", got)
+	}
+}
+
+func testClaimRemindersNotFound(t *testing.T, repo repository.Repository) {
+	_, err := repo.ClaimReminders(context.Background(), "missing", []string{"u1"}, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Errorf("ClaimReminders error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+}
+
+func testClaimRemindersDeletedWithPR(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	pr := newPR("pr-1", "u1", time.Now().Add(-72*time.Hour))
+	pr.AssignedReviewers = []string{"u2"}
+
+	err := repo.SavePR(ctx, pr)
+	if err != nil {
+		t.Fatalf("SavePR: %v", err)
+	}
+
+	now := time.Now()
+
+	_, err = repo.ClaimReminders(ctx, "pr-1", pr.AssignedReviewers, now.Add(-time.Hour), now)
+	if err != nil {
+		t.Fatalf("ClaimReminders: %v", err)
+	}
+
+	_, _, err = repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	_, err = repo.DeleteMergedPRs(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteMergedPRs: %v", err)
+	}
+
+	err = repo.SavePR(ctx, pr)
+	if err != nil {
+		t.Fatalf("SavePR after delete: %v", err)
+	}
+
+	claimed, err := repo.ClaimReminders(ctx, "pr-1", pr.AssignedReviewers, now.Add(-time.Hour), now)
+	if err != nil || !slices.Equal(claimed, []string{"u2"}) {
+		t.Errorf("ClaimReminders on a recreated pull request = %v, %v, want [u2]", claimed, err)
+	}
+}
+
+func testGetStats(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	stats, err := repo.GetStats(ctx)
+	if err != nil {
+		t.Fatalf("GetStats on empty repository: %v", err)
+	}
+
+	if stats.OpenPRs != 0 || stats.MergedPRs != 0 || len(stats.Reviewers) != 0 {
+		t.Errorf("GetStats = %+v, want zero stats", stats)
+	}
+
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+	mustSavePR(t, repo, "pr-2", "u1", time.Now())
+	mustSavePR(t, repo, "pr-3", "u2", time.Now())
+
+	_, _, err = repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, time.Now())
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	stats, err = repo.GetStats(ctx)
+	if err != nil {
+		t.Fatalf("GetStats: %v", err)
+	}
+
+	want := []domain.ReviewerStats{{UserID: "u2", Assigned: 2, Open: 1}, {UserID: "u1", Assigned: 1, Open: 1}}
+	if stats.OpenPRs != 2 || stats.MergedPRs != 1 || !slices.Equal(stats.Reviewers, want) {
+		t.Errorf("GetStats = %+v, want 2 open, 1 merged and reviewers %+v", stats, want)
+	}
+}
+
+func testArchiveTeam(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+
+	_, err := repo.ArchiveUser(ctx, "u3", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("ArchiveUser: %v", err)
+	}
+
+	archivedAt, err := repo.ArchiveTeam(ctx, "backend", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveTeam: %v", err)
+	}
+
+	again, err := repo.ArchiveTeam(ctx, "backend", time.Now().Add(time.Hour))
+	if err != nil || !sameInstant(again, archivedAt) {
+		t.Errorf("ArchiveTeam again = %v, %v, want the original %v", again, err, archivedAt)
+	}
+
+	_, err = repo.GetTeam(ctx, "backend")
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Errorf("GetTeam error = %v, want %v", err, repository.ErrTeamNotFound)
+	}
+
+	_, err = repo.GetUser(ctx, "u1")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("GetUser error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+
+	_, err = newService(repo).SavePR(ctx, newPR("pr-1", "u1", time.Now()))
+	if err == nil {
+		t.Errorf("SavePR by a member of an archived team succeeded")
+	}
+
+	err = repo.RestoreTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("RestoreTeam: %v", err)
+	}
+
+	team, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if !sameMembers(team.Members, newTeam("backend", "u1", "u2").Members) {
+		t.Errorf("Members = %+v, want u1 and u2, u3 was archived on its own", team.Members)
+	}
+}
+
+func testArchiveUser(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2", "u3"))
+
+	_, err := repo.ArchiveUser(ctx, "u2", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveUser: %v", err)
+	}
+
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+	if !slices.Equal(pr.AssignedReviewers, []string{"u3"}) {
+		t.Errorf("AssignedReviewers = %v, want only u3", pr.AssignedReviewers)
+	}
+
+	team, err := repo.GetTeam(ctx, "backend")
+	if err != nil || len(team.Members) != 2 {
+		t.Errorf("GetTeam = %+v, %v, want u1 and u3", team, err)
+	}
+
+	_, err = repo.SetIsActive(ctx, "u2", false)
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("SetIsActive error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+
+	err = repo.RestoreUser(ctx, "u2")
+	if err != nil {
+		t.Fatalf("RestoreUser: %v", err)
+	}
+
+	_, err = repo.GetUser(ctx, "u2")
+	if err != nil {
+		t.Errorf("GetUser after restore: %v", err)
+	}
+}
+
+func testRestoreUserOfArchivedTeam(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1"))
+
+	_, err := repo.ArchiveTeam(ctx, "backend", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveTeam: %v", err)
+	}
+
+	err = repo.RestoreUser(ctx, "u1")
+	if !errors.Is(err, repository.ErrTeamArchived) {
+		t.Fatalf("RestoreUser error = %v, want %v", err, repository.ErrTeamArchived)
+	}
+
+	_, err = repo.GetUser(ctx, "u1")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("user was restored anyway: GetUser error = %v", err)
+	}
+}
+
+func testArchivePR(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now().Add(-72*time.Hour))
+
+	_, err := repo.ArchivePR(ctx, "pr-1", time.Now())
+	if err != nil {
+		t.Fatalf("ArchivePR: %v", err)
+	}
+
+	prs, err := repo.GetReviewers(ctx, "u2")
+	if err != nil || len(prs) != 0 {
+		t.Errorf("GetReviewers = %+v, %v, want none", prs, err)
+	}
+
+	stale, err := repo.GetStalePRs(ctx, time.Now())
+	if err != nil || len(stale) != 0 {
+		t.Errorf("GetStalePRs = %+v, %v, want none", stale, err)
+	}
+
+	stats, err := repo.GetStats(ctx)
+	if err != nil || stats.OpenPRs != 0 || len(stats.Reviewers) != 0 {
+		t.Errorf("GetStats = %+v, %v, want nothing counted", stats, err)
+	}
+
+	_, _, err = repo.SetPRStatus(ctx, "pr-1", domain.PRStatusMerged, time.Now())
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Errorf("SetPRStatus error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+
+	err = repo.SavePR(ctx, newPR("pr-1", "u1", time.Now()))
+	if !errors.Is(err, repository.ErrPRAlreadyExists) {
+		t.Errorf("SavePR error = %v, want %v", err, repository.ErrPRAlreadyExists)
+	}
+
+	err = repo.RestorePR(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("RestorePR: %v", err)
+	}
+
+	prs, err = repo.GetReviewers(ctx, "u2")
+	if err != nil || len(prs) != 1 {
+		t.Errorf("GetReviewers after restore = %+v, %v, want pr-1", prs, err)
+	}
+}
+
+func testArchiveNotFound(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.ArchiveTeam(ctx, "missing", time.Now())
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Errorf("ArchiveTeam error = %v, want %v", err, repository.ErrTeamNotFound)
+	}
+
+	err = repo.RestoreTeam(ctx, "missing")
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Errorf("RestoreTeam error = %v, want %v", err, repository.ErrTeamNotFound)
+	}
+
+	_, err = repo.ArchiveUser(ctx, "missing", time.Now())
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("ArchiveUser error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+
+	err = repo.RestoreUser(ctx, "missing")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("RestoreUser error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+
+	_, err = repo.ArchivePR(ctx, "missing", time.Now())
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Errorf("ArchivePR error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+
+	err = repo.RestorePR(ctx, "missing")
+	if !errors.Is(err, repository.ErrPRNotFound) {
+		t.Errorf("RestorePR error = %v, want %v", err, repository.ErrPRNotFound)
+	}
+}
+
+func testImportRestoresArchived(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	_, err := repo.ArchiveTeam(ctx, "backend", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveTeam: %v", err)
+	}
+
+	err = repo.ImportTeams(ctx, []domain.Team{*newTeam("backend", "u1")})
+	if err != nil {
+		t.Fatalf("ImportTeams: %v", err)
+	}
+
+	team, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if !sameMembers(team.Members, newTeam("backend", "u1").Members) {
+		t.Errorf("Members = %+v, want only the imported u1", team.Members)
+	}
+}
+
+func testDeleteMergedPRs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	now := time.Now()
+	for _, id := range []string{"pr-old", "pr-recent", "pr-open", "pr-archived"} {
+		mustSavePR(t, repo, id, "u1", now.Add(-90*24*time.Hour))
+	}
+
+	for id, mergedAt := range map[string]time.Time{
+		"pr-old":      now.Add(-60 * 24 * time.Hour),
+		"pr-recent":   now.Add(-time.Hour),
+		"pr-archived": now.Add(-60 * 24 * time.Hour),
+	} {
+		_, _, err := repo.SetPRStatus(ctx, id, domain.PRStatusMerged, mergedAt)
+		if err != nil {
+			t.Fatalf("SetPRStatus(%s): %v", id, err)
+		}
+	}
+
+	_, err := repo.ArchivePR(ctx, "pr-archived", now)
+	if err != nil {
+		t.Fatalf("ArchivePR: %v", err)
+	}
+
+	deleted, err := repo.DeleteMergedPRs(ctx, now.Add(-30*24*time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteMergedPRs: %v", err)
+	}
+
+	if deleted != 2 {
+		t.Errorf("DeleteMergedPRs = %d, want 2", deleted)
+	}
+
+	prs, err := repo.GetReviewers(ctx, "u2")
+	if err != nil {
+		t.Fatalf("GetReviewers: %v", err)
+	}
+
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.PullRequestId
+	}
+	slices.Sort(ids)
+
+	if want := []string{"pr-open", "pr-recent"}; !slices.Equal(ids, want) {
+		t.Errorf("remaining pull requests = %v, want %v", ids, want)
+	}
+}
+
+func testEvents(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	lastID, err := repo.GetLastEventID(ctx)
+	if err != nil || lastID != 0 {
+		t.Fatalf("GetLastEventID = %d, %v, want 0 without events", lastID, err)
+	}
+
+	var ids []int64
+	for _, team := range []string{"backend", "frontend", "backend"} {
+		event, err := repo.SaveEvent(ctx, domain.Event{
+			Type:        "ASSIGNED",
+			TeamName:    team,
+			PullRequest: *pr,
+			UserIDs:     []string{"u1", "u2"},
+			Actor:       "key-" + team,
+		})
+		if err != nil {
+			t.Fatalf("SaveEvent: %v", err)
+		}
+
+		if len(ids) > 0 && event.EventID <= ids[len(ids)-1] {
+			t.Fatalf("EventID = %d, want ids to increase after %d", event.EventID, ids[len(ids)-1])
+		}
+
+		ids = append(ids, event.EventID)
+	}
+
+	lastID, err = repo.GetLastEventID(ctx)
+	if err != nil || lastID != ids[2] {
+		t.Errorf("GetLastEventID = %d, %v, want %d", lastID, err, ids[2])
+	}
+
+	got, err := repo.GetEventsAfter(ctx, ids[0], domain.EventFilter{TeamName: "backend"}, 10)
+	if err != nil {
+		t.Fatalf("GetEventsAfter: %v", err)
+	}
+
+	if len(got) != 1 || got[0].EventID != ids[2] || !slices.Equal(got[0].PullRequest.AssignedReviewers, pr.AssignedReviewers) ||
+		got[0].Actor != "key-backend" {
+		t.Errorf("GetEventsAfter(team) = %+v, want only event %d", got, ids[2])
+	}
+
+	got, err = repo.GetEventsAfter(ctx, 0, domain.EventFilter{UserID: "u2"}, 2)
+	if err != nil {
+		t.Fatalf("GetEventsAfter: %v", err)
+	}
+
+	if len(got) != 2 || got[0].EventID != ids[0] || got[1].EventID != ids[1] {
+		t.Errorf("GetEventsAfter(user, limit 2) = %+v, want events %v", got, ids[:2])
+	}
+
+	got, err = repo.GetEventsAfter(ctx, 0, domain.EventFilter{UserID: "u3"}, 10)
+	if err != nil {
+		t.Fatalf("GetEventsAfter: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("GetEventsAfter(unknown user) = %+v, want none", got)
+	}
+}
+
+func testAPIKeys(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	first := domain.APIKey{KeyID: "k1", Name: "ci", Hash: []byte("hash-1"), Scopes: []string{"write"}, CreatedAt: now}
+	expiresAt := now.Add(24 * time.Hour)
+	second := domain.APIKey{KeyID: "k2", Name: "ops", Hash: []byte("hash-2"), Scopes: []string{"admin", "read"}, CreatedAt: now.Add(time.Minute), ExpiresAt: &expiresAt}
+
+	for _, key := range []domain.APIKey{second, first} {
+		err := repo.SaveAPIKey(ctx, key)
+		if err != nil {
+			t.Fatalf("SaveAPIKey(%s): %v", key.KeyID, err)
+		}
+	}
+
+	got, err := repo.GetAPIKeyByHash(ctx, []byte("hash-2"))
+	if err != nil {
+		t.Fatalf("GetAPIKeyByHash: %v", err)
+	}
+
+	if got.KeyID != "k2" || got.Name != "ops" || !slices.Equal(got.Scopes, second.Scopes) ||
+		!sameInstant(got.CreatedAt, second.CreatedAt) || got.RevokedAt != nil ||
+		got.ExpiresAt == nil || !sameInstant(*got.ExpiresAt, expiresAt) {
+		t.Errorf("GetAPIKeyByHash = %+v, want %+v", got, second)
+	}
+
+	_, err = repo.GetAPIKeyByHash(ctx, []byte("unknown"))
+	if !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("GetAPIKeyByHash(unknown) error = %v, want %v", err, repository.ErrAPIKeyNotFound)
+	}
+
+	revokedAt := now.Add(time.Hour)
+
+	revoked, err := repo.RevokeAPIKey(ctx, "k1", revokedAt)
+	if err != nil {
+		t.Fatalf("RevokeAPIKey: %v", err)
+	}
+
+	if revoked.RevokedAt == nil || !sameInstant(*revoked.RevokedAt, revokedAt) {
+		t.Errorf("RevokeAPIKey RevokedAt = %v, want %v", revoked.RevokedAt, revokedAt)
+	}
+
+	if revoked.ExpiresAt != nil {
+		t.Errorf("RevokeAPIKey ExpiresAt = %v, want none", revoked.ExpiresAt)
+	}
+
+	revoked, err = repo.RevokeAPIKey(ctx, "k1", revokedAt.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("RevokeAPIKey again: %v", err)
+	}
+
+	if revoked.RevokedAt == nil || !sameInstant(*revoked.RevokedAt, revokedAt) {
+		t.Errorf("RevokeAPIKey again RevokedAt = %v, want the first %v", revoked.RevokedAt, revokedAt)
+	}
+
+	_, err = repo.RevokeAPIKey(ctx, "unknown", revokedAt)
+	if !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("RevokeAPIKey(unknown) error = %v, want %v", err, repository.ErrAPIKeyNotFound)
+	}
+
+	keys, err := repo.ListAPIKeys(ctx)
+	if err != nil {
+		t.Fatalf("ListAPIKeys: %v", err)
+	}
+
+	if len(keys) != 2 || keys[0].KeyID != "k1" || keys[1].KeyID != "k2" || keys[0].RevokedAt == nil || keys[1].RevokedAt != nil {
+		t.Errorf("ListAPIKeys = %+v, want k1 revoked then k2", keys)
+	}
+}
+
+func testAPIKeyDuplicate(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	err := repo.SaveAPIKey(ctx, domain.APIKey{KeyID: "k1", Name: "ci", Hash: []byte("hash-1"), Scopes: []string{"read"}, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("SaveAPIKey: %v", err)
+	}
+
+	err = repo.SaveAPIKey(ctx, domain.APIKey{KeyID: "k2", Name: "ci", Hash: []byte("hash-1"), Scopes: []string{"read"}, CreatedAt: time.Now()})
+	if !errors.Is(err, repository.ErrDuplicateKey) {
+		t.Errorf("SaveAPIKey(same hash) error = %v, want %v", err, repository.ErrDuplicateKey)
+	}
+}
+
+func testIdempotencyKeys(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now()
+	key := domain.IdempotencyKey{Scope: "k1", Key: "retry-1", RequestHash: []byte("hash-1"), CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	err := repo.SaveIdempotencyKey(ctx, key)
+	if err != nil {
+		t.Fatalf("SaveIdempotencyKey: %v", err)
+	}
+
+	err = repo.SaveIdempotencyKey(ctx, key)
+	if !errors.Is(err, repository.ErrDuplicateKey) {
+		t.Errorf("SaveIdempotencyKey(live key) error = %v, want %v", err, repository.ErrDuplicateKey)
+	}
+
+	// The same key of another caller is a different key.
+	other := key
+	other.Scope = "k2"
+
+	err = repo.SaveIdempotencyKey(ctx, other)
+	if err != nil {
+		t.Errorf("SaveIdempotencyKey(other scope): %v", err)
+	}
+
+	got, err := repo.GetIdempotencyKey(ctx, "k1", "retry-1")
+	if err != nil {
+		t.Fatalf("GetIdempotencyKey: %v", err)
+	}
+
+	if got.StatusCode != 0 || string(got.RequestHash) != "hash-1" || !sameInstant(got.ExpiresAt, key.ExpiresAt) {
+		t.Errorf("GetIdempotencyKey = %+v, want an in-progress key", *got)
+	}
+
+	err = repo.CompleteIdempotencyKey(ctx, "k1", "retry-1", 201, "application/json", []byte(`{"pr":{}}`))
+	if err != nil {
+		t.Fatalf("CompleteIdempotencyKey: %v", err)
+	}
+
+	got, err = repo.GetIdempotencyKey(ctx, "k1", "retry-1")
+	if err != nil {
+		t.Fatalf("GetIdempotencyKey: %v", err)
+	}
+
+	if got.StatusCode != 201 || got.ContentType != "application/json" || string(got.Body) != `{"pr":{}}` {
+		t.Errorf("GetIdempotencyKey = %+v, want the stored response", *got)
+	}
+
+	err = repo.DeleteIdempotencyKey(ctx, "k1", "retry-1")
+	if err != nil {
+		t.Fatalf("DeleteIdempotencyKey: %v", err)
+	}
+
+	_, err = repo.GetIdempotencyKey(ctx, "k1", "retry-1")
+	if !errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+		t.Errorf("GetIdempotencyKey error = %v, want %v", err, repository.ErrIdempotencyKeyNotFound)
+	}
+
+	err = repo.CompleteIdempotencyKey(ctx, "k1", "retry-1", 201, "application/json", nil)
+	if !errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+		t.Errorf("CompleteIdempotencyKey error = %v, want %v", err, repository.ErrIdempotencyKeyNotFound)
+	}
+}
+
+func testIdempotencyKeysExpired(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	now := time.Now()
+	key := domain.IdempotencyKey{Scope: "k1", Key: "retry-1", RequestHash: []byte("hash-1"), CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+
+	err := repo.SaveIdempotencyKey(ctx, key)
+	if err != nil {
+		t.Fatalf("SaveIdempotencyKey: %v", err)
+	}
+
+	err = repo.CompleteIdempotencyKey(ctx, "k1", "retry-1", 200, "application/json", []byte("{}"))
+	if err != nil {
+		t.Fatalf("CompleteIdempotencyKey: %v", err)
+	}
+
+	// An expired key is reserved again from scratch.
+	err = repo.SaveIdempotencyKey(ctx, domain.IdempotencyKey{Scope: "k1", Key: "retry-1", RequestHash: []byte("hash-2"), CreatedAt: now, ExpiresAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("SaveIdempotencyKey(expired key): %v", err)
+	}
+
+	got, err := repo.GetIdempotencyKey(ctx, "k1", "retry-1")
+	if err != nil {
+		t.Fatalf("GetIdempotencyKey: %v", err)
+	}
+
+	if got.StatusCode != 0 || string(got.RequestHash) != "hash-2" || len(got.Body) != 0 {
+		t.Errorf("GetIdempotencyKey = %+v, want a fresh in-progress key", *got)
+	}
+
+	err = repo.SaveIdempotencyKey(ctx, domain.IdempotencyKey{Scope: "k1", Key: "retry-2", RequestHash: []byte("hash-3"), CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)})
+	if err != nil {
+		t.Fatalf("SaveIdempotencyKey: %v", err)
+	}
+
+	deleted, err := repo.DeleteExpiredIdempotencyKeys(ctx, now)
+	if err != nil {
+		t.Fatalf("DeleteExpiredIdempotencyKeys: %v", err)
+	}
+
+	if deleted != 1 {
+		t.Errorf("DeleteExpiredIdempotencyKeys = %d, want 1", deleted)
+	}
+
+	_, err = repo.GetIdempotencyKey(ctx, "k1", "retry-1")
+	if err != nil {
+		t.Errorf("GetIdempotencyKey(live key): %v", err)
+	}
+}
+
+func testDumpRestore(t *testing.T, repo repository.Repository) {
+	dumper := mustDumper(t, repo)
+	ctx := context.Background()
+
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSaveTeam(t, repo, &domain.Team{TeamName: "empty"})
+	pr := mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	_, _, err := repo.SetPRStatus(ctx, pr.PullRequestId, domain.PRStatusMerged, time.Now())
+	if err != nil {
+		t.Fatalf("SetPRStatus: %v", err)
+	}
+
+	_, err = repo.ArchiveTeam(ctx, "empty", time.Now())
+	if err != nil {
+		t.Fatalf("ArchiveTeam: %v", err)
+	}
+
+	snapshot, err := dumper.Dump(ctx)
+	if err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+
+	if len(snapshot.Teams) != 2 || snapshot.Teams[0].TeamName != "backend" || len(snapshot.Teams[0].Members) != 2 {
+		t.Fatalf("Dump teams = %+v, want backend with 2 members and empty", snapshot.Teams)
+	}
+
+	if len(snapshot.PullRequests) != 1 || snapshot.PullRequests[0].MergedAt == nil {
+		t.Fatalf("Dump pull requests = %+v, want merged pr-1", snapshot.PullRequests)
+	}
+
+	err = dumper.Restore(ctx, snapshot, true)
+	if err != nil {
+		t.Fatalf("Restore(replace): %v", err)
+	}
+
+	again, err := dumper.Dump(ctx)
+	if err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+
+	if len(again.Teams) != 2 || !sameMembers(again.Teams[0].Members, snapshot.Teams[0].Members) {
+		t.Errorf("teams after round trip = %+v, want %+v", again.Teams, snapshot.Teams)
+	}
+
+	if again.Teams[1].ArchivedAt == nil || !sameInstant(*again.Teams[1].ArchivedAt, *snapshot.Teams[1].ArchivedAt) {
+		t.Errorf("archived_at of empty after round trip = %v, want %v", again.Teams[1].ArchivedAt, snapshot.Teams[1].ArchivedAt)
+	}
+
+	got := again.PullRequests[0]
+	want := snapshot.PullRequests[0]
+	if got.Status != want.Status || !slices.Equal(got.AssignedReviewers, want.AssignedReviewers) || !got.MergedAt.Equal(*want.MergedAt) {
+		t.Errorf("pull request after round trip = %+v, want %+v", got, want)
+	}
+}
+
+func testRestoreMerge(t *testing.T, repo repository.Repository) {
+	dumper := mustDumper(t, repo)
+	ctx := context.Background()
+
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+
+	err := dumper.Restore(ctx, &domain.Snapshot{Teams: []domain.Team{*newTeam("backend", "u3")}}, false)
+	if err != nil {
+		t.Fatalf("Restore(merge): %v", err)
+	}
+
+	team, err := repo.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("GetTeam: %v", err)
+	}
+
+	if len(team.Members) != 3 {
+		t.Errorf("Members = %+v, want u1, u2 and u3", team.Members)
+	}
+
+	err = dumper.Restore(ctx, &domain.Snapshot{Teams: []domain.Team{*newTeam("frontend", "u4", "u1")}}, false)
+	if !errors.Is(err, repository.ErrDuplicateKey) {
+		t.Fatalf("Restore error = %v, want %v", err, repository.ErrDuplicateKey)
+	}
+
+	_, err = repo.GetUser(ctx, "u4")
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("failed restore was partially applied: GetUser error = %v", err)
+	}
+
+	created := time.Now()
+	err = dumper.Restore(ctx, &domain.Snapshot{PullRequests: []domain.PullRequest{
+		{PullRequestId: "pr-1", PullRequestName: "pr-1", AuthorId: "ghost", Status: domain.PRStatusOpen, AssignedReviewers: []string{}, CreatedAt: &created},
+	}}, false)
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		t.Errorf("Restore error = %v, want %v", err, repository.ErrUserNotFound)
+	}
+}
+
+func testRestoreReplace(t *testing.T, repo repository.Repository) {
+	dumper := mustDumper(t, repo)
+	ctx := context.Background()
+
+	mustSaveTeam(t, repo, newTeam("backend", "u1", "u2"))
+	mustSavePR(t, repo, "pr-1", "u1", time.Now())
+
+	err := dumper.Restore(ctx, &domain.Snapshot{Teams: []domain.Team{*newTeam("frontend", "u1")}}, true)
+	if err != nil {
+		t.Fatalf("Restore(replace): %v", err)
+	}
+
+	_, err = repo.GetTeam(ctx, "backend")
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		t.Errorf("GetTeam(backend) error = %v, want %v", err, repository.ErrTeamNotFound)
+	}
+
+	user, err := repo.GetUser(ctx, "u1")
+	if err != nil || user.TeamName != "frontend" {
+		t.Errorf("GetUser(u1) = %+v, %v, want member of frontend", user, err)
+	}
+
+	prs, err := repo.GetReviewers(ctx, "u2")
+	if err != nil || len(prs) != 0 {
+		t.Errorf("GetReviewers(u2) = %+v, %v, want none", prs, err)
+	}
+}
+
+func mustDumper(t *testing.T, repo repository.Repository) repository.Dumper {
+	t.Helper()
+
+	dumper, ok := repo.(repository.Dumper)
+	if !ok {
+		t.Skip("repository does not implement repository.Dumper")
+	}
+
+	return dumper
+}
+
+func newTeam(name string, userIDs ...string) *domain.Team {
+	members := make([]domain.TeamMember, len(userIDs))
+	for i, id := range userIDs {
+		members[i] = domain.TeamMember{UserID: id, UserName: id, IsActive: true, Role: domain.RoleMember}
+	}
+
+	return &domain.Team{TeamName: name, Members: members}
+}
+
+func newPR(prID string, authorID string, createdAt time.Time) domain.PullRequest {
+	return domain.PullRequest{
+		PullRequestId:   prID,
+		PullRequestName: "name of " + prID,
+		AuthorId:        authorID,
+		Status:          domain.PRStatusOpen,
+		CreatedAt:       &createdAt,
+	}
+}
+
+func mustSaveTeam(t *testing.T, repo repository.Repository, team *domain.Team) {
+	t.Helper()
+
+	err := repo.SaveTeam(context.Background(), team)
+	if err != nil {
+		t.Fatalf("SaveTeam(%s): %v", team.TeamName, err)
+	}
+}
+
+func mustSavePR(t *testing.T, repo repository.Repository, prID string, authorID string, createdAt time.Time) *domain.PullRequest {
+	t.Helper()
+
+	pr, err := newService(repo).SavePR(context.Background(), newPR(prID, authorID, createdAt))
+	if err != nil {
+		t.Fatalf("SavePR(%s): %v", prID, err)
+	}
+
+	return pr
+}
+
+// newService picks reviewers the way the running service does, without notifications.
+func newService(repo repository.Repository) *service.Service {
+	return service.New(repo, notifier.Multi{}, rand.New(rand.NewPCG(1, 2)), zap.NewNop())
+}
+
+// recorder keeps the events the service sends.
+type recorder struct {
+	mu     sync.Mutex
+	events []notifier.Event
+}
+
+func (r *recorder) Notify(_ context.Context, event notifier.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+	return nil
+}
+
+func mustSetIsActive(t *testing.T, repo repository.Repository, userID string, isActive bool) {
+	t.Helper()
+
+	_, err := repo.SetIsActive(context.Background(), userID, isActive)
+	if err != nil {
+		t.Fatalf("SetIsActive(%s): %v", userID, err)
+	}
+}
+
+func sameMembers(got, want []domain.TeamMember) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for _, m := range want {
+		if !slices.Contains(got, m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameInstant compares at microsecond precision, which is what timestamptz keeps.
+func sameInstant(a, b time.Time) bool {
+	return a.Truncate(time.Microsecond).Equal(b.Truncate(time.Microsecond))
+}
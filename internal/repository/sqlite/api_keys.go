@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) SaveAPIKey(ctx context.Context, key domain.APIKey) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.db.ExecContext(ctx, querySaveAPIKey,
+		key.KeyID, key.Name, key.Hash, textArray(key.Scopes), formatTime(&key.CreatedAt), formatTime(key.ExpiresAt))
+	if err != nil {
+		if isConstraintViolation(err) {
+			c.logger.Error("failed to save api key: duplicate key", zap.String("key_id", key.KeyID))
+			return repository.ErrDuplicateKey
+		}
+
+		c.logger.Error("failed to save api key", zap.String("key_id", key.KeyID), zap.Error(err))
+		return fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	c.logger.Info("successfully saved api key", zap.String("key_id", key.KeyID))
+	return nil
+}
+
+func (c *Client) GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	key, err := scanAPIKey(c.db.QueryRowContext(ctx, queryGetAPIKeyByHash, hash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrAPIKeyNotFound
+		}
+
+		c.logger.Error("failed to get api key", zap.Error(err))
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, queryListAPIKeys)
+	if err != nil {
+		c.logger.Error("failed to list api keys", zap.Error(err))
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			c.logger.Error("failed to scan api key", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+
+		keys = append(keys, *key)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, keyID string, revokedAt time.Time) (*domain.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	key, err := scanAPIKey(c.db.QueryRowContext(ctx, queryRevokeAPIKey, keyID, formatTime(&revokedAt)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrAPIKeyNotFound.Error(), zap.String("key_id", keyID))
+			return nil, repository.ErrAPIKeyNotFound
+		}
+
+		c.logger.Error("failed to revoke api key", zap.String("key_id", keyID), zap.Error(err))
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	c.logger.Info("successfully revoked api key", zap.String("key_id", keyID))
+	return key, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var createdAt *time.Time
+
+	err := row.Scan(&key.KeyID, &key.Name, &key.Hash, (*textArray)(&key.Scopes), timestamp{&createdAt},
+		timestamp{&key.RevokedAt}, timestamp{&key.ExpiresAt})
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt != nil {
+		key.CreatedAt = *createdAt
+	}
+
+	return &key, nil
+}
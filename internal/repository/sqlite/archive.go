@@ -0,0 +1,227 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) ArchiveTeam(ctx context.Context, teamName string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var stored *time.Time
+
+	err = tx.QueryRowContext(ctx, queryArchiveTeam, teamName, formatTime(&archivedAt)).Scan(timestamp{&stored})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+			return time.Time{}, repository.ErrTeamNotFound
+		}
+
+		c.logger.Error("failed to archive team", zap.String("team_name", teamName), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive team: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, queryArchiveTeamMembers, teamName, formatTime(stored))
+	if err != nil {
+		c.logger.Error("failed to archive team members", zap.String("team_name", teamName), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive team members: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully archived team", zap.String("team_name", teamName))
+	return *stored, nil
+}
+
+func (c *Client) RestoreTeam(ctx context.Context, teamName string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var archivedAt *time.Time
+
+	err = tx.QueryRowContext(ctx, queryGetTeamArchivedAt, teamName).Scan(timestamp{&archivedAt})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+			return repository.ErrTeamNotFound
+		}
+
+		c.logger.Error("failed to get team", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if archivedAt == nil {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, queryUnarchiveTeamMembers, teamName, formatTime(archivedAt))
+	if err != nil {
+		c.logger.Error("failed to restore team members", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to restore team members: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, queryUnarchiveTeam, teamName)
+	if err != nil {
+		c.logger.Error("failed to restore team", zap.String("team_name", teamName), zap.Error(err))
+		return fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored team", zap.String("team_name", teamName))
+	return nil
+}
+
+func (c *Client) ArchiveUser(ctx context.Context, userID string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stored *time.Time
+
+	err := c.db.QueryRowContext(ctx, queryArchiveUser, userID, formatTime(&archivedAt)).Scan(timestamp{&stored})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return time.Time{}, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to archive user", zap.String("user_id", userID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive user: %w", err)
+	}
+
+	c.logger.Info("successfully archived user", zap.String("user_id", userID))
+	return *stored, nil
+}
+
+func (c *Client) RestoreUser(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var teamArchived bool
+
+	err = tx.QueryRowContext(ctx, queryUnarchiveUser, userID).Scan(&teamArchived)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to restore user", zap.String("user_id", userID), zap.Error(err))
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	if teamArchived {
+		c.logger.Warn(repository.ErrTeamArchived.Error(), zap.String("user_id", userID))
+		return repository.ErrTeamArchived
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored user", zap.String("user_id", userID))
+	return nil
+}
+
+func (c *Client) ArchivePR(ctx context.Context, prID string, archivedAt time.Time) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stored *time.Time
+
+	err := c.db.QueryRowContext(ctx, queryArchivePR, prID, formatTime(&archivedAt)).Scan(timestamp{&stored})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+			return time.Time{}, repository.ErrPRNotFound
+		}
+
+		c.logger.Error("failed to archive pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to archive pull request: %w", err)
+	}
+
+	c.logger.Info("successfully archived pull request", zap.String("pull_request_id", prID))
+	return *stored, nil
+}
+
+func (c *Client) RestorePR(ctx context.Context, prID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.db.ExecContext(ctx, queryUnarchivePR, prID)
+	if err != nil {
+		c.logger.Error("failed to restore pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return fmt.Errorf("failed to restore pull request: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to restore pull request: %w", err)
+	}
+
+	if affected == 0 {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return repository.ErrPRNotFound
+	}
+
+	c.logger.Info("successfully restored pull request", zap.String("pull_request_id", prID))
+	return nil
+}
+
+func (c *Client) DeleteMergedPRs(ctx context.Context, mergedBefore time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.db.ExecContext(ctx, queryDeleteMergedPRs, formatTime(&mergedBefore))
+	if err != nil {
+		c.logger.Error("failed to delete merged pull requests", zap.Error(err))
+		return 0, fmt.Errorf("failed to delete merged pull requests: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete merged pull requests: %w", err)
+	}
+
+	c.logger.Info("successfully deleted merged pull requests", zap.Int64("prs", deleted))
+	return deleted, nil
+}
@@ -0,0 +1,662 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	moderncsqlite "modernc.org/sqlite"
+	sqlitelib "modernc.org/sqlite/lib"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func New(ctx context.Context, config *Config, logger *zap.Logger) (*Client, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	db, err := sql.Open("sqlite", buildDSN(config))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+
+	// SQLite allows a single writer, one connection avoids SQLITE_BUSY and keeps :memory: databases shared.
+	db.SetMaxOpenConns(1)
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping sqlite: %w", err)
+	}
+
+	err = migrateUp(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Client{
+		db:      db,
+		logger:  logger,
+		timeout: config.Timeout,
+	}, nil
+}
+
+func (c *Client) SaveTeam(ctx context.Context, team *domain.Team) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	exists, err := c.teamExists(ctx, team.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		c.logger.Warn(repository.ErrTeamAlreadyExists.Error(), zap.Any("team_name", team.TeamName))
+		return fmt.Errorf("%w: %s", repository.ErrTeamAlreadyExists, team.TeamName)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, querySetTeamName, team.TeamName)
+	if err != nil {
+		c.logger.Error("failed to set team name", zap.Error(err), zap.String("team_name", team.TeamName))
+		return fmt.Errorf("failed to set team name: %s: %w", team.TeamName, err)
+	}
+
+	for _, member := range team.Members {
+		_, err = tx.ExecContext(ctx, querySaveTeamMember, member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email)
+		if err != nil {
+			if isConstraintViolation(err) {
+				c.logger.Error("failed to save team member: duplicate key", zap.String("user_id", member.UserID))
+				return repository.ErrDuplicateKey
+			}
+
+			c.logger.Error("failed to save team member", zap.Error(err), zap.String("user_id", member.UserID))
+			return fmt.Errorf("failed to save team member: %s: %w", member.UserID, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully stored team to database", zap.String("team_name", team.TeamName))
+	return nil
+}
+
+func (c *Client) ImportTeams(ctx context.Context, teams []domain.Team) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, team := range teams {
+		_, err = tx.ExecContext(ctx, queryEnsureTeam, team.TeamName)
+		if err != nil {
+			c.logger.Error("failed to create team", zap.String("team_name", team.TeamName), zap.Error(err))
+			return fmt.Errorf("failed to create team: %s: %w", team.TeamName, err)
+		}
+
+		for _, member := range team.Members {
+			_, err = tx.ExecContext(ctx, queryUpsertTeamMember, member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email)
+			if err != nil {
+				c.logger.Error("failed to upsert team member", zap.String("user_id", member.UserID), zap.Error(err))
+				return fmt.Errorf("failed to upsert team member: %s: %w", member.UserID, err)
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully imported teams", zap.Int("teams", len(teams)))
+	return nil
+}
+
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	exists, err := c.activeTeamExists(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		c.logger.Warn(repository.ErrTeamNotFound.Error(), zap.String("team_name", teamName))
+		return nil, repository.ErrTeamNotFound
+	}
+
+	rows, err := c.db.QueryContext(ctx, queryGetTeam, teamName)
+	if err != nil {
+		c.logger.Error("failed to get team member", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("failed to get team member: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]domain.TeamMember, 0)
+	for rows.Next() {
+		var member domain.TeamMember
+
+		err = rows.Scan(&member.UserID, &member.UserName, &member.Email, &member.IsActive, &member.Role)
+		if err != nil {
+			c.logger.Error("failed to scan member", zap.String("team_name", teamName), zap.Error(err))
+			return nil, fmt.Errorf("failed to scan member: %w", err)
+		}
+
+		members = append(members, member)
+	}
+	err = rows.Err()
+	if err != nil {
+		c.logger.Error("rows error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	c.logger.Info("successfully retrieved team members", zap.String("team_name", teamName))
+	return &domain.Team{
+		TeamName: teamName,
+		Members:  members,
+	}, nil
+}
+
+func (c *Client) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var user domain.User
+	err := c.db.QueryRowContext(ctx, querySetIsActive, userID, isActive).
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return nil, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to set is_active", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to set is_active: %w", err)
+	}
+
+	c.logger.Info("successfully set is_active", zap.String("user_id", userID))
+	return &user, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var user domain.User
+	err := c.db.QueryRowContext(ctx, queryGetUser, userID).
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return nil, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to get user", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	c.logger.Info("successfully got user", zap.String("user_id", userID))
+	return &user, nil
+}
+
+func (c *Client) SetUserRole(ctx context.Context, userID string, role string) (*domain.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var user domain.User
+	err := c.db.QueryRowContext(ctx, querySetUserRole, userID, role).
+		Scan(&user.UserID, &user.UserName, &user.Email, &user.TeamName, &user.IsActive, &user.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrUserNotFound.Error(), zap.String("user_id", userID))
+			return nil, repository.ErrUserNotFound
+		}
+
+		c.logger.Error("failed to set role", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to set role: %w", err)
+	}
+
+	c.logger.Info("successfully set role", zap.String("user_id", userID), zap.String("role", role))
+	return &user, nil
+}
+
+func (c *Client) GetActiveMembers(ctx context.Context, teamName string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, queryGetActiveMembers, teamName)
+	if err != nil {
+		c.logger.Error("failed to get active members", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("failed to get active members: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]string, 0)
+	for rows.Next() {
+		var userID string
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			c.logger.Error("failed to scan active members", zap.String("team_name", teamName), zap.Error(err))
+			return nil, fmt.Errorf("failed to scan active members: %w", err)
+		}
+
+		members = append(members, userID)
+	}
+	err = rows.Err()
+	if err != nil {
+		c.logger.Error("rows error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return members, nil
+}
+
+func (c *Client) SavePR(ctx context.Context, pr domain.PullRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	exists, err := c.prExists(ctx, pr.PullRequestId)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		c.logger.Warn(repository.ErrPRAlreadyExists.Error(), zap.String("pull_request_id", pr.PullRequestId))
+		return repository.ErrPRAlreadyExists
+	}
+
+	_, err = c.db.ExecContext(ctx, querySavePR,
+		pr.PullRequestId,
+		pr.PullRequestName,
+		pr.AuthorId,
+		pr.Status,
+		textArray(pr.AssignedReviewers),
+		formatTime(pr.CreatedAt),
+	)
+	if err != nil {
+		c.logger.Error("failed to save pull request", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+		return fmt.Errorf("failed to save pull request: %w", err)
+	}
+
+	c.logger.Info("successfully saved pull request", zap.String("pull_request_id", pr.PullRequestId))
+	return nil
+}
+
+func (c *Client) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	pr := domain.PullRequest{PullRequestId: prID}
+
+	err := c.db.QueryRowContext(ctx, queryGetPR, prID).Scan(
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.Status,
+		(*textArray)(&pr.AssignedReviewers),
+		timestamp{&pr.CreatedAt},
+		timestamp{&pr.MergedAt},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+			return nil, repository.ErrPRNotFound
+		}
+
+		c.logger.Error("failed to get pull request", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	return &pr, nil
+}
+
+func (c *Client) SetPRStatus(ctx context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		pr      domain.PullRequest
+		changed bool
+	)
+
+	err := c.db.QueryRowContext(ctx, querySetPRStatus, prID, status, formatTime(&mergedAt)).Scan(
+		&pr.PullRequestId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.Status,
+		(*textArray)(&pr.AssignedReviewers),
+		timestamp{&pr.CreatedAt},
+		timestamp{&pr.MergedAt},
+		&changed,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+			return nil, false, repository.ErrPRNotFound
+		}
+
+		c.logger.Error("failed to set status", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, false, fmt.Errorf("failed to set status: %w", err)
+	}
+
+	c.logger.Info("successfully set status", zap.String("pull_request_id", prID))
+	return &pr, changed, nil
+}
+
+func (c *Client) UpdateReviewers(ctx context.Context, prID string, expected []string, reviewers []string) (*domain.PullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var pr domain.PullRequest
+
+	err := c.db.QueryRowContext(ctx, queryUpdateReviewers, prID, textArray(expected), textArray(reviewers)).Scan(
+		&pr.PullRequestId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&pr.Status,
+		(*textArray)(&pr.AssignedReviewers),
+		timestamp{&pr.CreatedAt},
+		timestamp{&pr.MergedAt},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.logger.Warn(repository.ErrPRChanged.Error(), zap.String("pull_request_id", prID))
+			return nil, repository.ErrPRChanged
+		}
+
+		c.logger.Error("failed to update assigned reviewers", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, fmt.Errorf("failed to update assigned reviewers: %w", err)
+	}
+
+	c.logger.Info("successfully updated assigned reviewers", zap.String("pull_request_id", prID))
+	return &pr, nil
+}
+
+func (c *Client) GetReviewers(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, queryGetReviewers, userID)
+	if err != nil {
+		c.logger.Error("failed to get reviewers", zap.String("user_id", userID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequestShort, 0)
+	for rows.Next() {
+		var pr domain.PullRequestShort
+		err = rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&pr.Status,
+		)
+		if err != nil {
+			c.logger.Error("failed to scan pull request", zap.String("user_id", userID), zap.Error(err))
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+
+		prs = append(prs, pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	c.logger.Info("successfully got reviewers", zap.Int("prs", len(prs)))
+	return prs, nil
+}
+
+func (c *Client) GetStalePRs(ctx context.Context, createdBefore time.Time) ([]domain.PullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, queryGetStalePRs, formatTime(&createdBefore))
+	if err != nil {
+		c.logger.Error("failed to get stale pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to get stale pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		var pr domain.PullRequest
+		err = rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&pr.Status,
+			(*textArray)(&pr.AssignedReviewers),
+			timestamp{&pr.CreatedAt},
+			timestamp{&pr.MergedAt},
+		)
+		if err != nil {
+			c.logger.Error("failed to scan pull request", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+
+		prs = append(prs, pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	c.logger.Info("successfully got stale pull requests", zap.Int("prs", len(prs)))
+	return prs, nil
+}
+
+func (c *Client) GetStats(ctx context.Context) (*domain.Stats, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stats domain.Stats
+
+	err := c.db.QueryRowContext(ctx, queryGetPRCounts).Scan(&stats.OpenPRs, &stats.MergedPRs)
+	if err != nil {
+		c.logger.Error("failed to count pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to count pull requests: %w", err)
+	}
+
+	rows, err := c.db.QueryContext(ctx, queryGetReviewerStats)
+	if err != nil {
+		c.logger.Error("failed to get reviewer stats", zap.Error(err))
+		return nil, fmt.Errorf("failed to get reviewer stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats.Reviewers = make([]domain.ReviewerStats, 0)
+	for rows.Next() {
+		var reviewer domain.ReviewerStats
+
+		err = rows.Scan(&reviewer.UserID, &reviewer.Assigned, &reviewer.Open)
+		if err != nil {
+			c.logger.Error("failed to scan reviewer stats", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan reviewer stats: %w", err)
+		}
+
+		stats.Reviewers = append(stats.Reviewers, reviewer)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	c.logger.Info("successfully got stats", zap.Int("reviewers", len(stats.Reviewers)))
+	return &stats, nil
+}
+
+func (c *Client) SaveEvent(ctx context.Context, event domain.Event) (*domain.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	event.CreatedAt = time.Now().UTC()
+
+	err := c.db.QueryRowContext(ctx, querySaveEvent,
+		event.Type,
+		event.TeamName,
+		event.PullRequest.PullRequestId,
+		event.PullRequest.PullRequestName,
+		event.PullRequest.AuthorId,
+		event.PullRequest.Status,
+		textArray(event.PullRequest.AssignedReviewers),
+		event.ReplacedUserID,
+		textArray(event.UserIDs),
+		event.Actor,
+		formatTime(&event.CreatedAt),
+	).Scan(&event.EventID)
+	if err != nil {
+		c.logger.Error("failed to save event", zap.String("pull_request_id", event.PullRequest.PullRequestId), zap.Error(err))
+		return nil, fmt.Errorf("failed to save event: %w", err)
+	}
+
+	c.logger.Info("successfully saved event", zap.Int64("event_id", event.EventID))
+	return &event, nil
+}
+
+func (c *Client) GetEventsAfter(ctx context.Context, eventID int64, filter domain.EventFilter, limit int) ([]domain.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rows, err := c.db.QueryContext(ctx, queryGetEventsAfter, eventID, filter.UserID, filter.TeamName, limit)
+	if err != nil {
+		c.logger.Error("failed to get events", zap.Int64("event_id", eventID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.Event, 0)
+	for rows.Next() {
+		var event domain.Event
+		var createdAt *time.Time
+
+		err = rows.Scan(
+			&event.EventID,
+			&event.Type,
+			&event.TeamName,
+			&event.PullRequest.PullRequestId,
+			&event.PullRequest.PullRequestName,
+			&event.PullRequest.AuthorId,
+			&event.PullRequest.Status,
+			(*textArray)(&event.PullRequest.AssignedReviewers),
+			&event.ReplacedUserID,
+			(*textArray)(&event.UserIDs),
+			&event.Actor,
+			timestamp{&createdAt},
+		)
+		if err != nil {
+			c.logger.Error("failed to scan event", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+
+		if createdAt != nil {
+			event.CreatedAt = *createdAt
+		}
+
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetLastEventID(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var eventID int64
+
+	err := c.db.QueryRowContext(ctx, queryGetLastEventID).Scan(&eventID)
+	if err != nil {
+		c.logger.Error("failed to get last event id", zap.Error(err))
+		return 0, fmt.Errorf("failed to get last event id: %w", err)
+	}
+
+	return eventID, nil
+}
+
+func (c *Client) Close() {
+	c.db.Close()
+}
+
+func (c *Client) teamExists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+
+	err := c.db.QueryRowContext(ctx, queryTeamExists, teamName).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if team exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if team exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c *Client) activeTeamExists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+
+	err := c.db.QueryRowContext(ctx, queryActiveTeamExists, teamName).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if team exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if team exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (c *Client) prExists(ctx context.Context, prID string) (bool, error) {
+	var exists bool
+
+	err := c.db.QueryRowContext(ctx, queryPRExists, prID).Scan(&exists)
+	if err != nil {
+		c.logger.Error("failed to check if pull request exists", zap.Error(err))
+		return false, fmt.Errorf("failed to check if pull request exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+func isConstraintViolation(err error) bool {
+	var sqliteErr *moderncsqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+
+	return sqliteErr.Code() == sqlitelib.SQLITE_CONSTRAINT_PRIMARYKEY || sqliteErr.Code() == sqlitelib.SQLITE_CONSTRAINT_UNIQUE
+}
+
+func buildDSN(config *Config) string {
+	return fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(%d)",
+		config.Path,
+		config.Timeout.Milliseconds(),
+	)
+}
@@ -0,0 +1,28 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.Repository {
+		c, err := New(context.Background(), &Config{
+			Path:    filepath.Join(t.TempDir(), "reviewer.db"),
+			Timeout: 5 * time.Second,
+		}, zap.NewNop())
+		if err != nil {
+			t.Fatalf("new sqlite client: %v", err)
+		}
+		t.Cleanup(c.Close)
+
+		return c
+	})
+}
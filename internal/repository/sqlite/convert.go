@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// timeLayout has a fixed width and is always written in UTC, so timestamps compare correctly as text.
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// textArray emulates postgres text[] with a JSON array stored in a text column.
+type textArray []string
+
+func (a textArray) Value() (driver.Value, error) {
+	if a == nil {
+		return "[]", nil
+	}
+
+	b, err := json.Marshal([]string(a))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (a *textArray) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(v), (*[]string)(a))
+	case []byte:
+		return json.Unmarshal(v, (*[]string)(a))
+	case nil:
+		*a = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into text array", src)
+	}
+}
+
+// timestamp scans a nullable text column written by formatTime.
+type timestamp struct {
+	dst **time.Time
+}
+
+func (t timestamp) Scan(src any) error {
+	var s string
+
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		*t.dst = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into timestamp", src)
+	}
+
+	parsed, err := time.Parse(timeLayout, s)
+	if err != nil {
+		return err
+	}
+
+	*t.dst = &parsed
+	return nil
+}
+
+func formatTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+
+	return t.UTC().Format(timeLayout)
+}
@@ -0,0 +1,204 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	moderncsqlite "modernc.org/sqlite"
+	sqlitelib "modernc.org/sqlite/lib"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) Dump(ctx context.Context) (*domain.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// A transaction gives the three selects a consistent view.
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, queryDumpTeams)
+	if err != nil {
+		c.logger.Error("failed to dump teams", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump teams: %w", err)
+	}
+
+	teams := make([]domain.Team, 0)
+	index := make(map[string]int)
+	for rows.Next() {
+		team := domain.Team{Members: make([]domain.TeamMember, 0)}
+
+		err = rows.Scan(&team.TeamName, timestamp{&team.ArchivedAt})
+		if err != nil {
+			rows.Close()
+			c.logger.Error("failed to scan team", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan team: %w", err)
+		}
+
+		index[team.TeamName] = len(teams)
+		teams = append(teams, team)
+	}
+	rows.Close()
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	rows, err = tx.QueryContext(ctx, queryDumpUsers)
+	if err != nil {
+		c.logger.Error("failed to dump users", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump users: %w", err)
+	}
+
+	for rows.Next() {
+		var (
+			member   domain.TeamMember
+			teamName string
+		)
+
+		err = rows.Scan(&member.UserID, &member.UserName, &member.Email, &teamName, &member.IsActive, &member.Role,
+			timestamp{&member.ArchivedAt})
+		if err != nil {
+			rows.Close()
+			c.logger.Error("failed to scan user", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+
+		i := index[teamName]
+		teams[i].Members = append(teams[i].Members, member)
+	}
+	rows.Close()
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	rows, err = tx.QueryContext(ctx, queryDumpPRs)
+	if err != nil {
+		c.logger.Error("failed to dump pull requests", zap.Error(err))
+		return nil, fmt.Errorf("failed to dump pull requests: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequest, 0)
+	for rows.Next() {
+		var pr domain.PullRequest
+
+		err = rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&pr.Status,
+			(*textArray)(&pr.AssignedReviewers),
+			timestamp{&pr.CreatedAt},
+			timestamp{&pr.MergedAt},
+			timestamp{&pr.ArchivedAt},
+		)
+		if err != nil {
+			c.logger.Error("failed to scan pull request", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+
+		prs = append(prs, pr)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	c.logger.Info("successfully dumped data", zap.Int("teams", len(teams)), zap.Int("prs", len(prs)))
+	return &domain.Snapshot{
+		Teams:        teams,
+		PullRequests: prs,
+	}, nil
+}
+
+func (c *Client) Restore(ctx context.Context, snapshot *domain.Snapshot, replace bool) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		c.logger.Error("failed to start transaction", zap.Error(err))
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if replace {
+		for _, query := range []string{queryDeletePRs, queryDeleteUsers, queryDeleteTeams} {
+			_, err = tx.ExecContext(ctx, query)
+			if err != nil {
+				c.logger.Error("failed to delete existing data", zap.Error(err))
+				return fmt.Errorf("failed to delete existing data: %w", err)
+			}
+		}
+	}
+
+	for _, team := range snapshot.Teams {
+		_, err = tx.ExecContext(ctx, queryLoadTeam, team.TeamName, formatTime(team.ArchivedAt))
+		if err != nil {
+			c.logger.Error("failed to restore team", zap.String("team_name", team.TeamName), zap.Error(err))
+			return fmt.Errorf("failed to restore team: %s: %w", team.TeamName, err)
+		}
+
+		for _, member := range team.Members {
+			_, err = tx.ExecContext(ctx, queryLoadTeamMember,
+				member.UserID, member.UserName, team.TeamName, member.IsActive, member.Email, member.Role, formatTime(member.ArchivedAt))
+			if err != nil {
+				return c.restoreError("user", member.UserID, err)
+			}
+		}
+	}
+
+	for _, pr := range snapshot.PullRequests {
+		_, err = tx.ExecContext(ctx, queryRestorePR,
+			pr.PullRequestId,
+			pr.PullRequestName,
+			pr.AuthorId,
+			pr.Status,
+			textArray(pr.AssignedReviewers),
+			formatTime(pr.CreatedAt),
+			formatTime(pr.MergedAt),
+			formatTime(pr.ArchivedAt),
+		)
+		if err != nil {
+			return c.restoreError("pull request", pr.PullRequestId, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		c.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	c.logger.Info("successfully restored data", zap.Int("teams", len(snapshot.Teams)), zap.Bool("replace", replace))
+	return nil
+}
+
+func (c *Client) restoreError(kind, id string, err error) error {
+	if isConstraintViolation(err) {
+		c.logger.Error("failed to restore "+kind+": duplicate key", zap.String("id", id))
+		return fmt.Errorf("%w: %s %s", repository.ErrDuplicateKey, kind, id)
+	}
+
+	var sqliteErr *moderncsqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlitelib.SQLITE_CONSTRAINT_FOREIGNKEY {
+		c.logger.Error("failed to restore "+kind+": unknown author", zap.String("id", id))
+		return fmt.Errorf("%w: author of %s %s", repository.ErrUserNotFound, kind, id)
+	}
+
+	c.logger.Error("failed to restore "+kind, zap.String("id", id), zap.Error(err))
+	return fmt.Errorf("failed to restore %s: %s: %w", kind, id, err)
+}
@@ -0,0 +1,19 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+)
+
+// Ready pings the database, migrations need no check as New applies them.
+func (c *Client) Ready(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.db.PingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ping sqlite: %w", err)
+	}
+
+	return nil
+}
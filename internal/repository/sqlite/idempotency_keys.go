@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) SaveIdempotencyKey(ctx context.Context, key domain.IdempotencyKey) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.db.ExecContext(ctx, querySaveIdempotencyKey,
+		key.Scope, key.Key, key.RequestHash, formatTime(&key.CreatedAt), formatTime(&key.ExpiresAt))
+	if err != nil {
+		c.logger.Error("failed to save idempotency key", zap.String("key", key.Key), zap.Error(err))
+		return fmt.Errorf("failed to save idempotency key: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to save idempotency key: %w", err)
+	}
+
+	if affected == 0 {
+		c.logger.Warn("failed to save idempotency key: duplicate key", zap.String("key", key.Key))
+		return repository.ErrDuplicateKey
+	}
+
+	return nil
+}
+
+func (c *Client) GetIdempotencyKey(ctx context.Context, scope string, key string) (*domain.IdempotencyKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stored domain.IdempotencyKey
+	var createdAt, expiresAt *time.Time
+
+	err := c.db.QueryRowContext(ctx, queryGetIdempotencyKey, scope, key).Scan(
+		&stored.Scope, &stored.Key, &stored.RequestHash, &stored.StatusCode,
+		&stored.ContentType, &stored.Body, timestamp{&createdAt}, timestamp{&expiresAt},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrIdempotencyKeyNotFound
+		}
+
+		c.logger.Error("failed to get idempotency key", zap.String("key", key), zap.Error(err))
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	if createdAt != nil {
+		stored.CreatedAt = *createdAt
+	}
+
+	if expiresAt != nil {
+		stored.ExpiresAt = *expiresAt
+	}
+
+	return &stored, nil
+}
+
+func (c *Client) CompleteIdempotencyKey(ctx context.Context, scope string, key string, statusCode int, contentType string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.db.ExecContext(ctx, queryCompleteIdempotencyKey, scope, key, statusCode, contentType, body)
+	if err != nil {
+		c.logger.Error("failed to complete idempotency key", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", repository.ErrIdempotencyKeyNotFound, key)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteIdempotencyKey(ctx context.Context, scope string, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.db.ExecContext(ctx, queryDeleteIdempotencyKey, scope, key)
+	if err != nil {
+		c.logger.Error("failed to delete idempotency key", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	res, err := c.db.ExecContext(ctx, queryDeleteExpiredIdempotencyKeys, formatTime(&now))
+	if err != nil {
+		c.logger.Error("failed to delete expired idempotency keys", zap.Error(err))
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return deleted, nil
+}
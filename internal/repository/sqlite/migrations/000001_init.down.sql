@@ -0,0 +1,4 @@
+drop table if exists events;
+drop table if exists pull_requests;
+drop table if exists users;
+drop table if exists teams;
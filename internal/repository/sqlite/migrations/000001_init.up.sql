@@ -0,0 +1,37 @@
+create table if not exists teams(
+    team_name text primary key
+);
+
+create table if not exists users(
+    user_id text primary key,
+    username text not null,
+    email text,
+    team_name text references teams(team_name) on delete cascade,
+    is_active boolean not null
+);
+
+create table if not exists pull_requests(
+    pull_request_id text primary key,
+    pull_request_name text not null,
+    author_id text references users,
+    status text not null,
+    assigned_reviewers text not null default '[]',
+    created_at text,
+    merged_at text
+);
+
+create table if not exists events(
+    event_id integer primary key autoincrement,
+    event_type text not null,
+    team_name text not null,
+    pull_request_id text not null,
+    pull_request_name text not null,
+    author_id text not null,
+    status text not null,
+    assigned_reviewers text not null,
+    replaced_user_id text,
+    user_ids text not null,
+    created_at text not null
+);
+
+create index if not exists events_team_name_idx on events(team_name, event_id);
@@ -0,0 +1,5 @@
+drop index if exists pull_requests_merged_at_idx;
+
+alter table pull_requests drop column archived_at;
+alter table users drop column archived_at;
+alter table teams drop column archived_at;
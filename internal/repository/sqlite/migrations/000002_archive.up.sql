@@ -0,0 +1,5 @@
+alter table teams add column archived_at text;
+alter table users add column archived_at text;
+alter table pull_requests add column archived_at text;
+
+create index if not exists pull_requests_merged_at_idx on pull_requests(merged_at) where status = 'MERGED';
@@ -0,0 +1,3 @@
+alter table events drop column actor;
+
+drop table if exists api_keys;
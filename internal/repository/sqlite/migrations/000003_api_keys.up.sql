@@ -0,0 +1,10 @@
+create table if not exists api_keys(
+    key_id text primary key,
+    name text not null,
+    key_hash blob not null unique,
+    scopes text not null,
+    created_at text not null,
+    revoked_at text
+);
+
+alter table events add column actor text;
@@ -0,0 +1 @@
+alter table users add column role text not null default 'member';
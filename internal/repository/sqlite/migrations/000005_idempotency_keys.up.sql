@@ -0,0 +1,13 @@
+create table if not exists idempotency_keys(
+    scope text not null,
+    key text not null,
+    request_hash blob not null,
+    status_code integer not null default 0,
+    content_type text not null default '',
+    body blob,
+    created_at text not null,
+    expires_at text not null,
+    primary key (scope, key)
+);
+
+create index if not exists idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
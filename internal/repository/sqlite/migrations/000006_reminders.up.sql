@@ -0,0 +1,6 @@
+create table if not exists reminders(
+    pull_request_id text not null references pull_requests on delete cascade,
+    user_id text not null,
+    reminded_at text not null,
+    primary key (pull_request_id, user_id)
+);
@@ -0,0 +1 @@
+alter table api_keys drop column expires_at;
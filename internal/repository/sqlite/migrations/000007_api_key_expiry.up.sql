@@ -0,0 +1 @@
+alter table api_keys add column expires_at text;
@@ -0,0 +1,21 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	Path    string        `env:"SQLITE_PATH" env-default:"reviewer.db"`
+	Timeout time.Duration `env:"SQLITE_TIMEOUT" env-default:"5s"`
+}
+
+// Client stores data in a single SQLite file. Arrays such as assigned_reviewers are kept
+// as JSON text and searched with json_each, which stands in for postgres text[].
+type Client struct {
+	db      *sql.DB
+	logger  *zap.Logger
+	timeout time.Duration
+}
@@ -0,0 +1,183 @@
+package sqlite
+
+const (
+	querySetTeamName = `insert into teams (team_name) values (?1)`
+
+	querySaveTeamMember = `insert into users
+    		(user_id, username, team_name, is_active, email) values (?1, ?2, ?3, ?4, nullif(?5, ''))`
+
+	queryEnsureTeam = `insert into teams (team_name) values (?1) on conflict (team_name) do update set archived_at = null`
+
+	queryUpsertTeamMember = `insert into users
+    		(user_id, username, team_name, is_active, email) values (?1, ?2, ?3, ?4, nullif(?5, ''))
+			on conflict (user_id) do update set username = excluded.username, team_name = excluded.team_name,
+			is_active = excluded.is_active, email = excluded.email, archived_at = null,
+			role = case when users.role = 'lead' and users.team_name <> excluded.team_name then 'member' else users.role end`
+
+	queryGetTeam = `select user_id, username, coalesce(email, ''), is_active, role from users
+			where team_name = ?1 and archived_at is null order by rowid`
+
+	querySetIsActive = `update users set is_active = ?2
+    		where user_id = ?1 and archived_at is null returning user_id, username, coalesce(email, ''), team_name, is_active, role`
+
+	querySetUserRole = `update users set role = ?2
+    		where user_id = ?1 and archived_at is null returning user_id, username, coalesce(email, ''), team_name, is_active, role`
+
+	queryGetUser = `select user_id, username, coalesce(email, ''), team_name, is_active, role
+			from users where user_id = ?1 and archived_at is null`
+
+	querySavePR = `insert into pull_requests
+    		(pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at)
+			values (?1, ?2, ?3, ?4, ?5, ?6)`
+
+	querySetPRStatus = `update pull_requests
+			set status = ?2, merged_at = coalesce(merged_at, ?3) where pull_request_id = ?1 and archived_at is null
+    		returning pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at,
+    			merged_at = ?3`
+
+	queryGetStalePRs = `select pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
+			from pull_requests
+			where status = 'OPEN' and created_at < ?1 and archived_at is null
+			order by created_at`
+
+	queryGetPRCounts = `select coalesce(sum(status = 'OPEN'), 0), coalesce(sum(status = 'MERGED'), 0)
+			from pull_requests where archived_at is null`
+
+	queryGetReviewerStats = `select r.value, count(*), sum(pr.status = 'OPEN')
+			from pull_requests pr, json_each(pr.assigned_reviewers) r
+			where pr.archived_at is null
+			group by r.value
+			order by count(*) desc, r.value`
+
+	queryArchiveTeam = `update teams set archived_at = coalesce(archived_at, ?2) where team_name = ?1 returning archived_at`
+
+	queryArchiveTeamMembers = `update users set archived_at = ?2 where team_name = ?1 and archived_at is null`
+
+	queryGetTeamArchivedAt = `select archived_at from teams where team_name = ?1`
+
+	queryUnarchiveTeam = `update teams set archived_at = null where team_name = ?1`
+
+	queryUnarchiveTeamMembers = `update users set archived_at = null where team_name = ?1 and archived_at = ?2`
+
+	queryArchiveUser = `update users set archived_at = coalesce(archived_at, ?2) where user_id = ?1 returning archived_at`
+
+	queryUnarchiveUser = `update users set archived_at = null
+			where user_id = ?1
+			returning (select t.archived_at is not null from teams t where t.team_name = users.team_name)`
+
+	queryArchivePR = `update pull_requests set archived_at = coalesce(archived_at, ?2)
+			where pull_request_id = ?1 returning archived_at`
+
+	queryUnarchivePR = `update pull_requests set archived_at = null where pull_request_id = ?1`
+
+	queryDeleteMergedPRs = `delete from pull_requests where status = 'MERGED' and merged_at < ?1`
+
+	queryUpdateReviewers = `update pull_requests set assigned_reviewers = ?3
+			where pull_request_id = ?1 and json(assigned_reviewers) = json(?2) and status = 'OPEN' and archived_at is null
+    		returning pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at`
+
+	queryTeamExists = `select exists (select 1 from teams where team_name = ?1)`
+
+	queryActiveTeamExists = `select exists (select 1 from teams where team_name = ?1 and archived_at is null)`
+
+	queryPRExists = `select exists (select 1 from pull_requests where pull_request_id = ?1)`
+
+	queryGetPR = `select pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at
+			from pull_requests
+			where pull_request_id = ?1 and archived_at is null`
+
+	queryGetReviewers = `select pull_request_id, pull_request_name, author_id, status
+			from pull_requests
+			where exists (select 1 from json_each(assigned_reviewers) where value = ?1) and archived_at is null
+			order by created_at desc`
+
+	queryGetActiveMembers = `select user_id from users
+    		where team_name = ?1 and is_active = true and archived_at is null order by user_id`
+
+	querySaveEvent = `insert into events
+    		(event_type, team_name, pull_request_id, pull_request_name, author_id, status, assigned_reviewers, replaced_user_id, user_ids,
+    		actor, created_at)
+			values (?1, ?2, ?3, ?4, ?5, ?6, ?7, nullif(?8, ''), ?9, nullif(?10, ''), ?11)
+			returning event_id`
+
+	queryGetEventsAfter = `select event_id, event_type, team_name, pull_request_id, pull_request_name, author_id, status,
+			assigned_reviewers, coalesce(replaced_user_id, ''), user_ids, coalesce(actor, ''), created_at
+			from events
+			where event_id > ?1
+				and (?2 = '' or exists (select 1 from json_each(user_ids) where value = ?2))
+				and (?3 = '' or team_name = ?3)
+			order by event_id
+			limit ?4`
+
+	queryGetLastEventID = `select coalesce(max(event_id), 0) from events`
+)
+
+const (
+	queryDumpTeams = `select team_name, archived_at from teams order by team_name`
+
+	queryDumpUsers = `select user_id, username, coalesce(email, ''), team_name, is_active, role, archived_at
+			from users order by team_name, user_id`
+
+	queryDumpPRs = `select pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at,
+			archived_at from pull_requests order by created_at, pull_request_id`
+
+	queryDeletePRs = `delete from pull_requests`
+
+	queryDeleteUsers = `delete from users`
+
+	queryDeleteTeams = `delete from teams`
+
+	queryRestorePR = `insert into pull_requests
+    		(pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at, archived_at)
+			values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)`
+
+	// queryLoadTeam keeps the archive state of a team that already exists, unlike queryEnsureTeam.
+	queryLoadTeam = `insert into teams (team_name, archived_at) values (?1, ?2) on conflict do nothing`
+
+	queryLoadTeamMember = `insert into users
+    		(user_id, username, team_name, is_active, email, role, archived_at)
+			values (?1, ?2, ?3, ?4, nullif(?5, ''), coalesce(nullif(?6, ''), 'member'), ?7)`
+)
+
+const (
+	querySaveAPIKey = `insert into api_keys (key_id, name, key_hash, scopes, created_at, expires_at)
+			values (?1, ?2, ?3, ?4, ?5, ?6)`
+
+	queryGetAPIKeyByHash = `select key_id, name, key_hash, scopes, created_at, revoked_at, expires_at
+			from api_keys where key_hash = ?1`
+
+	queryListAPIKeys = `select key_id, name, key_hash, scopes, created_at, revoked_at, expires_at
+			from api_keys order by created_at, key_id`
+
+	queryRevokeAPIKey = `update api_keys set revoked_at = coalesce(revoked_at, ?2)
+			where key_id = ?1
+			returning key_id, name, key_hash, scopes, created_at, revoked_at, expires_at`
+)
+
+const (
+	// querySaveIdempotencyKey only overwrites an expired key, a live one leaves no rows affected.
+	querySaveIdempotencyKey = `insert into idempotency_keys (scope, key, request_hash, created_at, expires_at)
+			values (?1, ?2, ?3, ?4, ?5)
+			on conflict (scope, key) do update set request_hash = excluded.request_hash, status_code = 0,
+				content_type = '', body = null, created_at = excluded.created_at, expires_at = excluded.expires_at
+			where idempotency_keys.expires_at <= excluded.created_at`
+
+	queryGetIdempotencyKey = `select scope, key, request_hash, status_code, content_type, body, created_at, expires_at
+			from idempotency_keys where scope = ?1 and key = ?2`
+
+	queryCompleteIdempotencyKey = `update idempotency_keys set status_code = ?3, content_type = ?4, body = ?5
+			where scope = ?1 and key = ?2`
+
+	queryDeleteIdempotencyKey = `delete from idempotency_keys where scope = ?1 and key = ?2`
+
+	queryDeleteExpiredIdempotencyKeys = `delete from idempotency_keys where expires_at <= ?1`
+)
+
+const (
+	// The where clause of the select keeps the upsert from being parsed as a join constraint.
+	queryClaimReminders = `insert into reminders as r (pull_request_id, user_id, reminded_at)
+			select ?1, value, ?4 from json_each(?2) where true
+			on conflict (pull_request_id, user_id) do update set reminded_at = excluded.reminded_at
+			where r.reminded_at < ?3
+			returning user_id`
+)
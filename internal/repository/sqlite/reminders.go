@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/repository"
+)
+
+func (c *Client) ClaimReminders(ctx context.Context, prID string, userIDs []string, remindedBefore time.Time, remindedAt time.Time) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	exists, err := c.prExists(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		c.logger.Warn(repository.ErrPRNotFound.Error(), zap.String("pull_request_id", prID))
+		return nil, fmt.Errorf("%w: %s", repository.ErrPRNotFound, prID)
+	}
+
+	rows, err := c.db.QueryContext(ctx, queryClaimReminders, prID, textArray(userIDs), formatTime(&remindedBefore), formatTime(&remindedAt))
+	if err != nil {
+		c.logger.Error("failed to claim reminders", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, fmt.Errorf("failed to claim reminders: %w", err)
+	}
+	defer rows.Close()
+
+	claimed := make(map[string]bool, len(userIDs))
+	for rows.Next() {
+		var userID string
+
+		err = rows.Scan(&userID)
+		if err != nil {
+			c.logger.Error("failed to scan reminder", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan reminder: %w", err)
+		}
+
+		claimed[userID] = true
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return slices.DeleteFunc(slices.Clone(userIDs), func(id string) bool { return !claimed[id] }), nil
+}
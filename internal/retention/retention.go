@@ -0,0 +1,46 @@
+// Package retention hard-deletes old data that is no longer needed for reviewer selection.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// Interval between retention runs, zero disables the job.
+	Interval time.Duration `env:"RETENTION_INTERVAL" env-default:"0s"`
+	// MergedPRAge is how long a merged pull request is kept after the merge.
+	MergedPRAge time.Duration `env:"RETENTION_MERGED_PR_AGE" env-default:"2160h"`
+}
+
+type MergedPRDeleter interface {
+	DeleteMergedPRs(ctx context.Context, mergedBefore time.Time) (int64, error)
+}
+
+// Run deletes pull requests merged more than MergedPRAge ago once per Interval until ctx is done.
+func Run(ctx context.Context, cfg *Config, prs MergedPRDeleter, logger *zap.Logger) {
+	if cfg.Interval <= 0 || cfg.MergedPRAge <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			deleted, err := prs.DeleteMergedPRs(ctx, time.Now().Add(-cfg.MergedPRAge))
+			if err != nil {
+				logger.Error("retention.Run: failed to delete merged pull requests", zap.Error(err))
+				continue
+			}
+
+			logger.Info("retention.Run: deleted merged pull requests", zap.Int64("prs", deleted))
+		}
+	}
+}
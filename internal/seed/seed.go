@@ -0,0 +1,297 @@
+// Package seed fills a repository with synthetic teams, users and pull requests for demos and benchmarks.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+// Options describe the generated dataset. The same options, seed and end included, always produce the same
+// teams, users, authors and timings. Reviewers are picked by the service, so their distribution is what
+// the experiments measure, they are reproducible too when the service draws from NewRand(Seed).
+type Options struct {
+	Seed uint64
+	// Prefix starts every team, user and pull request id, it keeps several datasets apart.
+	Prefix string
+
+	Teams        int
+	UsersPerTeam int
+	PullRequests int
+
+	// Pull requests are created between End-Span and End.
+	End  time.Time
+	Span time.Duration
+
+	// MergeRatio is the share of pull requests that get merged, if their merge time falls before End.
+	MergeRatio float64
+	// MergeMedian is the median time from creation to merge, delays follow a log-normal distribution.
+	MergeMedian time.Duration
+	// InactiveRatio is the share of users created inactive.
+	InactiveRatio float64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Seed:          1,
+		Prefix:        "seed",
+		Teams:         5,
+		UsersPerTeam:  8,
+		PullRequests:  500,
+		End:           time.Now().UTC().Truncate(24 * time.Hour),
+		Span:          30 * 24 * time.Hour,
+		MergeRatio:    0.8,
+		MergeMedian:   18 * time.Hour,
+		InactiveRatio: 0.1,
+	}
+}
+
+// Report counts what Generate wrote. Skipped pull requests already existed or had no reviewer to assign.
+type Report struct {
+	Teams    int
+	Users    int
+	Inactive int
+	Created  int
+	Merged   int
+	Skipped  int
+}
+
+// mergeSigma spreads merge delays: about a tenth of merges take more than 3.6 times the median.
+const mergeSigma = 1.0
+
+// authorSkew is the Zipf exponent of authors inside a team, a few people open most of the pull requests.
+const authorSkew = 1.3
+
+var names = []string{
+	"alice", "bob", "carol", "dave", "erin", "frank", "grace", "heidi", "ivan", "judy",
+	"mallory", "niaj", "olivia", "peggy", "rupert", "sybil", "trent", "victor", "walter", "yasmin",
+}
+
+var topics = []string{
+	"Fix", "Add", "Refactor", "Speed up", "Remove", "Document", "Test", "Rename",
+}
+
+var areas = []string{
+	"auth", "billing", "search", "cache", "notifications", "migrations", "logging", "reviewer selection",
+	"team import", "metrics",
+}
+
+type step struct {
+	at      time.Time
+	pr      domain.PullRequest
+	isMerge bool
+}
+
+// Generate writes the dataset through repo, pull requests are created by svc. Teams and users are upserted,
+// so running it twice with the same options only skips the pull requests that already exist.
+func Generate(ctx context.Context, repo repository.Repository, svc *service.Service, opts Options) (*Report, error) {
+	if opts.Teams <= 0 || opts.UsersPerTeam < 2 || opts.PullRequests < 0 || opts.Span <= 0 {
+		return nil, fmt.Errorf("invalid options: need teams > 0, at least 2 users per team and a positive span")
+	}
+
+	rng := NewRand(opts.Seed)
+
+	teams := generateTeams(rng, opts)
+
+	err := repo.ImportTeams(ctx, teams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import teams: %w", err)
+	}
+
+	report := &Report{Teams: len(teams)}
+	for _, team := range teams {
+		for _, m := range team.Members {
+			report.Users++
+			if !m.IsActive {
+				report.Inactive++
+			}
+		}
+	}
+
+	skipped := make(map[string]struct{})
+
+	for _, s := range generateSteps(rng, opts, teams) {
+		if s.isMerge {
+			if _, ok := skipped[s.pr.PullRequestId]; ok {
+				continue
+			}
+
+			_, _, err = repo.SetPRStatus(ctx, s.pr.PullRequestId, domain.PRStatusMerged, s.at)
+			if err != nil {
+				return report, fmt.Errorf("failed to merge %s: %w", s.pr.PullRequestId, err)
+			}
+
+			report.Merged++
+			continue
+		}
+
+		_, err = svc.SavePR(ctx, s.pr)
+		if err != nil {
+			if errors.Is(err, repository.ErrPRAlreadyExists) || errors.Is(err, service.ErrReviewersNotFound) {
+				skipped[s.pr.PullRequestId] = struct{}{}
+				report.Skipped++
+				continue
+			}
+
+			return report, fmt.Errorf("failed to save %s: %w", s.pr.PullRequestId, err)
+		}
+
+		report.Created++
+	}
+
+	return report, nil
+}
+
+// NewRand returns the generator Generate draws the dataset from.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+func generateTeams(rng *rand.Rand, opts Options) []domain.Team {
+	teams := make([]domain.Team, 0, opts.Teams)
+
+	for t := range opts.Teams {
+		team := domain.Team{TeamName: fmt.Sprintf("%s-team-%02d", opts.Prefix, t+1)}
+
+		for u := range opts.UsersPerTeam {
+			id := fmt.Sprintf("%s-u%02d%03d", opts.Prefix, t+1, u+1)
+			name := fmt.Sprintf("%s.%02d%03d", names[rng.IntN(len(names))], t+1, u+1)
+
+			team.Members = append(team.Members, domain.TeamMember{
+				UserID:   id,
+				UserName: name,
+				Email:    name + "@example.com",
+				IsActive: rng.Float64() >= opts.InactiveRatio,
+			})
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams
+}
+
+// generateSteps returns pull request creations and merges ordered by time. Creations arrive as a Poisson
+// process over working hours, merges follow after a log-normal delay in wall time.
+func generateSteps(rng *rand.Rand, opts Options, teams []domain.Team) []step {
+	authors := make([]*rand.Zipf, len(teams))
+	for i, team := range teams {
+		authors[i] = rand.NewZipf(rng, authorSkew, 1, uint64(len(team.Members)-1))
+	}
+
+	// Arrival times of a Poisson process with a known count are uniform over the interval, sorting them keeps
+	// exactly opts.PullRequests arrivals.
+	start := workingTime(opts.End.Add(-opts.Span))
+	working := float64(workingDuration(start, opts.End))
+
+	offsets := make([]time.Duration, opts.PullRequests)
+	for i := range offsets {
+		offsets[i] = time.Duration(rng.Float64() * working)
+	}
+	slices.Sort(offsets)
+
+	steps := make([]step, 0, opts.PullRequests*2)
+
+	for i, offset := range offsets {
+		at := addWorking(start, offset)
+
+		team := rng.IntN(len(teams))
+		author := teams[team].Members[authors[team].Uint64()]
+
+		createdAt := at
+		pr := domain.PullRequest{
+			PullRequestId:   fmt.Sprintf("%s-pr-%05d", opts.Prefix, i+1),
+			PullRequestName: fmt.Sprintf("%s %s", topics[rng.IntN(len(topics))], areas[rng.IntN(len(areas))]),
+			AuthorId:        author.UserID,
+			Status:          domain.PRStatusOpen,
+			CreatedAt:       &createdAt,
+		}
+
+		steps = append(steps, step{at: createdAt, pr: pr})
+
+		// Draw both numbers for every pull request so that one decision does not shift the rest of the stream.
+		merge := rng.Float64() < opts.MergeRatio
+		delay := time.Duration(float64(opts.MergeMedian) * math.Exp(rng.NormFloat64()*mergeSigma))
+
+		mergedAt := createdAt.Add(delay)
+		if merge && !mergedAt.After(opts.End) {
+			steps = append(steps, step{at: mergedAt, pr: pr, isMerge: true})
+		}
+	}
+
+	slices.SortStableFunc(steps, func(a, b step) int {
+		return a.at.Compare(b.at)
+	})
+
+	return steps
+}
+
+const (
+	workdayStart = 9 * time.Hour
+	workdayEnd   = 19 * time.Hour
+)
+
+// workingTime moves t to the start of the next working day when it falls on a weekend or outside working hours.
+func workingTime(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(day)
+
+	switch {
+	case offset >= workdayEnd:
+		day = day.AddDate(0, 0, 1)
+	case offset >= workdayStart && !isWeekend(day):
+		return t
+	}
+
+	for isWeekend(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return day.Add(workdayStart)
+}
+
+// addWorking adds d of working time to t, which must already be a working time.
+func addWorking(t time.Time, d time.Duration) time.Time {
+	for {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+		left := day.Add(workdayEnd).Sub(t)
+		if d < left {
+			return t.Add(d)
+		}
+
+		d -= left
+		t = workingTime(day.Add(workdayEnd))
+	}
+}
+
+// workingDuration returns the working time between from and to.
+func workingDuration(from, to time.Time) time.Duration {
+	var total time.Duration
+
+	for t := workingTime(from); t.Before(to); {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+		end := day.Add(workdayEnd)
+		if end.After(to) {
+			end = to
+		}
+
+		total += end.Sub(t)
+		t = workingTime(day.Add(workdayEnd))
+	}
+
+	return total
+}
+
+func isWeekend(day time.Time) bool {
+	return day.Weekday() == time.Saturday || day.Weekday() == time.Sunday
+}
@@ -0,0 +1,63 @@
+package seed_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository/memory"
+	"reviewer-service/internal/seed"
+	"reviewer-service/internal/service"
+)
+
+func generate(t *testing.T, opts seed.Options) *domain.Snapshot {
+	t.Helper()
+
+	repo := memory.New(zap.NewNop())
+	t.Cleanup(repo.Close)
+
+	svc := service.New(repo, notifier.Multi{}, seed.NewRand(opts.Seed), zap.NewNop())
+
+	report, err := seed.Generate(context.Background(), repo, svc, opts)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if report.Created == 0 || report.Merged == 0 {
+		t.Fatalf("report = %+v, want created and merged pull requests", report)
+	}
+
+	snapshot, err := repo.Dump(context.Background())
+	if err != nil {
+		t.Fatalf("Dump: %v", err)
+	}
+
+	return snapshot
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	opts := seed.DefaultOptions()
+	opts.Seed = 42
+	opts.PullRequests = 100
+	opts.End = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	first := generate(t, opts)
+	second := generate(t, opts)
+
+	// Reviewers are compared too, they are picked by the service.
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("two runs with the same seed produced different datasets")
+	}
+
+	opts.Seed = 43
+	other := generate(t, opts)
+
+	if reflect.DeepEqual(first.PullRequests, other.PullRequests) {
+		t.Error("runs with different seeds produced the same pull requests")
+	}
+}
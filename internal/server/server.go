@@ -1,15 +1,25 @@
 package server
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 
+	"reviewer-service/internal/api"
 	"reviewer-service/internal/api/handler"
+	"reviewer-service/internal/auth"
+	"reviewer-service/internal/authz"
+	"reviewer-service/internal/events"
+	"reviewer-service/internal/health"
+	"reviewer-service/internal/idempotency"
 	"reviewer-service/internal/logger"
+	"reviewer-service/internal/ratelimit"
 	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+	"reviewer-service/internal/validation"
 )
 
 type Config struct {
@@ -17,24 +27,50 @@ type Config struct {
 	Port            int           `env:"HTTP_PORT" env-required:"true"`
 	Timeout         time.Duration `env:"HTTP_TIMEOUT" env-required:"true"`
 	ShutdownTimeout time.Duration `env:"HTTP_SHUTDOWN_TIMEOUT" env-required:"true"`
+	// V1DeprecatedAt is the YYYY-MM-DD date announced in the Deprecation header of v1 responses,
+	// the day /v2 was released.
+	V1DeprecatedAt string `env:"HTTP_V1_DEPRECATED_AT" env-default:"2026-10-18"`
+	// V1Sunset is the YYYY-MM-DD date announced in the Sunset header of v1 responses, empty omits it.
+	V1Sunset string `env:"HTTP_V1_SUNSET"`
 }
 
-func NewRouter(repo repository.Repository, log *zap.Logger, cfgLogger *logger.Config, srvTimeout time.Duration) *chi.Mux {
+func NewRouter(repo repository.Repository, svc *service.Service, broker *events.Broker, log *zap.Logger, cfgLogger *logger.Config, authenticator *auth.Authenticator, authorizer *authz.Authorizer, throttler *ratelimit.Throttler, validator *validation.Validator, keeper *idempotency.Keeper, srvTimeout time.Duration, deprecation func(http.Handler) http.Handler, prober *health.Prober) http.Handler {
 	router := chi.NewRouter()
 
 	router.Use(middleware.RequestID)
+	router.Use(deprecation)
 	router.Use(middleware.RealIP)
+	// the per-IP limit runs before auth, so that requests with bad credentials are counted too.
+	router.Use(throttler.IPMiddleware)
+	// auth runs before the logger so that request logs carry the key id.
+	router.Use(authenticator.Middleware)
 	router.Use(logger.MiddlewareLogger(log, cfgLogger))
-	router.Use(middleware.Recoverer)
+	// limits are keyed by the caller, so they run after auth.
+	router.Use(throttler.Middleware)
+	// invalid requests are rejected before they take an idempotency key.
+	router.Use(validator.Middleware)
+	router.Use(keeper.Middleware)
+	router.Use(handler.Recoverer(log))
 	router.Use(middleware.URLFormat)
+	router.NotFound(handler.NotFound(log))
+	router.MethodNotAllowed(handler.MethodNotAllowed(log))
 
-	router.Post("/team/add", handler.AddTeam(repo, srvTimeout, log))
-	router.Get("/team/get", handler.GetTeam(repo, srvTimeout, log))
-	router.Post("/users/setIsActive", handler.SetIsActive(repo, srvTimeout, log))
-	router.Post("/pullRequest/create", handler.CreatePR(repo, srvTimeout, log))
-	router.Post("/pullRequest/merge", handler.MergePR(repo, srvTimeout, log))
-	router.Post("/pullRequest/reassign", handler.ReassignPR(repo, srvTimeout, log))
-	router.Get("/users/getReview", handler.GetReview(repo, srvTimeout, log))
+	h := handler.New(repo, svc, broker, srvTimeout, log)
+	middlewares := []api.StrictMiddlewareFunc{authorizer.Middleware}
+	strictHandler := api.NewStrictHandlerWithOptions(h, middlewares, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handler.RequestErrorHandler(log),
+		ResponseErrorHandlerFunc: handler.ResponseErrorHandler(log),
+	})
 
-	return router
+	api.HandlerWithOptions(strictHandler, api.ChiServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: handler.RequestErrorHandler(log),
+	})
+
+	// probes are answered before auth, rate limits and the request log, which only wrap the API.
+	mux := http.NewServeMux()
+	prober.Register(mux)
+	mux.Handle("/", router)
+
+	return mux
 }
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+)
+
+// MaxReviewers is how many teammates review a pull request when the team has that many.
+const MaxReviewers = 2
+
+// reassignAttempts bounds the retries of a reassignment that raced with another change of the pull request.
+const reassignAttempts = 3
+
+// CreatePR saves an open pull request with reviewers picked by SavePR and notifies them.
+func (s *Service) CreatePR(ctx context.Context, prID string, name string, authorID string) (*domain.PullRequest, error) {
+	now := time.Now()
+
+	pr, err := s.SavePR(ctx, domain.PullRequest{
+		PullRequestId:   prID,
+		PullRequestName: name,
+		AuthorId:        authorID,
+		Status:          domain.PRStatusOpen,
+		CreatedAt:       &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.notify.Notify(ctx, notifier.Event{
+		Type:        notifier.EventAssigned,
+		PullRequest: *pr,
+		Reviewers:   pr.AssignedReviewers,
+	})
+	if err != nil {
+		s.logger.Warn("CreatePR: failed to notify reviewers", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+	}
+
+	return pr, nil
+}
+
+// SavePR assigns up to MaxReviewers random active teammates of the author, never the author, and saves
+// the rest of pr as given. It sends no notification, seed uses it to backdate pull requests.
+// A taken id fails with ErrPRAlreadyExists before the author is looked up.
+func (s *Service) SavePR(ctx context.Context, pr domain.PullRequest) (*domain.PullRequest, error) {
+	_, err := s.repo.GetPR(ctx, pr.PullRequestId)
+	switch {
+	case err == nil:
+		return nil, repository.ErrPRAlreadyExists
+	case !errors.Is(err, repository.ErrPRNotFound):
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	author, err := s.repo.GetUser(ctx, pr.AuthorId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get author: %w", err)
+	}
+
+	reviewers, err := s.candidates(ctx, author.TeamName, []string{pr.AuthorId})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reviewers) == 0 {
+		return nil, ErrReviewersNotFound
+	}
+
+	s.shuffle(reviewers)
+	pr.AssignedReviewers = reviewers[:min(len(reviewers), MaxReviewers)]
+
+	err = s.repo.SavePR(ctx, pr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save pull request: %w", err)
+	}
+
+	return &pr, nil
+}
+
+// MergePR is idempotent, merging a merged pull request returns it unchanged and notifies nobody.
+func (s *Service) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, changed, err := s.repo.SetPRStatus(ctx, prID, domain.PRStatusMerged, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to set pull request status: %w", err)
+	}
+
+	if !changed {
+		return pr, nil
+	}
+
+	err = s.notify.Notify(ctx, notifier.Event{
+		Type:        notifier.EventMerged,
+		PullRequest: *pr,
+	})
+	if err != nil {
+		s.logger.Warn("MergePR: failed to notify", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+	}
+
+	return pr, nil
+}
+
+// ReassignReviewer replaces oldUserID on an open pull request with a random active teammate of the author
+// who does not review it yet, and returns that teammate.
+func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldUserID string) (*domain.PullRequest, string, error) {
+	var (
+		pr          *domain.PullRequest
+		newReviewer string
+		err         error
+	)
+
+	for range reassignAttempts {
+		pr, newReviewer, err = s.reassign(ctx, prID, oldUserID)
+		if !errors.Is(err, repository.ErrPRChanged) {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to reassign reviewer: %w", err)
+	}
+
+	err = s.notify.Notify(ctx, notifier.Event{
+		Type:           notifier.EventReassigned,
+		PullRequest:    *pr,
+		Reviewers:      []string{newReviewer},
+		ReplacedUserID: oldUserID,
+	})
+	if err != nil {
+		s.logger.Warn("ReassignReviewer: failed to notify reviewer", zap.String("pull_request_id", pr.PullRequestId), zap.Error(err))
+	}
+
+	return pr, newReviewer, nil
+}
+
+func (s *Service) reassign(ctx context.Context, prID string, oldUserID string) (*domain.PullRequest, string, error) {
+	pr, err := s.repo.GetPR(ctx, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	if pr.Status == domain.PRStatusMerged {
+		return nil, "", ErrPRMerged
+	}
+
+	idx := slices.Index(pr.AssignedReviewers, oldUserID)
+	if idx == -1 {
+		return nil, "", ErrReviewerNotAssigned
+	}
+
+	author, err := s.repo.GetUser(ctx, pr.AuthorId)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get author: %w", err)
+	}
+
+	candidates, err := s.candidates(ctx, author.TeamName, append([]string{pr.AuthorId}, pr.AssignedReviewers...))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(candidates) == 0 {
+		return nil, "", ErrNoCandidate
+	}
+
+	newReviewer := s.pick(candidates)
+
+	reviewers := slices.Clone(pr.AssignedReviewers)
+	reviewers[idx] = newReviewer
+
+	// The update only applies while the reviewers are still the ones read above.
+	updated, err := s.repo.UpdateReviewers(ctx, prID, pr.AssignedReviewers, reviewers)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to update reviewers: %w", err)
+	}
+
+	return updated, newReviewer, nil
+}
+
+// candidates returns the active members of the team, except the excluded ones.
+func (s *Service) candidates(ctx context.Context, teamName string, exclude []string) ([]string, error) {
+	members, err := s.repo.GetActiveMembers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active members: %w", err)
+	}
+
+	return slices.DeleteFunc(members, func(userID string) bool {
+		return slices.Contains(exclude, userID)
+	}), nil
+}
+
+func (s *Service) ArchivePR(ctx context.Context, prID string) (time.Time, error) {
+	archivedAt, err := s.repo.ArchivePR(ctx, prID, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to archive pull request: %w", err)
+	}
+
+	return archivedAt, nil
+}
+
+func (s *Service) RestorePR(ctx context.Context, prID string) error {
+	err := s.repo.RestorePR(ctx, prID)
+	if err != nil {
+		return fmt.Errorf("failed to restore pull request: %w", err)
+	}
+
+	return nil
+}
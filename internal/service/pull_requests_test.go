@@ -0,0 +1,356 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+	"reviewer-service/internal/service"
+)
+
+// fakeRepo keeps users and pull requests in maps. Embedding the interface leaves the methods the
+// pull request use cases do not call unimplemented.
+type fakeRepo struct {
+	repository.Repository
+
+	users map[string]domain.User
+	prs   map[string]domain.PullRequest
+	// conflicts makes that many UpdateReviewers calls fail with ErrPRChanged.
+	conflicts int
+	updates   int
+}
+
+func newFakeRepo(users ...domain.User) *fakeRepo {
+	repo := &fakeRepo{
+		users: make(map[string]domain.User),
+		prs:   make(map[string]domain.PullRequest),
+	}
+
+	for _, u := range users {
+		repo.users[u.UserID] = u
+	}
+
+	return repo
+}
+
+func (r *fakeRepo) GetUser(_ context.Context, userID string) (*domain.User, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return &u, nil
+}
+
+func (r *fakeRepo) GetActiveMembers(_ context.Context, teamName string) ([]string, error) {
+	var ids []string
+	for _, u := range r.users {
+		if u.TeamName == teamName && u.IsActive {
+			ids = append(ids, u.UserID)
+		}
+	}
+
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func (r *fakeRepo) GetPR(_ context.Context, prID string) (*domain.PullRequest, error) {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return nil, repository.ErrPRNotFound
+	}
+
+	pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	return &pr, nil
+}
+
+func (r *fakeRepo) SavePR(_ context.Context, pr domain.PullRequest) error {
+	if _, ok := r.prs[pr.PullRequestId]; ok {
+		return repository.ErrPRAlreadyExists
+	}
+
+	pr.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	r.prs[pr.PullRequestId] = pr
+	return nil
+}
+
+func (r *fakeRepo) SetPRStatus(_ context.Context, prID string, status string, mergedAt time.Time) (*domain.PullRequest, bool, error) {
+	pr, ok := r.prs[prID]
+	if !ok {
+		return nil, false, repository.ErrPRNotFound
+	}
+
+	changed := pr.MergedAt == nil
+	if changed {
+		pr.MergedAt = &mergedAt
+	}
+	pr.Status = status
+	r.prs[prID] = pr
+
+	return &pr, changed, nil
+}
+
+func (r *fakeRepo) UpdateReviewers(_ context.Context, prID string, expected []string, reviewers []string) (*domain.PullRequest, error) {
+	r.updates++
+	if r.updates <= r.conflicts {
+		return nil, repository.ErrPRChanged
+	}
+
+	pr, ok := r.prs[prID]
+	if !ok || pr.Status != domain.PRStatusOpen || !slices.Equal(pr.AssignedReviewers, expected) {
+		return nil, repository.ErrPRChanged
+	}
+
+	pr.AssignedReviewers = slices.Clone(reviewers)
+	r.prs[prID] = pr
+
+	return &pr, nil
+}
+
+// recorder keeps the events the service sends.
+type recorder struct {
+	events []notifier.Event
+}
+
+func (r *recorder) Notify(_ context.Context, event notifier.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func user(id string, team string, active bool) domain.User {
+	return domain.User{UserID: id, UserName: id, TeamName: team, IsActive: active, Role: domain.RoleMember}
+}
+
+func openPR(id string, authorID string, reviewers ...string) domain.PullRequest {
+	now := time.Now()
+
+	return domain.PullRequest{
+		PullRequestId:     id,
+		PullRequestName:   "name of " + id,
+		AuthorId:          authorID,
+		Status:            domain.PRStatusOpen,
+		AssignedReviewers: reviewers,
+		CreatedAt:         &now,
+	}
+}
+
+func newService(repo repository.Repository) (*service.Service, *recorder) {
+	var notify recorder
+	return service.New(repo, &notify, rand.New(rand.NewPCG(1, 2)), zap.NewNop()), &notify
+}
+
+func TestCreatePR(t *testing.T) {
+	repo := newFakeRepo(
+		user("u1", "backend", true),
+		user("u2", "backend", true),
+		user("u3", "backend", true),
+		user("u4", "backend", false),
+		user("u5", "backend", true),
+		user("f1", "frontend", true),
+	)
+	svc, notify := newService(repo)
+
+	// Reviewers are random, enough pull requests make every wrong pick likely to show up.
+	for i := range 50 {
+		id := fmt.Sprintf("pr-%d", i)
+
+		pr, err := svc.CreatePR(context.Background(), id, "Add search", "u1")
+		if err != nil {
+			t.Fatalf("CreatePR(%s): %v", id, err)
+		}
+
+		if len(pr.AssignedReviewers) != service.MaxReviewers {
+			t.Fatalf("AssignedReviewers = %v, want %d reviewers", pr.AssignedReviewers, service.MaxReviewers)
+		}
+
+		for _, reviewer := range pr.AssignedReviewers {
+			if !slices.Contains([]string{"u2", "u3", "u5"}, reviewer) {
+				t.Fatalf("AssignedReviewers = %v, want active teammates of the author only", pr.AssignedReviewers)
+			}
+		}
+
+		if pr.AssignedReviewers[0] == pr.AssignedReviewers[1] {
+			t.Fatalf("AssignedReviewers = %v, want distinct reviewers", pr.AssignedReviewers)
+		}
+	}
+
+	if len(notify.events) != 50 || notify.events[0].Type != notifier.EventAssigned {
+		t.Errorf("got %d events, want one %s event per pull request", len(notify.events), notifier.EventAssigned)
+	}
+}
+
+func TestCreatePRSingleCandidate(t *testing.T) {
+	repo := newFakeRepo(user("u1", "backend", true), user("u2", "backend", true))
+	svc, _ := newService(repo)
+
+	pr, err := svc.CreatePR(context.Background(), "pr-1", "Add search", "u1")
+	if err != nil {
+		t.Fatalf("CreatePR: %v", err)
+	}
+
+	if !slices.Equal(pr.AssignedReviewers, []string{"u2"}) {
+		t.Errorf("AssignedReviewers = %v, want [u2]", pr.AssignedReviewers)
+	}
+}
+
+func TestCreatePRErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		authorID string
+		existing bool
+		want     error
+	}{
+		{"NoActiveTeammate", "u1", false, service.ErrReviewersNotFound},
+		{"UnknownAuthor", "missing", false, repository.ErrUserNotFound},
+		{"Exists", "u1", true, repository.ErrPRAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(user("u1", "backend", true), user("u2", "backend", false))
+			if tt.existing {
+				repo.prs["pr-1"] = openPR("pr-1", "u2")
+			}
+			svc, notify := newService(repo)
+
+			_, err := svc.CreatePR(context.Background(), "pr-1", "Add search", tt.authorID)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CreatePR error = %v, want %v", err, tt.want)
+			}
+
+			if len(notify.events) != 0 {
+				t.Errorf("events = %v, want none", notify.events)
+			}
+		})
+	}
+}
+
+func TestMergePR(t *testing.T) {
+	repo := newFakeRepo(user("u1", "backend", true), user("u2", "backend", true))
+	repo.prs["pr-1"] = openPR("pr-1", "u1", "u2")
+	svc, notify := newService(repo)
+
+	for range 2 {
+		pr, err := svc.MergePR(context.Background(), "pr-1")
+		if err != nil {
+			t.Fatalf("MergePR: %v", err)
+		}
+
+		if pr.Status != domain.PRStatusMerged {
+			t.Errorf("Status = %q, want %q", pr.Status, domain.PRStatusMerged)
+		}
+	}
+
+	if len(notify.events) != 1 || notify.events[0].Type != notifier.EventMerged {
+		t.Errorf("events = %v, want a single %s event", notify.events, notifier.EventMerged)
+	}
+}
+
+func TestReassignReviewer(t *testing.T) {
+	repo := newFakeRepo(
+		user("u1", "backend", true),
+		user("u2", "backend", true),
+		user("u3", "backend", true),
+		user("u4", "backend", false),
+		user("u5", "backend", true),
+	)
+	repo.prs["pr-1"] = openPR("pr-1", "u1", "u2", "u3")
+	svc, notify := newService(repo)
+
+	pr, newReviewer, err := svc.ReassignReviewer(context.Background(), "pr-1", "u2")
+	if err != nil {
+		t.Fatalf("ReassignReviewer: %v", err)
+	}
+
+	// u5 is the only active teammate who is neither the author nor a reviewer already.
+	if newReviewer != "u5" || !slices.Equal(pr.AssignedReviewers, []string{"u5", "u3"}) {
+		t.Errorf("ReassignReviewer = %s, %v, want u5 in place of u2", newReviewer, pr.AssignedReviewers)
+	}
+
+	if len(notify.events) != 1 || notify.events[0].ReplacedUserID != "u2" {
+		t.Errorf("events = %v, want a single event replacing u2", notify.events)
+	}
+}
+
+func TestReassignReviewerErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		pr        domain.PullRequest
+		oldUserID string
+		want      error
+	}{
+		{"NotFound", openPR("other", "u1", "u2"), "u2", repository.ErrPRNotFound},
+		{"Merged", func() domain.PullRequest {
+			pr := openPR("pr-1", "u1", "u2")
+			pr.Status = domain.PRStatusMerged
+			return pr
+		}(), "u2", service.ErrPRMerged},
+		{"NotAssigned", openPR("pr-1", "u1", "u2"), "u3", service.ErrReviewerNotAssigned},
+		{"NoCandidate", openPR("pr-1", "u1", "u2", "u3"), "u2", service.ErrNoCandidate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(
+				user("u1", "backend", true),
+				user("u2", "backend", true),
+				user("u3", "backend", true),
+				user("u4", "backend", false),
+			)
+			repo.prs[tt.pr.PullRequestId] = tt.pr
+			svc, notify := newService(repo)
+
+			_, _, err := svc.ReassignReviewer(context.Background(), "pr-1", tt.oldUserID)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("ReassignReviewer error = %v, want %v", err, tt.want)
+			}
+
+			if len(notify.events) != 0 {
+				t.Errorf("events = %v, want none", notify.events)
+			}
+		})
+	}
+}
+
+func TestReassignReviewerRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		conflicts int
+		want      error
+		updates   int
+	}{
+		{"SucceedsAfterConflicts", 2, nil, 3},
+		{"GivesUp", 3, repository.ErrPRChanged, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo(user("u1", "backend", true), user("u2", "backend", true), user("u3", "backend", true))
+			repo.prs["pr-1"] = openPR("pr-1", "u1", "u2")
+			repo.conflicts = tt.conflicts
+			svc, _ := newService(repo)
+
+			_, newReviewer, err := svc.ReassignReviewer(context.Background(), "pr-1", "u2")
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("ReassignReviewer error = %v, want %v", err, tt.want)
+			}
+
+			if tt.want == nil && newReviewer != "u3" {
+				t.Errorf("new reviewer = %q, want u3", newReviewer)
+			}
+
+			if repo.updates != tt.updates {
+				t.Errorf("UpdateReviewers called %d times, want %d", repo.updates, tt.updates)
+			}
+		})
+	}
+}
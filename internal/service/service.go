@@ -0,0 +1,54 @@
+// Package service holds the use cases shared by every API version and the gRPC server: it owns the
+// reviewer assignment rules, calls the repository and sends the notifications that follow a change.
+package service
+
+import (
+	"errors"
+	"math/rand/v2"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/notifier"
+	"reviewer-service/internal/repository"
+)
+
+var (
+	ErrUnknownRole = errors.New("unknown role")
+
+	ErrReviewersNotFound   = errors.New("reviewers not found")
+	ErrPRMerged            = errors.New("pull request already merged")
+	ErrReviewerNotAssigned = errors.New("reviewer not assigned")
+	ErrNoCandidate         = errors.New("no candidate")
+)
+
+type Service struct {
+	repo   repository.Repository
+	notify notifier.Notifier
+	logger *zap.Logger
+
+	// rngMu guards rng, a rand.Rand is not safe for concurrent use.
+	rngMu sync.Mutex
+	rng   *rand.Rand
+}
+
+// New picks reviewers with rng, a seeded one makes the picks reproducible.
+func New(repo repository.Repository, notify notifier.Notifier, rng *rand.Rand, logger *zap.Logger) *Service {
+	return &Service{repo: repo, notify: notify, rng: rng, logger: logger}
+}
+
+func (s *Service) shuffle(ids []string) {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
+	s.rng.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
+}
+
+func (s *Service) pick(ids []string) string {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+
+	return ids[s.rng.IntN(len(ids))]
+}
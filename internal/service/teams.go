@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"reviewer-service/internal/domain"
+)
+
+func (s *Service) CreateTeam(ctx context.Context, team *domain.Team) error {
+	err := s.repo.SaveTeam(ctx, team)
+	if err != nil {
+		return fmt.Errorf("failed to save team: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	team, err := s.repo.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return team, nil
+}
+
+func (s *Service) ImportTeams(ctx context.Context, teams []domain.Team) error {
+	err := s.repo.ImportTeams(ctx, teams)
+	if err != nil {
+		return fmt.Errorf("failed to import teams: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) ArchiveTeam(ctx context.Context, teamName string) (time.Time, error) {
+	archivedAt, err := s.repo.ArchiveTeam(ctx, teamName, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to archive team: %w", err)
+	}
+
+	return archivedAt, nil
+}
+
+func (s *Service) RestoreTeam(ctx context.Context, teamName string) error {
+	err := s.repo.RestoreTeam(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"reviewer-service/internal/domain"
+)
+
+func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	user, err := s.repo.SetIsActive(ctx, userID, isActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set is_active: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *Service) SetRole(ctx context.Context, userID string, role string) (*domain.User, error) {
+	if !slices.Contains(domain.Roles, role) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRole, role)
+	}
+
+	user, err := s.repo.SetUserRole(ctx, userID, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set role: %w", err)
+	}
+
+	return user, nil
+}
+
+// GetReviews returns the pull requests the user is assigned to review.
+func (s *Service) GetReviews(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+	prs, err := s.repo.GetReviewers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	return prs, nil
+}
+
+func (s *Service) ArchiveUser(ctx context.Context, userID string) (time.Time, error) {
+	archivedAt, err := s.repo.ArchiveUser(ctx, userID, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to archive user: %w", err)
+	}
+
+	return archivedAt, nil
+}
+
+func (s *Service) RestoreUser(ctx context.Context, userID string) error {
+	err := s.repo.RestoreUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+// Package teamimport reads teams and their members from CSV or YAML files for bulk import.
+package teamimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/validation"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatYAML = "yaml"
+)
+
+var ErrUnknownFormat = errors.New("unknown import format")
+
+// csvColumns lists the header of a CSV file, email is the only optional column.
+var csvColumns = []string{"team_name", "user_id", "username", "email", "is_active"}
+
+// RowError points at the line of the input that failed validation.
+type RowError struct {
+	Line    int
+	Field   string
+	Message string
+}
+
+// Errors is returned by Parse when some rows are invalid, nothing should be imported then.
+type Errors []RowError
+
+func (e Errors) Error() string {
+	return fmt.Sprintf("%d validation errors", len(e))
+}
+
+// FormatFromContentType maps a Content-Type header to a format, it returns "" when unknown.
+func FormatFromContentType(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch mediaType {
+	case "text/csv":
+		return FormatCSV
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return FormatYAML
+	default:
+		return ""
+	}
+}
+
+// FormatFromPath maps a file extension to a format, it returns "" when unknown.
+func FormatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return ""
+	}
+}
+
+// Parse validates the whole input and groups members by team in the order they appear. Rows are held
+// to the constraints openapi.yml puts on teams and members, invalid rows are reported together as Errors.
+func Parse(r io.Reader, format string) ([]domain.Team, error) {
+	var rows []row
+	var errs Errors
+	var err error
+
+	switch format {
+	case FormatCSV:
+		rows, errs, err = readCSV(r)
+	case FormatYAML:
+		rows, errs, err = readYAML(r)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowErrs, err := validate(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	errs = append(errs, rowErrs...)
+	if len(errs) > 0 {
+		slices.SortStableFunc(errs, func(a, b RowError) int { return a.Line - b.Line })
+		return nil, errs
+	}
+
+	return group(rows), nil
+}
+
+// row is one member, or a team listed without members when member is false.
+type row struct {
+	line     int
+	member   bool
+	teamName string
+	userID   string
+	username string
+	email    string
+	isActive bool
+}
+
+func readCSV(r io.Reader) ([]row, Errors, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("empty csv file")
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+
+	var errs Errors
+	for _, column := range csvColumns {
+		if _, ok := index[column]; !ok && column != "email" {
+			errs = append(errs, RowError{Line: 1, Field: column, Message: "missing column"})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs, nil
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := index[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				errs = append(errs, RowError{Line: parseErr.Line, Message: parseErr.Err.Error()})
+				continue
+			}
+
+			return nil, nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		isActive, err := strconv.ParseBool(field(record, "is_active"))
+		if err != nil {
+			errs = append(errs, RowError{Line: line, Field: "is_active", Message: fmt.Sprintf("invalid boolean %q", field(record, "is_active"))})
+		}
+
+		rows = append(rows, row{
+			line:     line,
+			member:   true,
+			teamName: field(record, "team_name"),
+			userID:   field(record, "user_id"),
+			username: field(record, "username"),
+			email:    field(record, "email"),
+			isActive: isActive,
+		})
+	}
+
+	return rows, errs, nil
+}
+
+type yamlFile struct {
+	Teams []yaml.Node `yaml:"teams"`
+}
+
+type yamlTeam struct {
+	TeamName string      `yaml:"team_name"`
+	Members  []yaml.Node `yaml:"members"`
+}
+
+type yamlMember struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	Email    string `yaml:"email"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+func readYAML(r io.Reader) ([]row, Errors, error) {
+	var file yamlFile
+
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&file)
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("empty yaml file")
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode yaml: %w", err)
+	}
+
+	var rows []row
+	var errs Errors
+
+	for _, teamNode := range file.Teams {
+		var team yamlTeam
+
+		err = teamNode.Decode(&team)
+		if err != nil {
+			errs = append(errs, RowError{Line: teamNode.Line, Message: err.Error()})
+			continue
+		}
+
+		if len(team.Members) == 0 {
+			rows = append(rows, row{line: teamNode.Line, teamName: strings.TrimSpace(team.TeamName)})
+			continue
+		}
+
+		for _, memberNode := range team.Members {
+			var member yamlMember
+
+			err = memberNode.Decode(&member)
+			if err != nil {
+				errs = append(errs, RowError{Line: memberNode.Line, Message: err.Error()})
+				continue
+			}
+
+			if member.IsActive == nil {
+				errs = append(errs, RowError{Line: memberNode.Line, Field: "is_active", Message: "is required"})
+				continue
+			}
+
+			rows = append(rows, row{
+				line:     memberNode.Line,
+				member:   true,
+				teamName: strings.TrimSpace(team.TeamName),
+				userID:   strings.TrimSpace(member.UserID),
+				username: strings.TrimSpace(member.Username),
+				email:    strings.TrimSpace(member.Email),
+				isActive: *member.IsActive,
+			})
+		}
+	}
+
+	return rows, errs, nil
+}
+
+// loadSchemas reads openapi.yml once, rows get the constraints of the team and member schemas.
+var loadSchemas = sync.OnceValues(validation.NewSchemas)
+
+func validate(rows []row) (Errors, error) {
+	schemas, err := loadSchemas()
+	if err != nil {
+		return nil, err
+	}
+
+	var errs Errors
+	seen := make(map[string]int, len(rows))
+
+	for _, r := range rows {
+		// Empty values are reported as missing rather than as too short.
+		required := map[string]string{"team_name": r.teamName}
+
+		fieldErrs, err := schemas.Component("Team", api.Team{TeamName: r.teamName, Members: []api.TeamMember{}})
+		if err != nil {
+			return nil, err
+		}
+
+		if r.member {
+			required["user_id"] = r.userID
+			required["username"] = r.username
+
+			memberErrs, err := schemas.Component("TeamMember", api.TeamMember{
+				UserId:   r.userID,
+				Username: r.username,
+				Email:    r.email,
+				IsActive: r.isActive,
+			})
+			if err != nil {
+				return nil, err
+			}
+			fieldErrs = append(fieldErrs, memberErrs...)
+		}
+
+		for _, field := range csvColumns {
+			if value, ok := required[field]; ok && value == "" {
+				errs = append(errs, RowError{Line: r.line, Field: field, Message: "is required"})
+			}
+		}
+
+		for _, fe := range fieldErrs {
+			if value, ok := required[fe.Field]; ok && value == "" {
+				continue
+			}
+
+			errs = append(errs, RowError{Line: r.line, Field: fe.Field, Message: fe.Message})
+		}
+
+		if !r.member {
+			continue
+		}
+
+		if r.email != "" && !strings.Contains(r.email, "@") {
+			errs = append(errs, RowError{Line: r.line, Field: "email", Message: fmt.Sprintf("invalid email %q", r.email)})
+		}
+
+		if r.userID == "" {
+			continue
+		}
+
+		if line, ok := seen[r.userID]; ok {
+			errs = append(errs, RowError{Line: r.line, Field: "user_id", Message: fmt.Sprintf("user %s is already listed on line %d", r.userID, line)})
+			continue
+		}
+		seen[r.userID] = r.line
+	}
+
+	return errs, nil
+}
+
+func group(rows []row) []domain.Team {
+	teams := make([]domain.Team, 0)
+	index := make(map[string]int)
+
+	for _, r := range rows {
+		i, ok := index[r.teamName]
+		if !ok {
+			i = len(teams)
+			index[r.teamName] = i
+			teams = append(teams, domain.Team{TeamName: r.teamName, Members: make([]domain.TeamMember, 0)})
+		}
+
+		if !r.member {
+			continue
+		}
+
+		teams[i].Members = append(teams[i].Members, domain.TeamMember{
+			UserID:   r.userID,
+			UserName: r.username,
+			Email:    r.email,
+			IsActive: r.isActive,
+		})
+	}
+
+	return teams
+}
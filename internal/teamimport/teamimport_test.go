@@ -0,0 +1,253 @@
+package teamimport_test
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"reviewer-service/internal/domain"
+	"reviewer-service/internal/teamimport"
+)
+
+func TestParseAppliesSpecConstraints(t *testing.T) {
+	input := "team_name,user_id,username,email,is_active\n" +
+		"backend,u1,Alice,alice@example.com,true\n" +
+		"backend,u 2,Bob,,true\n" +
+		strings.Repeat("t", 101) + ",u3," + strings.Repeat("c", 101) + ",,false\n" +
+		"backend,,Dave,,true\n"
+
+	_, err := teamimport.Parse(strings.NewReader(input), teamimport.FormatCSV)
+
+	var errs teamimport.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Parse error = %v, want teamimport.Errors", err)
+	}
+
+	want := []struct {
+		line  int
+		field string
+	}{
+		{3, "user_id"},
+		{4, "team_name"},
+		{4, "username"},
+		{5, "user_id"},
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("errors = %+v, want %d", errs, len(want))
+	}
+
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Field != w.field {
+			t.Errorf("errors[%d] = %+v, want line %d, field %s", i, errs[i], w.line, w.field)
+		}
+	}
+
+	if !slices.ContainsFunc(errs, func(e teamimport.RowError) bool { return e.Message == "is required" }) {
+		t.Errorf("errors = %+v, want the empty user_id reported as required", errs)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	// Columns may come in any order and case, email may be left out.
+	input := "User_ID, Team_Name ,is_active,username\n" +
+		"u1,backend,true,Alice\n" +
+		"u2,frontend,false, Bob \n" +
+		"u3,backend,1,Carol\n"
+
+	teams, err := teamimport.Parse(strings.NewReader(input), teamimport.FormatCSV)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := []domain.Team{
+		{TeamName: "backend", Members: []domain.TeamMember{
+			{UserID: "u1", UserName: "Alice", IsActive: true},
+			{UserID: "u3", UserName: "Carol", IsActive: true},
+		}},
+		{TeamName: "frontend", Members: []domain.TeamMember{
+			{UserID: "u2", UserName: "Bob", IsActive: false},
+		}},
+	}
+
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("Parse = %+v, want %+v", teams, want)
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	input := `teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: Alice
+        email: alice@example.com
+        is_active: true
+      - user_id: u2
+        username: Bob
+        is_active: false
+  - team_name: design
+`
+
+	teams, err := teamimport.Parse(strings.NewReader(input), teamimport.FormatYAML)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := []domain.Team{
+		{TeamName: "backend", Members: []domain.TeamMember{
+			{UserID: "u1", UserName: "Alice", Email: "alice@example.com", IsActive: true},
+			{UserID: "u2", UserName: "Bob", IsActive: false},
+		}},
+		{TeamName: "design", Members: []domain.TeamMember{}},
+	}
+
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("Parse = %+v, want %+v", teams, want)
+	}
+}
+
+func TestParseReportsLines(t *testing.T) {
+	type rowErr struct {
+		line  int
+		field string
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   []rowErr
+	}{
+		{
+			name:   "CSVMissingColumn",
+			format: teamimport.FormatCSV,
+			input:  "team_name,user_id,username\nbackend,u1,Alice\n",
+			want:   []rowErr{{1, "is_active"}},
+		},
+		{
+			name:   "CSVInvalidBoolean",
+			format: teamimport.FormatCSV,
+			input:  "team_name,user_id,username,is_active\nbackend,u1,Alice,true\nbackend,u2,Bob,yes\n",
+			want:   []rowErr{{3, "is_active"}},
+		},
+		{
+			name:   "CSVWrongFieldCount",
+			format: teamimport.FormatCSV,
+			input:  "team_name,user_id,username,is_active\nbackend,u1,Alice\nbackend,u2,Bob,true\n",
+			want:   []rowErr{{2, ""}},
+		},
+		{
+			name:   "CSVDuplicateUser",
+			format: teamimport.FormatCSV,
+			input:  "team_name,user_id,username,is_active\nbackend,u1,Alice,true\nfrontend,u1,Alice,true\n",
+			want:   []rowErr{{3, "user_id"}},
+		},
+		{
+			name:   "CSVInvalidEmail",
+			format: teamimport.FormatCSV,
+			input:  "team_name,user_id,username,email,is_active\nbackend,u1,Alice,not-an-email,true\n",
+			want:   []rowErr{{2, "email"}},
+		},
+		{
+			name:   "YAMLMissingIsActive",
+			format: teamimport.FormatYAML,
+			input:  "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n        username: Alice\n",
+			want:   []rowErr{{4, "is_active"}},
+		},
+		{
+			name:   "YAMLMisspelledField",
+			format: teamimport.FormatYAML,
+			input:  "teams:\n  - team_name: backend\n    members:\n      - user_id: u1\n        username: Alice\n        is_active: true\n      - user_id: u2\n        name: Bob\n        is_active: true\n",
+			want:   []rowErr{{7, "username"}},
+		},
+		{
+			name:   "YAMLEmptyTeamName",
+			format: teamimport.FormatYAML,
+			input:  "teams:\n  - team_name: backend\n  - team_name: ''\n",
+			want:   []rowErr{{3, "team_name"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams, err := teamimport.Parse(strings.NewReader(tt.input), tt.format)
+
+			var errs teamimport.Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Parse error = %v, want teamimport.Errors", err)
+			}
+
+			// One bad row rejects the whole file.
+			if teams != nil {
+				t.Errorf("Parse returned %+v next to errors", teams)
+			}
+
+			got := make([]rowErr, len(errs))
+			for i, e := range errs {
+				got[i] = rowErr{e.Line, e.Field}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("errors = %+v, want %+v", errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{"UnknownFormat", "xml", "<teams/>"},
+		{"EmptyCSV", teamimport.FormatCSV, ""},
+		{"EmptyYAML", teamimport.FormatYAML, ""},
+		{"YAMLUnknownTopLevelField", teamimport.FormatYAML, "groups: []\n"},
+	}
+
+	for _, tt := range tests {
+		_, err := teamimport.Parse(strings.NewReader(tt.input), tt.format)
+
+		var errs teamimport.Errors
+		if err == nil || errors.As(err, &errs) {
+			t.Errorf("%s: error = %v, want the file rejected as a whole", tt.name, err)
+		}
+	}
+
+	_, err := teamimport.Parse(strings.NewReader(""), "xml")
+	if !errors.Is(err, teamimport.ErrUnknownFormat) {
+		t.Errorf("error = %v, want %v", err, teamimport.ErrUnknownFormat)
+	}
+}
+
+func TestFormatDetection(t *testing.T) {
+	contentTypes := map[string]string{
+		"text/csv; charset=utf-8": teamimport.FormatCSV,
+		"application/yaml":        teamimport.FormatYAML,
+		"text/x-yaml":             teamimport.FormatYAML,
+		"application/json":        "",
+	}
+
+	for contentType, want := range contentTypes {
+		if got := teamimport.FormatFromContentType(contentType); got != want {
+			t.Errorf("FormatFromContentType(%q) = %q, want %q", contentType, got, want)
+		}
+	}
+
+	paths := map[string]string{
+		"teams.CSV":      teamimport.FormatCSV,
+		"dir/teams.yml":  teamimport.FormatYAML,
+		"dir/teams.yaml": teamimport.FormatYAML,
+		"teams.json":     "",
+	}
+
+	for path, want := range paths {
+		if got := teamimport.FormatFromPath(path); got != want {
+			t.Errorf("FormatFromPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"reviewer-service/internal/api"
+)
+
+// Schemas checks input that does not arrive as an HTTP request, gRPC calls and import files,
+// against the schemas openapi.yml declares for the matching HTTP API.
+type Schemas struct {
+	doc        *openapi3.T
+	operations map[string]*openapi3.Operation
+}
+
+func NewSchemas() (*Schemas, error) {
+	doc, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+
+	// The embedded spec carries the operation ids as Go names, AddTeam for addTeam.
+	operations := make(map[string]*openapi3.Operation)
+	for _, item := range doc.Paths.Map() {
+		for _, op := range item.Operations() {
+			operations[strings.ToLower(op.OperationID)] = op
+		}
+	}
+
+	return &Schemas{doc: doc, operations: operations}, nil
+}
+
+// Component checks value, anything that marshals to JSON, against a schema of components/schemas.
+func (s *Schemas) Component(name string, value any) ([]api.ProblemFieldError, error) {
+	ref, ok := s.doc.Components.Schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q", name)
+	}
+
+	return visit(ref.Value, value, "")
+}
+
+// Body checks body against the JSON request body of the operation.
+func (s *Schemas) Body(operationID string, body any) ([]api.ProblemFieldError, error) {
+	op, ok := s.operations[strings.ToLower(operationID)]
+	if !ok || op.RequestBody == nil || op.RequestBody.Value.Content["application/json"] == nil {
+		return nil, fmt.Errorf("operation %q has no json request body", operationID)
+	}
+
+	return visit(op.RequestBody.Value.Content["application/json"].Schema.Value, body, "")
+}
+
+// Parameter checks the value of a parameter of the operation, errors are reported on name.
+func (s *Schemas) Parameter(operationID string, name string, value any) ([]api.ProblemFieldError, error) {
+	op, ok := s.operations[strings.ToLower(operationID)]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %q", operationID)
+	}
+
+	for _, param := range op.Parameters {
+		if param.Value.Name == name {
+			return visit(param.Value.Schema.Value, value, name)
+		}
+	}
+
+	return nil, fmt.Errorf("operation %q has no parameter %q", operationID, name)
+}
+
+// visit reports every violation, on field when set and on the offending property otherwise.
+func visit(schema *openapi3.Schema, value any, field string) ([]api.ProblemFieldError, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	var doc any
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+
+	var errs []api.ProblemFieldError
+
+	for _, e := range flatten(schema.VisitJSON(doc, openapi3.MultiErrors())) {
+		var schemaErr *openapi3.SchemaError
+		if !errors.As(e, &schemaErr) {
+			return nil, e
+		}
+
+		name := field
+		if name == "" {
+			name = bodyField(schemaErr)
+		}
+
+		errs = append(errs, api.ProblemFieldError{Field: name, Message: schemaErr.Reason})
+	}
+
+	return errs, nil
+}
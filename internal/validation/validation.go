@@ -0,0 +1,215 @@
+// Package validation checks requests against openapi.yml before they reach the handlers,
+// so that the constraints are declared once, in the spec.
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+)
+
+type Config struct {
+	// MaxBodyBytes caps every request body, team import files included.
+	MaxBodyBytes int64 `env:"REQUEST_MAX_BODY_BYTES" env-default:"1048576"`
+}
+
+type Validator struct {
+	cfg    *Config
+	router routers.Router
+	logger *zap.Logger
+}
+
+func New(cfg *Config, logger *zap.Logger) (*Validator, error) {
+	doc, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+
+	// Routes are matched by path alone, whatever host the service runs on.
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openapi router: %w", err)
+	}
+
+	return &Validator{cfg: cfg, router: router, logger: logger}, nil
+}
+
+// Middleware must run before anything that reads the body. Requests for unknown routes pass
+// through to the router's own 404 and 405.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, v.cfg.MaxBodyBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				v.logger.Warn("Middleware: request body too large", zap.String("path", r.URL.Path), zap.Int64("limit", tooLarge.Limit))
+				api.WriteProblem(w, r, v.logger, http.StatusRequestEntityTooLarge, api.CodeTooLarge,
+					fmt.Sprintf("request body is larger than %d bytes", tooLarge.Limit))
+				return
+			}
+
+			v.logger.Warn("Middleware: failed to read body", zap.Error(err))
+			api.WriteProblem(w, r, v.logger, http.StatusBadRequest, api.CodeBadRequest, api.ErrReadBody)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		route, params, err := v.router.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), v.input(r, body, route, params))
+		if err != nil {
+			fieldErrs, decodeErr := fieldErrors(err)
+			if decodeErr != nil {
+				v.logger.Warn("Middleware: failed to decode body", zap.String("path", r.URL.Path), zap.Error(decodeErr))
+				api.WriteProblem(w, r, v.logger, http.StatusBadRequest, api.CodeBadRequest, "failed to decode body")
+				return
+			}
+
+			v.logger.Warn("Middleware: invalid request", zap.String("path", r.URL.Path), zap.Error(err))
+			api.WriteProblem(w, r, v.logger, http.StatusBadRequest, api.CodeInvalid, api.ErrInvalidRequest, fieldErrs...)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}
+
+	return http.HandlerFunc(fn)
+}
+
+// input prepares the request for the filter. The generated handlers decode JSON bodies whatever
+// the Content-Type says, so JSON operations are validated as JSON too; other bodies, like
+// team import files, are left to their handlers.
+func (v *Validator) input(r *http.Request, body []byte, route *routers.Route, params map[string]string) *openapi3filter.RequestValidationInput {
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	req := r
+	if rb := route.Operation.RequestBody; rb != nil && rb.Value.Content["application/json"] != nil {
+		req = r.Clone(r.Context())
+		req.Header.Set("Content-Type", "application/json")
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	} else {
+		options.ExcludeRequestBody = true
+	}
+
+	return &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: params,
+		Route:      route,
+		Options:    options,
+	}
+}
+
+// fieldErrors flattens the filter's errors into one entry per offending field. A body that is
+// not JSON at all is reported on its own.
+func fieldErrors(err error) ([]api.ProblemFieldError, error) {
+	var errs []api.ProblemFieldError
+
+	for _, e := range flatten(err) {
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(e, &reqErr) {
+			errs = append(errs, api.ProblemFieldError{Message: e.Error()})
+			continue
+		}
+
+		var parseErr *openapi3filter.ParseError
+		if reqErr.Parameter == nil && errors.As(reqErr, &parseErr) {
+			return nil, reqErr
+		}
+
+		var field string
+		if reqErr.Parameter != nil {
+			field = reqErr.Parameter.Name
+		}
+
+		schemaErrs := flatten(reqErr.Err)
+		if len(schemaErrs) == 0 {
+			errs = append(errs, api.ProblemFieldError{Field: field, Message: reqErr.Error()})
+			continue
+		}
+
+		for _, se := range schemaErrs {
+			var schemaErr *openapi3.SchemaError
+			if !errors.As(se, &schemaErr) {
+				errs = append(errs, api.ProblemFieldError{Field: field, Message: se.Error()})
+				continue
+			}
+
+			if reqErr.Parameter == nil {
+				field = bodyField(schemaErr)
+			}
+
+			errs = append(errs, api.ProblemFieldError{Field: field, Message: schemaErr.Reason})
+		}
+	}
+
+	return errs, nil
+}
+
+func flatten(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	// Not errors.As: a RequestError unwraps to the MultiError of its own schema errors.
+	multi, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range multi {
+		errs = append(errs, flatten(e)...)
+	}
+
+	return errs
+}
+
+// bodyField renders the JSON pointer of a body error as members[1].user_id.
+func bodyField(err *openapi3.SchemaError) string {
+	path := err.JSONPointer()
+
+	// Unknown properties are reported on the object that holds them.
+	if err.SchemaField == "properties" {
+		var name string
+		_, scanErr := fmt.Sscanf(err.Reason, "property %q is unsupported", &name)
+		if scanErr == nil {
+			path = append(path, name)
+		}
+	}
+
+	var b strings.Builder
+	for _, part := range path {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,180 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"reviewer-service/internal/api"
+	"reviewer-service/internal/validation"
+)
+
+// fields lists the distinct fields of errs, sorted.
+func fields(errs []api.ProblemFieldError) []string {
+	names := make([]string, len(errs))
+	for i, e := range errs {
+		names[i] = e.Field
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+func TestMiddleware(t *testing.T) {
+	validator, err := validation.New(&validation.Config{MaxBodyBytes: 1 << 10}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	handler := validator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantFields []string
+	}{
+		{
+			name:       "Valid",
+			method:     http.MethodPost,
+			target:     "/team/add",
+			body:       `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Pattern",
+			method:     http.MethodPost,
+			target:     "/pullRequest/create",
+			body:       `{"pull_request_id":"pr 1","pull_request_name":"Add search","author_id":"u1"}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"pull_request_id"},
+		},
+		{
+			name:       "MaxLength",
+			method:     http.MethodPost,
+			target:     "/team/add",
+			body:       `{"team_name":"` + strings.Repeat("t", 101) + `","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"team_name"},
+		},
+		{
+			name:       "MissingRequired",
+			method:     http.MethodPost,
+			target:     "/team/add",
+			body:       `{"team_name":"backend","members":[{"user_id":"u1","is_active":true}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"members[0].username"},
+		},
+		{
+			name:       "Parameter",
+			method:     http.MethodGet,
+			target:     "/team/get?team_name=" + strings.Repeat("t", 101),
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"team_name"},
+		},
+		{
+			name:       "TooLarge",
+			method:     http.MethodPost,
+			target:     "/team/add",
+			body:       strings.Repeat(" ", 2<<10),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "UnknownRoute",
+			method:     http.MethodPost,
+			target:     "/unknown",
+			body:       `{}`,
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+
+			if tt.wantFields == nil {
+				return
+			}
+
+			var problem api.Problem
+			err := json.Unmarshal(rec.Body.Bytes(), &problem)
+			if err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+
+			if problem.Code != api.CodeInvalid || !slices.Equal(fields(problem.Errors), tt.wantFields) {
+				t.Errorf("problem = %+v, want %s on %v", problem, api.CodeInvalid, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	schemas, err := validation.NewSchemas()
+	if err != nil {
+		t.Fatalf("NewSchemas: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		check func() ([]api.ProblemFieldError, error)
+		want  []string
+	}{
+		{"ComponentValid", func() ([]api.ProblemFieldError, error) {
+			return schemas.Component("TeamMember", api.TeamMember{UserId: "u1", Username: "Alice"})
+		}, []string{}},
+		{"ComponentPattern", func() ([]api.ProblemFieldError, error) {
+			return schemas.Component("TeamMember", api.TeamMember{UserId: "u/1", Username: "Alice"})
+		}, []string{"user_id"}},
+		{"ComponentMaxLength", func() ([]api.ProblemFieldError, error) {
+			return schemas.Component("TeamMember", api.TeamMember{UserId: "u1", Username: "Alice", Email: strings.Repeat("e", 255)})
+		}, []string{"email"}},
+		{"ComponentMissingRequired", func() ([]api.ProblemFieldError, error) {
+			return schemas.Component("TeamMember", map[string]any{"user_id": "u1", "is_active": true})
+		}, []string{"username"}},
+		{"BodyNested", func() ([]api.ProblemFieldError, error) {
+			return schemas.Body("addTeam", api.Team{TeamName: "backend", Members: []api.TeamMember{
+				{UserId: "u1", Username: "Alice"},
+				{UserId: strings.Repeat("u", 65), Username: ""},
+			}})
+		}, []string{"members[1].user_id", "members[1].username"}},
+		{"Parameter", func() ([]api.ProblemFieldError, error) {
+			return schemas.Parameter("getReview", "user_id", "")
+		}, []string{"user_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := tt.check()
+			if err != nil {
+				t.Fatalf("check: %v", err)
+			}
+
+			if got := fields(errs); !slices.Equal(got, tt.want) {
+				t.Errorf("fields = %v, want %v (%+v)", got, tt.want, errs)
+			}
+		})
+	}
+
+	_, err = schemas.Body("getTeam", nil)
+	if err == nil {
+		t.Error("Body of an operation without a json body: want an error")
+	}
+}